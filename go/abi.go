@@ -0,0 +1,245 @@
+package cdp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Function selectors of common token standard methods.
+const (
+	erc20TransferSelector                  = "a9059cbb" // transfer(address,uint256)
+	erc20ApproveSelector                   = "095ea7b3" // approve(address,uint256)
+	transferFromSelector                   = "23b872dd" // transferFrom(address,address,uint256)
+	erc721SafeTransferFromSelector         = "42842e0e" // safeTransferFrom(address,address,uint256)
+	erc721SafeTransferFromWithDataSelector = "b88d4fde" // safeTransferFrom(address,address,uint256,bytes)
+	setApprovalForAllSelector              = "a22cb465" // setApprovalForAll(address,bool)
+	getCurrentPeriodSelector               = "2c18d42e" // getCurrentPeriod((address,address,address,uint160,uint48,uint48,uint48,uint256,bytes))
+	erc20NameSelector                      = "06fdde03" // name()
+	erc20SymbolSelector                    = "95d89b41" // symbol()
+	erc20DecimalsSelector                  = "313ce567" // decimals()
+	erc20BalanceOfSelector                 = "70a08231" // balanceOf(address)
+)
+
+// abiWordSize is the size in bytes of a single ABI-encoded word.
+const abiWordSize = 32
+
+// maxUint256 is the largest value representable by a uint256.
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// keccak256 returns the Keccak-256 hash of the concatenation of data.
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// decodeHexData decodes 0x-prefixed hex calldata. Empty data ("" or "0x") decodes to nil.
+func decodeHexData(data string) ([]byte, error) {
+	data = strings.TrimPrefix(strings.TrimPrefix(data, "0x"), "0X")
+	if data == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(data)
+}
+
+// splitCalldata splits calldata into its hex selector and its argument words.
+func splitCalldata(data []byte) (string, [][]byte, error) {
+	if len(data) < 4 {
+		return "", nil, errors.New("calldata is shorter than a function selector")
+	}
+
+	args := data[4:]
+	words := make([][]byte, 0, len(args)/abiWordSize)
+	for len(args) >= abiWordSize {
+		words = append(words, args[:abiWordSize])
+		args = args[abiWordSize:]
+	}
+
+	return hex.EncodeToString(data[:4]), words, nil
+}
+
+// wordToAddress decodes an ABI-encoded address word as a 0x-prefixed hex address.
+func wordToAddress(word []byte) string {
+	return "0x" + hex.EncodeToString(word[abiWordSize-20:])
+}
+
+// wordToBigInt decodes an ABI-encoded uint256 word.
+func wordToBigInt(word []byte) *big.Int {
+	return new(big.Int).SetBytes(word)
+}
+
+// wordToBool decodes an ABI-encoded bool word.
+func wordToBool(word []byte) bool {
+	return word[abiWordSize-1] != 0
+}
+
+// addressWord ABI-encodes a 0x-prefixed hex address as a word.
+func addressWord(address string) ([]byte, error) {
+	decoded, err := decodeHexData(address)
+	if err != nil || len(decoded) != 20 {
+		return nil, fmt.Errorf("invalid address %q", address)
+	}
+	word := make([]byte, abiWordSize)
+	copy(word[abiWordSize-20:], decoded)
+	return word, nil
+}
+
+// uintWord ABI-encodes a non-negative integer as a word.
+func uintWord(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, abiWordSize))
+}
+
+// bytesTail ABI-encodes the tail of a dynamic bytes value: its length followed by
+// the data, right-padded to a whole number of words.
+func bytesTail(data []byte) []byte {
+	padded := (len(data) + abiWordSize - 1) / abiWordSize * abiWordSize
+	tail := make([]byte, abiWordSize+padded)
+	copy(tail, uintWord(big.NewInt(int64(len(data)))))
+	copy(tail[abiWordSize:], data)
+	return tail
+}
+
+// decodeStringResult decodes the return data of a function returning a string.
+// Some older tokens return bytes32 instead, which is decoded with its trailing
+// zero bytes removed.
+func decodeStringResult(data []byte) (string, error) {
+	if len(data) == abiWordSize {
+		return string(bytes.TrimRight(data, "\x00")), nil
+	}
+	if len(data) < 2*abiWordSize {
+		return "", errors.New("return data is too short for a string")
+	}
+
+	offset := wordToBigInt(data[:abiWordSize])
+	if !offset.IsInt64() || offset.Int64() > int64(len(data)-abiWordSize) {
+		return "", errors.New("string offset is out of range")
+	}
+	start := int(offset.Int64())
+	length := wordToBigInt(data[start : start+abiWordSize])
+	if !length.IsInt64() || length.Int64() > int64(len(data)-start-abiWordSize) {
+		return "", errors.New("string length is out of range")
+	}
+	return string(data[start+abiWordSize : start+abiWordSize+int(length.Int64())]), nil
+}
+
+// FunctionSelector returns the 4-byte selector of a function signature such as
+// "transfer(address,uint256)": the first four bytes of its Keccak-256 hash. The
+// signature must not have spaces or parameter names. The aliases uint and int are
+// hashed as uint256 and int256, as Solidity does.
+func FunctionSelector(signature string) [4]byte {
+	var selector [4]byte
+	copy(selector[:], keccak256([]byte(canonicalSignature(signature))))
+	return selector
+}
+
+// integerAlias matches the uint and int type aliases.
+var integerAlias = regexp.MustCompile(`\b(u?int)\b`)
+
+// canonicalSignature returns signature with the uint and int aliases in its
+// parameter types replaced by uint256 and int256.
+func canonicalSignature(signature string) string {
+	open := strings.IndexByte(signature, '(')
+	if open < 0 {
+		return signature
+	}
+	return signature[:open] + integerAlias.ReplaceAllString(signature[open:], "${1}256")
+}
+
+// EncodeCall ABI-encodes a call to the function with the given signature and
+// returns it as 0x-prefixed hex calldata, suitable for EvmCall.Data or
+// TransactionRequest.Data. Only the static types address, uint<N> and bool are
+// supported. Addresses are given as 0x-prefixed hex strings, integers as *big.Int,
+// int, int64 or uint64, and bools as bool.
+func EncodeCall(signature string, args ...interface{}) (string, error) {
+	types, err := parseSignatureTypes(signature)
+	if err != nil {
+		return "", err
+	}
+	if len(args) != len(types) {
+		return "", fmt.Errorf("%s takes %d arguments, got %d", signature, len(types), len(args))
+	}
+
+	selector := FunctionSelector(signature)
+	data := append(make([]byte, 0, 4+len(args)*abiWordSize), selector[:]...)
+	for i, typ := range types {
+		word, err := encodeStaticArg(typ, args[i])
+		if err != nil {
+			return "", fmt.Errorf("argument %d: %w", i, err)
+		}
+		data = append(data, word...)
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// parseSignatureTypes returns the parameter types of a function signature.
+func parseSignatureTypes(signature string) ([]string, error) {
+	open := strings.IndexByte(signature, '(')
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("invalid function signature %q", signature)
+	}
+	params := signature[open+1 : len(signature)-1]
+	if params == "" {
+		return nil, nil
+	}
+	return strings.Split(params, ","), nil
+}
+
+// encodeStaticArg ABI-encodes a single value of a static type as a word.
+func encodeStaticArg(typ string, arg interface{}) ([]byte, error) {
+	switch {
+	case typ == "address":
+		address, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string for address, got %T", arg)
+		}
+		return addressWord(address)
+	case typ == "bool":
+		b, ok := arg.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %T", arg)
+		}
+		word := make([]byte, abiWordSize)
+		if b {
+			word[abiWordSize-1] = 1
+		}
+		return word, nil
+	case strings.HasPrefix(typ, "uint"):
+		bits := 256
+		if typ != "uint" {
+			n, err := strconv.Atoi(strings.TrimPrefix(typ, "uint"))
+			if err != nil || n < 8 || n > 256 || n%8 != 0 {
+				return nil, fmt.Errorf("unsupported type %q", typ)
+			}
+			bits = n
+		}
+		var v *big.Int
+		switch a := arg.(type) {
+		case *big.Int:
+			v = a
+		case int:
+			v = big.NewInt(int64(a))
+		case int64:
+			v = big.NewInt(a)
+		case uint64:
+			v = new(big.Int).SetUint64(a)
+		default:
+			return nil, fmt.Errorf("expected an integer for %s, got %T", typ, arg)
+		}
+		if v == nil || v.Sign() < 0 || v.BitLen() > bits {
+			return nil, fmt.Errorf("%v out of range for %s", v, typ)
+		}
+		return uintWord(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %q", typ)
+	}
+}
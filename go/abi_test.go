@@ -0,0 +1,95 @@
+package cdp
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestFunctionSelector(t *testing.T) {
+	tests := map[string]string{
+		"transfer(address,uint256)":                 erc20TransferSelector,
+		"approve(address,uint256)":                  erc20ApproveSelector,
+		"transferFrom(address,address,uint256)":     transferFromSelector,
+		"safeTransferFrom(address,address,uint256)": erc721SafeTransferFromSelector,
+		"setApprovalForAll(address,bool)":           setApprovalForAllSelector,
+		"balanceOf(address)":                        erc20BalanceOfSelector,
+		"decimals()":                                erc20DecimalsSelector,
+		"transfer(address,uint)":                    erc20TransferSelector,
+	}
+	for signature, want := range tests {
+		selector := FunctionSelector(signature)
+		if got := hex.EncodeToString(selector[:]); got != want {
+			t.Errorf("FunctionSelector(%q) = %s, want %s", signature, got, want)
+		}
+	}
+}
+
+func TestEncodeCall(t *testing.T) {
+	word := func(s string) string { return strings.Repeat("0", 64-len(s)) + s }
+	recipient := "0x" + strings.Repeat("ab", 20)
+
+	tests := []struct {
+		signature string
+		args      []interface{}
+		want      string
+	}{
+		{"decimals()", nil, "0x" + erc20DecimalsSelector},
+		{"transfer(address,uint256)", []interface{}{recipient, big.NewInt(1_000_000)},
+			"0x" + erc20TransferSelector + word(strings.Repeat("ab", 20)) + word("f4240")},
+		{"approve(address,uint256)", []interface{}{recipient, new(big.Int).Set(maxUint256)},
+			"0x" + erc20ApproveSelector + word(strings.Repeat("ab", 20)) + strings.Repeat("f", 64)},
+		{"setApprovalForAll(address,bool)", []interface{}{recipient, true},
+			"0x" + setApprovalForAllSelector + word(strings.Repeat("ab", 20)) + word("1")},
+		{"transferFrom(address,address,uint256)", []interface{}{testOwner, recipient, 7},
+			"0x" + transferFromSelector + word(strings.ToLower(testOwner[2:])) + word(strings.Repeat("ab", 20)) + word("7")},
+	}
+	for _, tc := range tests {
+		got, err := EncodeCall(tc.signature, tc.args...)
+		if err != nil {
+			t.Errorf("EncodeCall(%q) returned an error: %v", tc.signature, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("EncodeCall(%q) = %s, want %s", tc.signature, got, tc.want)
+		}
+	}
+}
+
+func TestEncodeCallErrors(t *testing.T) {
+	tests := []struct {
+		signature string
+		args      []interface{}
+	}{
+		{"transfer", nil},
+		{"transfer(address,uint256)", []interface{}{testRecipient}},
+		{"transfer(address,uint256)", []interface{}{"0x1234", big.NewInt(1)}},
+		{"transfer(address,uint256)", []interface{}{testRecipient, big.NewInt(-1)}},
+		{"transfer(address,uint256)", []interface{}{testRecipient, "1"}},
+		{"setValue(uint8)", []interface{}{256}},
+		{"setFlag(bool)", []interface{}{1}},
+		{"setName(string)", []interface{}{"name"}},
+		{"setData(bytes)", []interface{}{[]byte{1}}},
+	}
+	for _, tc := range tests {
+		if _, err := EncodeCall(tc.signature, tc.args...); err == nil {
+			t.Errorf("EncodeCall(%q, %v) succeeded, want an error", tc.signature, tc.args)
+		}
+	}
+}
+
+func TestCanonicalSignature(t *testing.T) {
+	tests := map[string]string{
+		"f(uint)":               "f(uint256)",
+		"f(int,uint8,uint[])":   "f(int256,uint8,uint256[])",
+		"uint(address)":         "uint(address)",
+		"f(uint256,int128)":     "f(uint256,int128)",
+		"f((uint,bool),int[2])": "f((uint256,bool),int256[2])",
+	}
+	for signature, want := range tests {
+		if got := canonicalSignature(signature); got != want {
+			t.Errorf("canonicalSignature(%q) = %q, want %q", signature, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package cdp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// AccountType identifies the kind of account a serialized account handle refers to.
+type AccountType string
+
+const (
+	// AccountTypeEvmServer is a CDP-managed EVM account (an EvmAccount).
+	AccountTypeEvmServer AccountType = "evm-server"
+	// AccountTypeEvmSmart is an EVM smart account (a SmartAccount).
+	AccountTypeEvmSmart AccountType = "evm-smart"
+)
+
+// Account is an account handle that can be persisted with json.Marshal and
+// restored with AccountFromJSON: an *EvmAccount or a *SmartAccount.
+type Account interface {
+	json.Marshaler
+
+	// Type returns the kind of account the handle refers to.
+	Type() AccountType
+}
+
+// accountJSON is the serialized form of an account handle. It holds the
+// account's identity only, never key material.
+type accountJSON struct {
+	Type    AccountType `json:"type"`
+	Address string      `json:"address"`
+	Name    string      `json:"name,omitempty"`
+	Owners  []string    `json:"owners,omitempty"`
+}
+
+// Type returns AccountTypeEvmServer.
+func (a *EvmAccount) Type() AccountType {
+	return AccountTypeEvmServer
+}
+
+// MarshalJSON serializes the account's identity (its type, address and name) for
+// restoring with AccountFromJSON. Policies are not included, since they may change
+// while the handle is persisted.
+func (a *EvmAccount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(accountJSON{Type: a.Type(), Address: a.Address, Name: a.Name})
+}
+
+// Type returns AccountTypeEvmSmart.
+func (s *SmartAccount) Type() AccountType {
+	return AccountTypeEvmSmart
+}
+
+// MarshalJSON serializes the smart account's identity (its type, address, name and
+// owner) for restoring with AccountFromJSON. Policies and the default paymaster are
+// not included.
+func (s *SmartAccount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(accountJSON{Type: s.Type(), Address: s.Address, Name: s.Name, Owners: []string{s.Owner}})
+}
+
+// AccountFromJSON restores an account handle serialized with json.Marshal, bound to
+// client, without making any API call. The result is an *EvmAccount or a
+// *SmartAccount, according to its Type. As with NewSmartAccount, the account's
+// existence is not checked.
+func AccountFromJSON(client *Client, data []byte) (Account, error) {
+	if client == nil {
+		return nil, errors.New("client is required")
+	}
+
+	var account accountJSON
+	if err := json.Unmarshal(data, &account); err != nil {
+		return nil, fmt.Errorf("invalid account JSON: %w", err)
+	}
+	if _, err := addressWord(account.Address); err != nil {
+		return nil, fmt.Errorf("invalid account JSON: %w", err)
+	}
+
+	switch account.Type {
+	case AccountTypeEvmServer:
+		return &EvmAccount{client: client, Address: account.Address, Name: account.Name}, nil
+	case AccountTypeEvmSmart:
+		if len(account.Owners) == 0 {
+			return nil, errors.New("invalid account JSON: smart account has no owner")
+		}
+		if _, err := addressWord(account.Owners[0]); err != nil {
+			return nil, fmt.Errorf("invalid account JSON: owner: %w", err)
+		}
+		s := NewSmartAccount(client, account.Address, account.Owners[0])
+		s.Name = account.Name
+		return s, nil
+	default:
+		return nil, fmt.Errorf("invalid account JSON: unknown account type %q", account.Type)
+	}
+}
@@ -0,0 +1,57 @@
+package cdp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAccountJSONRoundTrip(t *testing.T) {
+	client := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+	restored := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+
+	evm := &EvmAccount{client: client, Address: testRecipient, Name: "treasury", Policies: []string{"policy"}}
+	smart := NewSmartAccount(client, testOwner, testOtherOwner).WithPaymaster("https://paymaster.example")
+	smart.Name = "payouts"
+
+	for _, account := range []Account{evm, smart} {
+		data, err := json.Marshal(account)
+		if err != nil {
+			t.Fatalf("failed to marshal %s account: %v", account.Type(), err)
+		}
+		got, err := AccountFromJSON(restored, data)
+		if err != nil {
+			t.Fatalf("AccountFromJSON(%s) returned an error: %v", data, err)
+		}
+
+		switch want := account.(type) {
+		case *EvmAccount:
+			a, ok := got.(*EvmAccount)
+			if !ok || a.client != restored || a.Address != want.Address || a.Name != want.Name || a.Policies != nil {
+				t.Errorf("AccountFromJSON(%s) = %+v", data, got)
+			}
+		case *SmartAccount:
+			s, ok := got.(*SmartAccount)
+			if !ok || s.client != restored || s.Address != want.Address || s.Owner != want.Owner || s.Name != want.Name || s.paymasterURL != "" {
+				t.Errorf("AccountFromJSON(%s) = %+v", data, got)
+			}
+		}
+	}
+}
+
+func TestAccountFromJSONInvalid(t *testing.T) {
+	client := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+	for _, data := range []string{
+		`not json`,
+		`{"type":"evm-server","address":"0x1234"}`,
+		`{"type":"evm-smart","address":"` + testOwner + `"}`,
+		`{"type":"evm-smart","address":"` + testOwner + `","owners":["owner"]}`,
+		`{"type":"solana","address":"` + testOwner + `"}`,
+	} {
+		if _, err := AccountFromJSON(client, []byte(data)); err == nil {
+			t.Errorf("AccountFromJSON(%s) succeeded, want an error", data)
+		}
+	}
+	if _, err := AccountFromJSON(nil, []byte(`{"type":"evm-server","address":"`+testOwner+`"}`)); err == nil {
+		t.Error("expected an error for a nil client")
+	}
+}
@@ -0,0 +1,175 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrNameCollision is returned when an account name is already taken and the
+// configured NameCollisionPolicy does not allow reusing or renaming it.
+var ErrNameCollision = errors.New("account name collision")
+
+// maxAccountNameLength is the maximum length of a CDP account name.
+const maxAccountNameLength = 36
+
+// maxNameSuffix bounds the number of suffixed names tried by NameCollisionSuffix.
+const maxNameSuffix = 100
+
+// NameCollisionPolicy determines what GetOrCreate helpers do when an account with
+// the requested name already exists.
+type NameCollisionPolicy int
+
+const (
+	// NameCollisionReuse reuses the existing account if it is compatible with the
+	// request (for smart accounts, if it is owned by the requested owner) and
+	// returns ErrNameCollision otherwise. This is the default.
+	NameCollisionReuse NameCollisionPolicy = iota
+	// NameCollisionFail returns ErrNameCollision whenever the name is taken.
+	NameCollisionFail
+	// NameCollisionSuffix reuses a compatible account and otherwise creates the
+	// account under the first available suffixed name ("name-2", "name-3", ...).
+	// Suffixed names are tried in order and compatible accounts found under them
+	// are reused, so re-running a provisioning script yields the same account.
+	NameCollisionSuffix
+)
+
+// CreateOptions configures the GetOrCreate account helpers.
+type CreateOptions struct {
+	// Name is the account name. Names consist of alphanumeric characters and
+	// hyphens, and are between 2 and 36 characters long.
+	Name string
+	// OnNameCollision determines what happens when the name is already taken.
+	OnNameCollision NameCollisionPolicy
+}
+
+// EvmAccount is a handle to a CDP-managed EVM externally owned account.
+type EvmAccount struct {
+	client *Client
+
+	// Address is the account's address.
+	Address string
+	// Name is the account's name, if it has one.
+	Name string
+	// Policies are the IDs of the policies that apply to the account, including
+	// the project-level policy, as of when the handle was fetched.
+	Policies []string
+}
+
+// newEvmAccount converts an API account into an EvmAccount handle.
+func newEvmAccount(client *Client, account *openapi.EvmAccount) *EvmAccount {
+	a := &EvmAccount{client: client, Address: account.Address}
+	if account.Name != nil {
+		a.Name = *account.Name
+	}
+	if account.Policies != nil {
+		a.Policies = *account.Policies
+	}
+	return a
+}
+
+// GetOrCreateEvmAccount returns the EVM account with the given name, creating it if
+// it does not exist. Any existing EVM account is compatible, so NameCollisionReuse
+// and NameCollisionSuffix both reuse it.
+//
+// It is safe to call concurrently for the same name: concurrent calls on the same
+// client share a single lookup and creation, and if another client or process
+// creates the account first, it is fetched instead. With NameCollisionFail, each
+// call makes its own lookup, and a lost creation race fails with ErrNameCollision.
+func (c *Client) GetOrCreateEvmAccount(ctx context.Context, opts CreateOptions) (*EvmAccount, error) {
+	if opts.Name == "" || opts.OnNameCollision == NameCollisionFail {
+		return c.getOrCreateEvmAccount(ctx, opts)
+	}
+	account, err := c.evmAccountFlights.do(ctx, opts.Name, func() (*EvmAccount, error) {
+		return c.getOrCreateEvmAccount(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Give each caller its own handle.
+	handle := *account
+	return &handle, nil
+}
+
+// getOrCreateEvmAccount implements GetOrCreateEvmAccount for a single caller.
+func (c *Client) getOrCreateEvmAccount(ctx context.Context, opts CreateOptions) (*EvmAccount, error) {
+	existing, err := c.getEvmAccountByName(ctx, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		account, err := c.createEvmAccount(ctx, opts.Name)
+		if !isConflict(err) {
+			return account, err
+		}
+		// Another client created the account since it was looked up.
+		if existing, err = c.getEvmAccountByName(ctx, opts.Name); err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, fmt.Errorf("failed to create EVM account: name %q is taken but the account was not found", opts.Name)
+		}
+	}
+
+	if opts.OnNameCollision == NameCollisionFail {
+		return nil, fmt.Errorf("%w: an EVM account named %q already exists", ErrNameCollision, opts.Name)
+	}
+	return newEvmAccount(c, existing), nil
+}
+
+// getEvmAccountByName returns the EVM account with the given name, or nil if none
+// exists.
+func (c *Client) getEvmAccountByName(ctx context.Context, name string) (*openapi.EvmAccount, error) {
+	resp, err := c.GetEvmAccountByNameWithResponse(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EVM account: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, unexpectedResponseError("get EVM account", resp.HTTPResponse, resp.Body)
+	}
+}
+
+// createEvmAccount creates an EVM account with the given name.
+func (c *Client) createEvmAccount(ctx context.Context, name string) (*EvmAccount, error) {
+	body := openapi.CreateEvmAccountJSONRequestBody{}
+	if name != "" {
+		body.Name = &name
+	}
+
+	resp, err := c.CreateEvmAccountWithResponse(ctx, nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EVM account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated || resp.JSON201 == nil {
+		return nil, unexpectedStatusError("create EVM account", resp.StatusCode(), resp.Body)
+	}
+
+	return newEvmAccount(c, resp.JSON201), nil
+}
+
+// isConflict reports whether err is a 409 Conflict response, as returned when
+// creating an account whose name is already taken.
+func isConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// suffixedName returns name with the given numeric suffix, truncating name so the
+// result fits within the maximum account name length.
+func suffixedName(name string, n int) string {
+	suffix := "-" + strconv.Itoa(n)
+	if len(name)+len(suffix) > maxAccountNameLength {
+		name = name[:maxAccountNameLength-len(suffix)]
+	}
+	return name + suffix
+}
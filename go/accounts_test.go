@@ -0,0 +1,160 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetOrCreateEvmAccount(t *testing.T) {
+	f, client := newFakeAccountServer(t)
+
+	created, err := client.GetOrCreateEvmAccount(context.Background(), CreateOptions{Name: "hot-wallet"})
+	if err != nil {
+		t.Fatalf("GetOrCreateEvmAccount returned an error: %v", err)
+	}
+
+	reused, err := client.GetOrCreateEvmAccount(context.Background(), CreateOptions{
+		Name:            "hot-wallet",
+		OnNameCollision: NameCollisionSuffix,
+	})
+	if err != nil {
+		t.Fatalf("GetOrCreateEvmAccount returned an error: %v", err)
+	}
+	if reused.Address != created.Address {
+		t.Errorf("expected the existing account to be reused, got %s want %s", reused.Address, created.Address)
+	}
+	if len(f.created) != 1 {
+		t.Errorf("expected one account to be created, got %v", f.created)
+	}
+
+	_, err = client.GetOrCreateEvmAccount(context.Background(), CreateOptions{
+		Name:            "hot-wallet",
+		OnNameCollision: NameCollisionFail,
+	})
+	if !errors.Is(err, ErrNameCollision) {
+		t.Fatalf("expected ErrNameCollision, got %v", err)
+	}
+}
+
+func TestGetOrCreateEvmAccountConcurrent(t *testing.T) {
+	f, client := newFakeAccountServer(t)
+	other := newTestClient(t, client.options.BasePath)
+
+	const callers = 20
+	addresses := make([]string, 2*callers)
+	var wg sync.WaitGroup
+	for i := range addresses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Half the callers use a second client, standing in for another
+			// process that races on the same name.
+			c := client
+			if i%2 == 1 {
+				c = other
+			}
+			account, err := c.GetOrCreateEvmAccount(context.Background(), CreateOptions{Name: "shared"})
+			if err != nil {
+				t.Errorf("GetOrCreateEvmAccount returned an error: %v", err)
+				return
+			}
+			addresses[i] = account.Address
+		}()
+	}
+	wg.Wait()
+
+	for i, address := range addresses {
+		if address != addresses[0] {
+			t.Errorf("caller %d got account %s, want %s", i, address, addresses[0])
+		}
+	}
+	if len(f.created) != 1 {
+		t.Errorf("expected one account to be created, got %v", f.created)
+	}
+}
+
+func TestGetOrCreateEvmAccountCreateConflict(t *testing.T) {
+	const address = "0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8"
+	var lookups atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errorType":"already_exists","errorMessage":"name taken"}`)
+			return
+		}
+		// Another process creates the account between the first lookup and the
+		// create request.
+		if lookups.Add(1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q,"name":"raced"}`, address)
+	}))
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	account, err := client.GetOrCreateEvmAccount(context.Background(), CreateOptions{Name: "raced"})
+	if err != nil || account.Address != address {
+		t.Fatalf("GetOrCreateEvmAccount = %+v, %v; want the account created by the other process", account, err)
+	}
+
+	lookups.Store(0)
+	_, err = client.GetOrCreateEvmAccount(context.Background(), CreateOptions{Name: "raced", OnNameCollision: NameCollisionFail})
+	if !errors.Is(err, ErrNameCollision) {
+		t.Errorf("expected ErrNameCollision with NameCollisionFail, got %v", err)
+	}
+}
+
+func TestFlightGroupSharesResult(t *testing.T) {
+	var g flightGroup[int]
+	var calls atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	results := make(chan int, 3)
+	go func() {
+		v, _ := g.do(context.Background(), "key", func() (int, error) {
+			close(started)
+			<-release
+			return int(calls.Add(1)), nil
+		})
+		results <- v
+	}()
+	<-started
+	for range 2 {
+		go func() {
+			v, _ := g.do(context.Background(), "key", func() (int, error) {
+				return int(calls.Add(1)), nil
+			})
+			results <- v
+		}()
+	}
+
+	// Waiters give up when their context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := g.do(ctx, "key", func() (int, error) { return 0, nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled for a cancelled waiter, got %v", err)
+	}
+
+	// Give the other callers time to join the in-flight call.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	for range 3 {
+		if v := <-results; v != 1 {
+			t.Errorf("got result %d, want the shared result 1", v)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("fn ran %d times, want 1", n)
+	}
+}
@@ -0,0 +1,70 @@
+package cdp
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrRecipientNotAllowed is returned when a transaction or call is rejected
+// because its recipient is not in ClientOptions.RecipientAllowlist.
+var ErrRecipientNotAllowed = errors.New("recipient not allowed")
+
+// checkRecipient returns an error wrapping ErrRecipientNotAllowed if a recipient
+// of a transaction or call to with the given value (nil for none) and calldata
+// data is not allowlisted.
+func (c *Client) checkRecipient(to string, value *big.Int, data string) error {
+	allowlist := c.options.RecipientAllowlist
+	if len(allowlist) == 0 {
+		return nil
+	}
+	if to == "" {
+		return fmt.Errorf("%w: contract deployments have no recipient", ErrRecipientNotAllowed)
+	}
+	for _, recipient := range callRecipients(to, value, data) {
+		if !slices.ContainsFunc(allowlist, func(allowed string) bool { return strings.EqualFold(allowed, recipient) }) {
+			return fmt.Errorf("%w: %s", ErrRecipientNotAllowed, recipient)
+		}
+	}
+	return nil
+}
+
+// checkCallRecipients checks the recipients of each of calls.
+func (c *Client) checkCallRecipients(calls []openapi.EvmCall) error {
+	for i, call := range calls {
+		value := big.NewInt(0)
+		if call.Value != "" {
+			var err error
+			if value, err = ParseAmount(call.Value); err != nil {
+				// Check to as if the call sent value.
+				value = big.NewInt(1)
+			}
+		}
+		if err := c.checkRecipient(call.To, value, call.Data); err != nil {
+			return fmt.Errorf("call %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// callRecipients returns the recipients of a transaction or call to with the
+// given value and calldata data. For an ERC-20 transfer that sends no native
+// value, to is the token contract and only the token recipient receives funds;
+// otherwise to receives the value, and the token recipient too if data is an
+// ERC-20 transfer.
+func callRecipients(to string, value *big.Int, data string) []string {
+	data = strings.TrimPrefix(strings.ToLower(data), "0x")
+	if !strings.HasPrefix(data, erc20TransferSelector) || len(data) < len(erc20TransferSelector)+64 {
+		return []string{to}
+	}
+	word := data[len(erc20TransferSelector) : len(erc20TransferSelector)+64]
+	tokenRecipient := "0x" + word[24:]
+	if value == nil || value.Sign() == 0 {
+		return []string{tokenRecipient}
+	}
+	return []string{to, tokenRecipient}
+}
@@ -0,0 +1,101 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRecipientAllowlist(t *testing.T) {
+	var sent atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/prepare-and-send") {
+			_, _ = w.Write([]byte(`{"network":"base-sepolia","calls":[],"status":"broadcast","userOpHash":"0xop"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"transactionHash":"0xabc"}`))
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{RecipientAllowlist: []string{strings.ToUpper(testRecipient[:2]) + testRecipient[2:]}})
+	account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+	smartAccount := (&SmartAccount{client: client, Address: testOwner}).OnNetwork("base-sepolia")
+	allowlistedTransfer := "0x" + erc20TransferSelector + fmt.Sprintf("%064s", testRecipient[2:]) + fmt.Sprintf("%064x", 1)
+
+	tests := []struct {
+		name    string
+		send    func() error
+		allowed bool
+	}{
+		{"native transfer", func() error {
+			_, err := account.Transfer(context.Background(), testRecipient, big.NewInt(1), "eth")
+			return err
+		}, true},
+		{"token transfer", func() error {
+			_, err := account.Transfer(context.Background(), testRecipient, big.NewInt(1), "usdc")
+			return err
+		}, true},
+		{"user operation transfer", func() error {
+			_, err := smartAccount.Transfer(context.Background(), testRecipient, big.NewInt(1), "usdc", UserOperationOptions{})
+			return err
+		}, true},
+		{"blocked native transfer", func() error {
+			_, err := account.Transfer(context.Background(), testNFT, big.NewInt(1), "eth")
+			return err
+		}, false},
+		{"blocked token transfer", func() error {
+			_, err := account.Transfer(context.Background(), testNFT, big.NewInt(1), "usdc")
+			return err
+		}, false},
+		{"blocked user operation transfer", func() error {
+			_, err := smartAccount.Transfer(context.Background(), testNFT, big.NewInt(1), "usdc", UserOperationOptions{})
+			return err
+		}, false},
+		{"blocked call", func() error {
+			_, err := client.NewEvmCall("base-sepolia", testNFT, nil, "0x")
+			return err
+		}, false},
+		{"blocked native value alongside an allowlisted token transfer", func() error {
+			// The calldata encodes transfer(testRecipient, 1), but the value goes to testNFT.
+			_, err := account.SendTransaction(context.Background(), "base-sepolia", TransactionRequest{To: testNFT, Value: big.NewInt(1), Data: allowlistedTransfer})
+			return err
+		}, false},
+		{"blocked signed native value alongside an allowlisted token transfer", func() error {
+			_, err := account.SignTransaction(context.Background(), "base-sepolia", TransactionRequest{To: testNFT, Value: big.NewInt(1), Data: allowlistedTransfer})
+			return err
+		}, false},
+		{"blocked call value alongside an allowlisted token transfer", func() error {
+			_, err := client.NewEvmCall("base-sepolia", testNFT, big.NewInt(1), allowlistedTransfer)
+			return err
+		}, false},
+		{"blocked contract deployment", func() error {
+			_, err := account.SendTransaction(context.Background(), "base-sepolia", TransactionRequest{Data: "0x6080"})
+			return err
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := sent.Load()
+			err := tt.send()
+			if tt.allowed && err != nil {
+				t.Fatalf("expected the recipient to be allowed, got %v", err)
+			}
+			if !tt.allowed {
+				if !errors.Is(err, ErrRecipientNotAllowed) {
+					t.Fatalf("expected ErrRecipientNotAllowed, got %v", err)
+				}
+				if sent.Load() != before {
+					t.Error("a blocked request was sent")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package cdp
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// AmountEncoding is the string encoding of an integer amount, such as a value in
+// wei.
+type AmountEncoding int
+
+const (
+	// AmountDecimal encodes amounts as base-10 strings (e.g. "1000000000000000000").
+	AmountDecimal AmountEncoding = iota
+	// AmountHex encodes amounts as 0x-prefixed hexadecimal strings (e.g.
+	// "0xde0b6b3a7640000").
+	AmountHex
+)
+
+// FormatAmount encodes a non-negative amount with the given encoding. A nil amount
+// is encoded as zero.
+func FormatAmount(amount *big.Int, encoding AmountEncoding) string {
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	if encoding == AmountHex {
+		return "0x" + amount.Text(16)
+	}
+	return amount.String()
+}
+
+// ParseAmount parses a non-negative amount in either encoding: a 0x-prefixed
+// string is parsed as hexadecimal, anything else as decimal.
+func ParseAmount(s string) (*big.Int, error) {
+	digits, base := s, 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		digits, base = s[2:], 16
+	}
+	// big.Int.SetString accepts signs and underscores, which are not valid here.
+	if digits == "" || strings.ContainsAny(digits, "+-_") {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	amount, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	return amount, nil
+}
+
+// amountEncoding returns the encoding the API expects for amounts on network.
+func (c *Client) amountEncoding(network string) AmountEncoding {
+	return c.options.AmountEncodings[network]
+}
+
+// encodeAmount encodes amount for network, checking that the encoded string
+// parses back to the same amount.
+func (c *Client) encodeAmount(network string, amount *big.Int) (string, error) {
+	if amount != nil && amount.Sign() < 0 {
+		return "", fmt.Errorf("amount must not be negative: %s", amount)
+	}
+	encoded := FormatAmount(amount, c.amountEncoding(network))
+	decoded, err := ParseAmount(encoded)
+	if err != nil || (amount != nil && decoded.Cmp(amount) != 0) {
+		return "", fmt.Errorf("amount %s does not round-trip through encoding %q", amount, encoded)
+	}
+	return encoded, nil
+}
+
+// NewEvmCall returns a call to send to with a smart account user operation on
+// network, with value (in wei, nil for none) encoded as the network expects.
+func (c *Client) NewEvmCall(network, to string, value *big.Int, data string) (openapi.EvmCall, error) {
+	network = c.networkOrDefault(network)
+	if err := c.checkRecipient(to, value, data); err != nil {
+		return openapi.EvmCall{}, err
+	}
+	encoded, err := c.encodeAmount(network, value)
+	if err != nil {
+		return openapi.EvmCall{}, err
+	}
+	if data == "" {
+		data = "0x"
+	}
+	return openapi.EvmCall{To: to, Value: encoded, Data: data}, nil
+}
+
+// encodeCallValues returns a copy of calls with their values re-encoded as the API
+// expects on network. Values may be given in either encoding; an empty value is
+// treated as zero.
+func (c *Client) encodeCallValues(network string, calls []openapi.EvmCall) ([]openapi.EvmCall, error) {
+	encoded := make([]openapi.EvmCall, len(calls))
+	for i, call := range calls {
+		value := new(big.Int)
+		var err error
+		if call.Value != "" {
+			if value, err = ParseAmount(call.Value); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+		}
+		if call.Value, err = c.encodeAmount(network, value); err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		encoded[i] = call
+	}
+	return encoded, nil
+}
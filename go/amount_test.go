@@ -0,0 +1,93 @@
+package cdp
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestFormatAndParseAmount(t *testing.T) {
+	large, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+	tests := []struct {
+		amount  *big.Int
+		decimal string
+		hex     string
+	}{
+		{big.NewInt(0), "0", "0x0"},
+		{big.NewInt(1_000_000_000_000_000_000), "1000000000000000000", "0xde0b6b3a7640000"},
+		{large, large.String(), "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	}
+	for _, tc := range tests {
+		if got := FormatAmount(tc.amount, AmountDecimal); got != tc.decimal {
+			t.Errorf("FormatAmount(%s, AmountDecimal) = %q, want %q", tc.amount, got, tc.decimal)
+		}
+		if got := FormatAmount(tc.amount, AmountHex); got != tc.hex {
+			t.Errorf("FormatAmount(%s, AmountHex) = %q, want %q", tc.amount, got, tc.hex)
+		}
+		for _, s := range []string{tc.decimal, tc.hex} {
+			got, err := ParseAmount(s)
+			if err != nil {
+				t.Errorf("ParseAmount(%q) returned an error: %v", s, err)
+			} else if got.Cmp(tc.amount) != 0 {
+				t.Errorf("ParseAmount(%q) = %s, want %s", s, got, tc.amount)
+			}
+		}
+	}
+}
+
+func TestParseAmountRejectsMalformed(t *testing.T) {
+	for _, s := range []string{"", "0x", "-1", "+1", "1_000", "0x-1", "1.5", "0xzz", "ten"} {
+		if _, err := ParseAmount(s); err == nil {
+			t.Errorf("ParseAmount(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestEncodeCallValues(t *testing.T) {
+	client := &Client{options: ClientOptions{AmountEncodings: map[string]AmountEncoding{"hex-net": AmountHex}}}
+	calls := []openapi.EvmCall{
+		{To: testRecipient, Value: "1000000000000000000", Data: "0x"},
+		{To: testRecipient, Value: "0x10", Data: "0x"},
+		{To: testRecipient, Data: "0x"},
+	}
+
+	tests := map[string][]string{
+		"hex-net": {"0xde0b6b3a7640000", "0x10", "0x0"},
+		"base":    {"1000000000000000000", "16", "0"},
+	}
+	for network, want := range tests {
+		got, err := client.encodeCallValues(network, calls)
+		if err != nil {
+			t.Fatalf("%s: encodeCallValues returned an error: %v", network, err)
+		}
+		for i := range want {
+			if got[i].Value != want[i] {
+				t.Errorf("%s: call %d value = %q, want %q", network, i, got[i].Value, want[i])
+			}
+		}
+	}
+	if calls[1].Value != "0x10" {
+		t.Errorf("encodeCallValues modified its input: %q", calls[1].Value)
+	}
+
+	if _, err := client.encodeCallValues("base", []openapi.EvmCall{{Value: "-5"}}); err == nil {
+		t.Error("expected an error for a negative value")
+	}
+}
+
+func TestNewEvmCall(t *testing.T) {
+	client := &Client{options: ClientOptions{AmountEncodings: map[string]AmountEncoding{"hex-net": AmountHex}}}
+
+	call, err := client.NewEvmCall("hex-net", testRecipient, big.NewInt(255), "")
+	if err != nil {
+		t.Fatalf("NewEvmCall returned an error: %v", err)
+	}
+	if call.Value != "0xff" || call.Data != "0x" || call.To != testRecipient {
+		t.Errorf("got %+v", call)
+	}
+
+	if _, err := client.NewEvmCall("base", testRecipient, big.NewInt(-1), "0x"); err == nil {
+		t.Error("expected an error for a negative value")
+	}
+}
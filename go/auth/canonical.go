@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+)
+
+// HashCanonicalJSON returns the SHA-256 hash of the canonical JSON encoding of
+// data, streaming the encoding into the hash rather than building it in memory.
+// It is the hash wallet JWTs carry in their reqHash claim.
+func HashCanonicalJSON(data map[string]interface{}) ([]byte, error) {
+	h := sha256.New()
+	w := bufio.NewWriter(h)
+	if err := writeCanonicalJSON(w, data); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// writeCanonicalJSON writes the canonical JSON encoding of v to w: the encoding of
+// json.Marshal, which sorts object keys, except that *big.Int and *big.Float
+// values are encoded as decimal strings and nil maps and slices as empty ones.
+//
+// Maps and slices are walked in place, so unlike copying the data into a sorted
+// structure first, memory use doesn't grow with the size of large arrays; only the
+// keys of one object at a time are held for sorting.
+func writeCanonicalJSON(w *bufio.Writer, v interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		w.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			writeJSONString(w, k)
+			w.WriteByte(':')
+			if err := writeCanonicalJSON(w, v[k]); err != nil {
+				return err
+			}
+		}
+		return w.WriteByte('}')
+
+	case []interface{}:
+		w.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			if err := writeCanonicalJSON(w, elem); err != nil {
+				return err
+			}
+		}
+		return w.WriteByte(']')
+
+	case *big.Int:
+		if v == nil {
+			return writeJSONValue(w, nil)
+		}
+		return writeJSONValue(w, v.String())
+
+	case *big.Float:
+		if v == nil {
+			return writeJSONValue(w, nil)
+		}
+		return writeJSONValue(w, v.String())
+
+	case string:
+		writeJSONString(w, v)
+		return nil
+
+	case bool:
+		_, err := w.WriteString(strconv.FormatBool(v))
+		return err
+
+	case nil:
+		_, err := w.WriteString("null")
+		return err
+
+	case int:
+		_, err := w.Write(strconv.AppendInt(w.AvailableBuffer(), int64(v), 10))
+		return err
+
+	case int64:
+		_, err := w.Write(strconv.AppendInt(w.AvailableBuffer(), v, 10))
+		return err
+
+	default:
+		return writeJSONValue(w, v)
+	}
+}
+
+// writeJSONString writes s as a JSON string. Strings of printable ASCII that
+// json.Marshal doesn't escape, the common case, are written directly; others are
+// encoded with json.Marshal to match its escaping exactly.
+func writeJSONString(w *bufio.Writer, s string) {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c >= 0x7f || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			_ = writeJSONValue(w, s)
+			return
+		}
+	}
+	w.WriteByte('"')
+	w.WriteString(s)
+	w.WriteByte('"')
+}
+
+// writeJSONValue writes the json.Marshal encoding of v to w.
+func writeJSONValue(w io.Writer, v interface{}) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(encoded)
+	return err
+}
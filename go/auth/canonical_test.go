@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sortKeys is the previous canonicalization, which copies data into a sorted
+// structure before marshaling it. It is kept as the reference the streaming
+// encoder must match byte for byte.
+func sortKeys(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		sortedMap := make(map[string]interface{})
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			sortedMap[k] = sortKeys(v[k])
+		}
+		return sortedMap
+	case []interface{}:
+		sortedSlice := make([]interface{}, len(v))
+		for i, elem := range v {
+			sortedSlice[i] = sortKeys(elem)
+		}
+		return sortedSlice
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	case *big.Float:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	default:
+		return data
+	}
+}
+
+func referenceHash(t testing.TB, data map[string]interface{}) []byte {
+	encoded, err := json.Marshal(sortKeys(data))
+	require.NoError(t, err)
+	hash := sha256.Sum256(encoded)
+	return hash[:]
+}
+
+// largeRequestData returns request data with an array of n objects, like the body
+// of a batch endpoint.
+func largeRequestData(n int) map[string]interface{} {
+	items := make([]interface{}, n)
+	for i := range items {
+		items[i] = map[string]interface{}{
+			"to":    fmt.Sprintf("0x%040x", i),
+			"value": big.NewInt(int64(i) * 1e12),
+			"data":  "0x",
+			"meta":  map[string]interface{}{"index": i, "tags": []interface{}{"a", "b"}},
+		}
+	}
+	return map[string]interface{}{"network": "base", "calls": items}
+}
+
+func TestHashCanonicalJSONMatchesReference(t *testing.T) {
+	var nilInt *big.Int
+	tests := map[string]map[string]interface{}{
+		"flat": {"b": 1, "a": "x", "c": true, "d": nil},
+		"nested": {
+			"z": map[string]interface{}{"y": []interface{}{3, map[string]interface{}{"q": 1, "p": 2}}, "x": 1.5},
+			"a": []interface{}{},
+		},
+		"big numbers": {"int": new(big.Int).Lsh(big.NewInt(1), 200), "float": big.NewFloat(1.25), "nil": nilInt},
+		"escaping":    {"<html>": "a&b", "unicode": "héllo ", "quote\"": "\\"},
+		"nil collections": {
+			"map":   map[string]interface{}(nil),
+			"slice": []interface{}(nil),
+		},
+		"typed values": {"strings": []string{"b", "a"}, "map": map[string]int{"b": 1, "a": 2}, "struct": struct{ B, A int }{1, 2}},
+		"large":        largeRequestData(100),
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			hash, err := HashCanonicalJSON(data)
+			require.NoError(t, err)
+			assert.Equal(t, referenceHash(t, data), hash)
+		})
+	}
+}
+
+func TestHashCanonicalJSONUnsupportedValue(t *testing.T) {
+	_, err := HashCanonicalJSON(map[string]interface{}{"a": []interface{}{math.NaN()}})
+	assert.Error(t, err)
+}
+
+func BenchmarkCanonicalJSON(b *testing.B) {
+	data := largeRequestData(10000)
+
+	b.Run("sortKeys", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			referenceHash(b, data)
+		}
+	})
+	b.Run("streaming", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			if _, err := HashCanonicalJSON(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
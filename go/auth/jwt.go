@@ -4,23 +4,38 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/rand"
-	"crypto/sha256"
+	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
-	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
-	"math/big"
-	"sort"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// ErrEntropy is returned when reading random bytes for a JWT nonce fails. Such
+// failures are transient, so generating the JWT again may succeed.
+var ErrEntropy = errors.New("failed to read random bytes")
+
+// randReader is the source of randomness for JWT nonces.
+var randReader io.Reader = rand.Reader
+
+// generateNonce returns 16 random bytes for use as a JWT nonce.
+func generateNonce() ([]byte, error) {
+	nonce := make([]byte, 16)
+	if _, err := io.ReadFull(randReader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w: %w", ErrEntropy, err)
+	}
+	return nonce, nil
+}
+
 // GenerateJWT generates a JWT (Bearer token) for authenticating with Coinbase's APIs.
-// Supports both EC (ES256) and Ed25519 (EdDSA) keys. Also supports JWTs meant for
+// Supports EC (ES256), Ed25519 (EdDSA) and RSA (RS256 or PS256) keys. Also supports JWTs meant for
 // websocket connections by allowing RequestMethod, RequestHost, and RequestPath to all be
 // empty strings, in which case the 'uris' claim is omitted from the JWT.
 func GenerateJWT(options JwtOptions) (string, error) {
@@ -46,7 +61,16 @@ func GenerateJWT(options JwtOptions) (string, error) {
 		options.ExpiresIn = 120
 	}
 
-	now := time.Now()
+	notBefore := options.NotBefore
+	if notBefore.IsZero() {
+		notBefore = time.Now()
+	}
+	expiresAt := options.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = notBefore.Add(time.Duration(options.ExpiresIn) * time.Second)
+	} else if expiresAt.Unix() <= notBefore.Unix() {
+		return "", fmt.Errorf("expiration time %s must be after not-before time %s", expiresAt.UTC().Format(time.RFC3339), notBefore.UTC().Format(time.RFC3339))
+	}
 
 	// Generate URI for REST API requests
 	var uri string
@@ -55,18 +79,18 @@ func GenerateJWT(options JwtOptions) (string, error) {
 	}
 
 	// Generate random nonce
-	nonceBytes := make([]byte, 16)
-	if _, err := rand.Read(nonceBytes); err != nil {
-		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	nonceBytes, err := generateNonce()
+	if err != nil {
+		return "", err
 	}
 
 	// Create common claims
 	claims := jwt.MapClaims{
 		"sub": options.KeyID,
 		"iss": "cdp",
-		"nbf": now.Unix(),
-		"iat": now.Unix(),
-		"exp": now.Add(time.Duration(options.ExpiresIn) * time.Second).Unix(),
+		"nbf": notBefore.Unix(),
+		"iat": notBefore.Unix(),
+		"exp": expiresAt.Unix(),
 	}
 
 	// Use provided audience if available
@@ -79,14 +103,50 @@ func GenerateJWT(options JwtOptions) (string, error) {
 		claims["uris"] = []string{uri}
 	}
 
-	// Create and sign JWT based on key type
-	if isValidECKey(options.KeySecret) {
+	// Create and sign JWT based on the algorithm, detected from the key type unless
+	// set explicitly
+	algorithm, err := signingAlgorithm(options)
+	if err != nil {
+		return "", err
+	}
+	switch algorithm {
+	case jwt.SigningMethodES256.Alg():
 		return buildECJWT(options, claims, nonceBytes)
-	} else if isValidEd25519Key(options.KeySecret) {
+	case jwt.SigningMethodEdDSA.Alg():
 		return buildEdwardsJWT(options, claims, nonceBytes)
+	case jwt.SigningMethodRS256.Alg():
+		return buildRSAJWT(options, jwt.SigningMethodRS256, claims, nonceBytes)
+	case jwt.SigningMethodPS256.Alg():
+		return buildRSAJWT(options, jwt.SigningMethodPS256, claims, nonceBytes)
+	}
+	return "", errInvalidKeyFormat
+}
+
+// errInvalidKeyFormat is returned for API key secrets of no supported type.
+var errInvalidKeyFormat = errors.New("invalid key format - must be either PEM EC key, PEM RSA key or base64 Ed25519 key")
+
+// signingAlgorithm returns the algorithm to sign a JWT with: options.Algorithm if
+// it is set and suits the key, or else the algorithm detected from the key.
+func signingAlgorithm(options JwtOptions) (string, error) {
+	if options.Algorithm == "" {
+		return KeyAlgorithm(options.KeySecret)
 	}
 
-	return "", errors.New("invalid key format - must be either PEM EC key or base64 Ed25519 key")
+	var valid bool
+	switch options.Algorithm {
+	case jwt.SigningMethodES256.Alg():
+		valid = isValidECKey(options.KeySecret)
+	case jwt.SigningMethodEdDSA.Alg():
+		valid = isValidEd25519Key(options.KeySecret)
+	case jwt.SigningMethodRS256.Alg(), jwt.SigningMethodPS256.Alg():
+		valid = isValidRSAKey(options.KeySecret)
+	default:
+		return "", fmt.Errorf("unsupported algorithm %q - must be ES256, EdDSA, RS256 or PS256", options.Algorithm)
+	}
+	if !valid {
+		return "", fmt.Errorf("invalid key format for algorithm %s", options.Algorithm)
+	}
+	return options.Algorithm, nil
 }
 
 // GenerateWalletJWT generates a wallet authentication JWT for the given API endpoint URL.
@@ -116,10 +176,19 @@ func GenerateWalletJWT(options WalletJwtOptions) (string, error) {
 		return "", fmt.Errorf("private key is not an ECDSA key")
 	}
 
-	// Generate random nonce
-	nonceBytes := make([]byte, 16)
-	if _, err := rand.Read(nonceBytes); err != nil {
-		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	var jti string
+	if options.JTIProvider != nil {
+		jti = options.JTIProvider()
+		if jti == "" {
+			return "", errors.New("JTIProvider returned an empty jti")
+		}
+	} else {
+		// Generate random nonce
+		nonceBytes, err := generateNonce()
+		if err != nil {
+			return "", err
+		}
+		jti = hex.EncodeToString(nonceBytes)
 	}
 
 	claims := WalletAuthClaims{
@@ -127,24 +196,19 @@ func GenerateWalletJWT(options WalletJwtOptions) (string, error) {
 		RegisteredClaims: jwt.RegisteredClaims{
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
-			ID:        hex.EncodeToString(nonceBytes),
+			ID:        jti,
 		},
 	}
 
-	// Hash the request data if present
-	if len(options.RequestData) > 0 {
-		// Sort the request data keys
-		sortedData := sortKeys(options.RequestData)
+	requestData := excludeFields(options.RequestData, options.ExcludeFields)
 
-		// Convert to JSON with sorted keys
-		jsonBytes, err := json.Marshal(sortedData)
+	// Hash the canonical JSON encoding of the request data if present
+	if len(requestData) > 0 {
+		hash, err := HashCanonicalJSON(requestData)
 		if err != nil {
 			return "", fmt.Errorf("failed to marshal request data: %w", err)
 		}
-
-		// Hash the JSON using SHA-256
-		hash := sha256.Sum256(jsonBytes)
-		claims.ReqHash = hex.EncodeToString(hash[:])
+		claims.ReqHash = hex.EncodeToString(hash)
 	}
 
 	// Create the token
@@ -160,6 +224,22 @@ func GenerateWalletJWT(options WalletJwtOptions) (string, error) {
 	return signedToken, nil
 }
 
+// KeyAlgorithm returns the JWT signing algorithm GenerateJWT uses for keySecret
+// unless JwtOptions.Algorithm is set: "ES256" for a PEM-encoded EC key, "EdDSA"
+// for a base64-encoded Ed25519 key, or "RS256" for a PEM-encoded RSA key. It
+// returns an error if keySecret is none of these.
+func KeyAlgorithm(keySecret string) (string, error) {
+	switch {
+	case isValidECKey(keySecret):
+		return jwt.SigningMethodES256.Alg(), nil
+	case isValidEd25519Key(keySecret):
+		return jwt.SigningMethodEdDSA.Alg(), nil
+	case isValidRSAKey(keySecret):
+		return jwt.SigningMethodRS256.Alg(), nil
+	}
+	return "", errInvalidKeyFormat
+}
+
 // isValidEd25519Key checks if a string could be a valid Ed25519 key.
 func isValidEd25519Key(str string) bool {
 	decoded, err := base64.StdEncoding.DecodeString(str)
@@ -185,6 +265,34 @@ func isValidECKey(str string) bool {
 	return key != nil
 }
 
+// isValidRSAKey checks if a string is a valid RSA private key in PEM format.
+func isValidRSAKey(str string) bool {
+	_, err := parseRSAKey(str)
+	return err == nil
+}
+
+// parseRSAKey parses a PEM-encoded RSA private key, in PKCS #1 ("RSA PRIVATE KEY")
+// or PKCS #8 ("PRIVATE KEY") form.
+func parseRSAKey(str string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(str))
+	if block == nil {
+		return nil, errors.New("failed to parse PEM block")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
 // buildECJWT builds a JWT using an EC key.
 func buildECJWT(options JwtOptions, claims jwt.MapClaims, nonce []byte) (string, error) {
 	// Parse the private key
@@ -241,49 +349,86 @@ func buildEdwardsJWT(options JwtOptions, claims jwt.MapClaims, nonce []byte) (st
 	return signedToken, nil
 }
 
-// sortKeys recursively sorts all keys in a map or slice of maps.
-// It also handles special numeric types like *big.Int and *big.Float by converting them to strings.
-func sortKeys(data interface{}) interface{} {
-	switch v := data.(type) {
-	case map[string]interface{}:
-		// Create a new map with sorted keys
-		sortedMap := make(map[string]interface{})
-		keys := make([]string, 0, len(v))
-		for k := range v {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
+// buildRSAJWT builds a JWT using an RSA key and method, RS256 or PS256.
+func buildRSAJWT(options JwtOptions, method jwt.SigningMethod, claims jwt.MapClaims, nonce []byte) (string, error) {
+	privateKey, err := parseRSAKey(options.KeySecret)
+	if err != nil {
+		return "", err
+	}
 
-		// Recursively sort nested structures
-		for _, k := range keys {
-			sortedMap[k] = sortKeys(v[k])
-		}
-		return sortedMap
+	// Create the token
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = options.KeyID
+	token.Header["nonce"] = hex.EncodeToString(nonce)
 
-	case []interface{}:
-		// Recursively sort elements in the slice
-		sortedSlice := make([]interface{}, len(v))
-		for i, elem := range v {
-			sortedSlice[i] = sortKeys(elem)
-		}
-		return sortedSlice
+	// Sign the token
+	signedToken, err := token.SignedString(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
 
-	case *big.Int:
-		// Convert *big.Int to string to ensure consistent JSON marshaling
-		if v == nil {
-			return nil
-		}
-		return v.String()
+	return signedToken, nil
+}
 
-	case *big.Float:
-		// Convert *big.Float to string to ensure consistent JSON marshaling
-		if v == nil {
-			return nil
-		}
-		return v.String()
+// TokenExpiry returns the expiry time from the "exp" claim of a JWT, such as one
+// returned by GenerateJWT. The signature is not verified, so the result must not
+// be used to decide whether to trust a token. Wallet JWTs carry no "exp" claim.
+func TokenExpiry(token string) (time.Time, error) {
+	claims := jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse token: %w", err)
+	}
+	if claims.ExpiresAt == nil {
+		return time.Time{}, errors.New("token has no exp claim")
+	}
+	return claims.ExpiresAt.Time, nil
+}
 
-	default:
-		// Return primitive types as-is
+// DecodeClaims returns the claims of a JWT, such as one returned by GenerateJWT or
+// GenerateWalletJWT, for inspection in tests and debugging. The signature is not
+// verified, so the result must not be used to decide whether to trust a token.
+// Numeric claims such as "exp" are decoded as float64.
+func DecodeClaims(token string) (map[string]interface{}, error) {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	return claims, nil
+}
+
+// DecodeHeader returns the header of a JWT, such as its "kid" and "nonce", for
+// inspection in tests and debugging. Like DecodeClaims, it does not verify the
+// signature.
+func DecodeHeader(token string) (map[string]interface{}, error) {
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	return parsed.Header, nil
+}
+
+// excludeFields returns a copy of data without the given dot-separated field
+// paths. Maps along excluded paths are copied, so data itself is not modified.
+func excludeFields(data map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
 		return data
 	}
+
+	result := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		result[k] = v
+	}
+
+	for _, field := range fields {
+		key, rest, nested := strings.Cut(field, ".")
+		if !nested {
+			delete(result, key)
+			continue
+		}
+		if child, ok := result[key].(map[string]interface{}); ok {
+			result[key] = excludeFields(child, []string{rest})
+		}
+	}
+
+	return result
 }
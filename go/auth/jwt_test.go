@@ -5,13 +5,17 @@ import (
 	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"math/big"
 	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
@@ -169,6 +173,70 @@ func TestGenerateJWT(t *testing.T) {
 		assert.Equal(t, int64(300), exp-nbf)
 	})
 
+	t.Run("pins absolute validity times", func(t *testing.T) {
+		options := defaultOptions
+		options.KeySecret = ecKey
+		options.NotBefore = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		options.ExpiresAt = options.NotBefore.Add(10 * time.Minute)
+		options.ExpiresIn = 30
+
+		token, err := GenerateJWT(options)
+		require.NoError(t, err)
+
+		claims, err := DecodeClaims(token)
+		require.NoError(t, err)
+		assert.Equal(t, float64(options.NotBefore.Unix()), claims["nbf"])
+		assert.Equal(t, float64(options.NotBefore.Unix()), claims["iat"])
+		assert.Equal(t, float64(options.ExpiresAt.Unix()), claims["exp"])
+	})
+
+	t.Run("counts ExpiresIn from NotBefore", func(t *testing.T) {
+		options := defaultOptions
+		options.KeySecret = ed25519Key
+		options.NotBefore = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		options.ExpiresIn = 300
+
+		token, err := GenerateJWT(options)
+		require.NoError(t, err)
+
+		claims, err := DecodeClaims(token)
+		require.NoError(t, err)
+		assert.Equal(t, float64(options.NotBefore.Add(300*time.Second).Unix()), claims["exp"])
+	})
+
+	t.Run("uses ExpiresAt with the current time as NotBefore", func(t *testing.T) {
+		options := defaultOptions
+		options.KeySecret = ecKey
+		options.ExpiresAt = time.Now().Add(time.Hour)
+
+		token, err := GenerateJWT(options)
+		require.NoError(t, err)
+
+		claims, err := DecodeClaims(token)
+		require.NoError(t, err)
+		assert.Equal(t, float64(options.ExpiresAt.Unix()), claims["exp"])
+		assert.InDelta(t, float64(time.Now().Unix()), claims["nbf"], 2)
+	})
+
+	t.Run("rejects ExpiresAt not after NotBefore", func(t *testing.T) {
+		options := defaultOptions
+		options.KeySecret = ecKey
+		options.NotBefore = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		options.ExpiresAt = options.NotBefore
+		_, err := GenerateJWT(options)
+		assert.ErrorContains(t, err, "must be after not-before time")
+
+		options.ExpiresAt = options.NotBefore.Add(-time.Minute)
+		_, err = GenerateJWT(options)
+		assert.Error(t, err)
+
+		options.NotBefore = time.Time{}
+		options.ExpiresAt = time.Now().Add(-time.Minute)
+		_, err = GenerateJWT(options)
+		assert.Error(t, err)
+	})
+
 	t.Run("rejects mixed empty and non-empty request parameters", func(t *testing.T) {
 		options := JwtOptions{
 			KeyID:         "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
@@ -203,6 +271,104 @@ func TestGenerateJWT(t *testing.T) {
 	})
 }
 
+// generateTestRSAKey returns a PEM-encoded RSA key in PKCS #1 form, or in PKCS #8
+// form if pkcs8 is true, and its public key.
+func generateTestRSAKey(t *testing.T, pkcs8 bool) (string, *rsa.PublicKey) {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}
+	if pkcs8 {
+		block.Type = "PRIVATE KEY"
+		block.Bytes, err = x509.MarshalPKCS8PrivateKey(privateKey)
+		require.NoError(t, err)
+	}
+	return string(pem.EncodeToMemory(block)), &privateKey.PublicKey
+}
+
+func TestGenerateJWTWithRSAKey(t *testing.T) {
+	options := JwtOptions{
+		KeyID:         "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+		RequestMethod: "GET",
+		RequestHost:   "api.cdp.coinbase.com",
+		RequestPath:   "/platform/v1/wallets",
+	}
+
+	for _, encoding := range []struct {
+		name  string
+		pkcs8 bool
+	}{{"PKCS1", false}, {"PKCS8", true}} {
+		secret, publicKey := generateTestRSAKey(t, encoding.pkcs8)
+
+		for _, tt := range []struct{ algorithm, want string }{
+			{"", "RS256"},
+			{"RS256", "RS256"},
+			{"PS256", "PS256"},
+		} {
+			t.Run(encoding.name+" "+tt.want, func(t *testing.T) {
+				options := options
+				options.KeySecret = secret
+				options.Algorithm = tt.algorithm
+
+				token, err := GenerateJWT(options)
+				require.NoError(t, err)
+
+				parsed, err := jwt.Parse(token, func(*jwt.Token) (interface{}, error) {
+					return publicKey, nil
+				}, jwt.WithValidMethods([]string{tt.want}))
+				require.NoError(t, err)
+				assert.Equal(t, options.KeyID, parsed.Header["kid"])
+				assert.NotEmpty(t, parsed.Header["nonce"])
+
+				claims, ok := parsed.Claims.(jwt.MapClaims)
+				require.True(t, ok, "expected claims to be jwt.MapClaims")
+				assert.Equal(t, options.KeyID, claims["sub"])
+				assert.Equal(t, []interface{}{"GET api.cdp.coinbase.com/platform/v1/wallets"}, claims["uris"])
+			})
+		}
+	}
+}
+
+func TestGenerateJWTAlgorithm(t *testing.T) {
+	rsaKey, _ := generateTestRSAKey(t, false)
+	options := JwtOptions{KeyID: "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"}
+
+	t.Run("accepts the algorithm of the key", func(t *testing.T) {
+		for algorithm, secret := range map[string]string{"ES256": generateTestECKey(t), "EdDSA": generateTestEd25519Key(t)} {
+			options := options
+			options.KeySecret = secret
+			options.Algorithm = algorithm
+
+			token, err := GenerateJWT(options)
+			require.NoError(t, err)
+			header, err := DecodeHeader(token)
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, header["alg"])
+		}
+	})
+
+	t.Run("rejects an algorithm that does not suit the key", func(t *testing.T) {
+		for algorithm, secret := range map[string]string{"ES256": rsaKey, "RS256": generateTestECKey(t), "PS256": generateTestEd25519Key(t), "EdDSA": rsaKey} {
+			options := options
+			options.KeySecret = secret
+			options.Algorithm = algorithm
+
+			_, err := GenerateJWT(options)
+			assert.ErrorContains(t, err, "invalid key format for algorithm "+algorithm)
+		}
+	})
+
+	t.Run("rejects unsupported algorithms", func(t *testing.T) {
+		options := options
+		options.KeySecret = rsaKey
+		options.Algorithm = "HS256"
+
+		_, err := GenerateJWT(options)
+		assert.ErrorContains(t, err, "unsupported algorithm")
+	})
+}
+
 func TestGenerateWalletJWT(t *testing.T) {
 	walletAuthKey := generateTestWalletAuthKey(t)
 
@@ -396,4 +562,187 @@ func TestGenerateWalletJWT(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, tokenWithNil)
 	})
+
+	t.Run("excludes fields from the request hash", func(t *testing.T) {
+		reqHash := func(options WalletJwtOptions) string {
+			token, err := GenerateWalletJWT(options)
+			require.NoError(t, err)
+			parsedToken, _ := jwt.Parse(token, func(_ *jwt.Token) (interface{}, error) {
+				return nil, jwt.ErrInvalidKeyType
+			})
+			claims, _ := parsedToken.Claims.(jwt.MapClaims)
+			return claims["reqHash"].(string)
+		}
+
+		full := defaultOptions
+		full.RequestData = map[string]interface{}{
+			"name":    "My Account",
+			"traceId": "abc",
+			"nested":  map[string]interface{}{"keep": 1, "drop": 2},
+		}
+
+		masked := full
+		masked.ExcludeFields = []string{"traceId", "nested.drop"}
+
+		stripped := defaultOptions
+		stripped.RequestData = map[string]interface{}{
+			"name":   "My Account",
+			"nested": map[string]interface{}{"keep": 1},
+		}
+
+		assert.NotEqual(t, reqHash(full), reqHash(masked), "excluding a field should change the hash")
+		assert.Equal(t, reqHash(stripped), reqHash(masked), "masked data should hash like data without the excluded fields")
+		assert.Contains(t, full.RequestData, "traceId", "the caller's request data should not be modified")
+		assert.Contains(t, full.RequestData["nested"], "drop", "the caller's nested request data should not be modified")
+	})
+
+	t.Run("uses the jti from JTIProvider", func(t *testing.T) {
+		options := defaultOptions
+		options.JTIProvider = func() string { return "audit-000042" }
+
+		token, err := GenerateWalletJWT(options)
+		require.NoError(t, err)
+
+		parsedToken, _ := jwt.Parse(token, func(_ *jwt.Token) (interface{}, error) {
+			return nil, jwt.ErrInvalidKeyType
+		})
+		claims, _ := parsedToken.Claims.(jwt.MapClaims)
+		assert.Equal(t, "audit-000042", claims["jti"])
+	})
+
+	t.Run("rejects an empty jti from JTIProvider", func(t *testing.T) {
+		options := defaultOptions
+		options.JTIProvider = func() string { return "" }
+
+		_, err := GenerateWalletJWT(options)
+		assert.Error(t, err)
+	})
+}
+
+func TestTokenExpiry(t *testing.T) {
+	t.Run("returns the exp claim of a generated token", func(t *testing.T) {
+		before := time.Now()
+		token, err := GenerateJWT(JwtOptions{
+			KeyID:         "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			KeySecret:     generateTestECKey(t),
+			RequestMethod: "GET",
+			RequestHost:   "api.cdp.coinbase.com",
+			RequestPath:   "/platform/v2/evm/accounts",
+			ExpiresIn:     300,
+		})
+		require.NoError(t, err)
+
+		expiry, err := TokenExpiry(token)
+		require.NoError(t, err)
+		assert.WithinDuration(t, before.Add(300*time.Second), expiry, 2*time.Second)
+	})
+
+	t.Run("errors for tokens without an exp claim", func(t *testing.T) {
+		token, err := GenerateWalletJWT(WalletJwtOptions{
+			WalletSecret:  generateTestWalletAuthKey(t),
+			RequestMethod: "POST",
+			RequestHost:   "api.cdp.coinbase.com",
+			RequestPath:   "/platform/v2/evm/accounts",
+		})
+		require.NoError(t, err)
+
+		_, err = TokenExpiry(token)
+		assert.Error(t, err)
+	})
+
+	t.Run("errors for malformed tokens", func(t *testing.T) {
+		_, err := TokenExpiry("not-a-jwt")
+		assert.Error(t, err)
+	})
+}
+
+func TestGenerateJWTEntropyFailure(t *testing.T) {
+	original := randReader
+	randReader = iotest.ErrReader(errors.New("entropy unavailable"))
+	t.Cleanup(func() { randReader = original })
+
+	_, err := GenerateJWT(JwtOptions{
+		KeyID:         "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+		KeySecret:     generateTestECKey(t),
+		RequestMethod: "GET",
+		RequestHost:   "api.cdp.coinbase.com",
+		RequestPath:   "/platform/v2/evm/accounts",
+	})
+	assert.ErrorIs(t, err, ErrEntropy)
+
+	_, err = GenerateWalletJWT(WalletJwtOptions{
+		WalletSecret:  generateTestWalletAuthKey(t),
+		RequestMethod: "POST",
+		RequestHost:   "api.cdp.coinbase.com",
+		RequestPath:   "/platform/v2/evm/accounts",
+	})
+	assert.ErrorIs(t, err, ErrEntropy)
+}
+
+func TestKeyAlgorithm(t *testing.T) {
+	alg, err := KeyAlgorithm(generateTestECKey(t))
+	require.NoError(t, err)
+	assert.Equal(t, "ES256", alg)
+
+	alg, err = KeyAlgorithm(generateTestEd25519Key(t))
+	require.NoError(t, err)
+	assert.Equal(t, "EdDSA", alg)
+
+	for _, pkcs8 := range []bool{false, true} {
+		rsaKey, _ := generateTestRSAKey(t, pkcs8)
+		alg, err = KeyAlgorithm(rsaKey)
+		require.NoError(t, err)
+		assert.Equal(t, "RS256", alg)
+	}
+
+	_, err = KeyAlgorithm("not-a-key")
+	assert.Error(t, err)
+}
+
+func TestDecodeClaims(t *testing.T) {
+	t.Run("returns the standard claims of a generated token", func(t *testing.T) {
+		before := time.Now()
+		token, err := GenerateJWT(JwtOptions{
+			KeyID:         "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			KeySecret:     generateTestECKey(t),
+			RequestMethod: "GET",
+			RequestHost:   "api.cdp.coinbase.com",
+			RequestPath:   "/platform/v2/evm/accounts",
+		})
+		require.NoError(t, err)
+
+		claims, err := DecodeClaims(token)
+		require.NoError(t, err)
+		assert.Equal(t, "cdp", claims["iss"])
+		assert.Equal(t, "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", claims["sub"])
+		assert.Equal(t, []interface{}{"GET api.cdp.coinbase.com/platform/v2/evm/accounts"}, claims["uris"])
+		assert.InDelta(t, float64(before.Add(120*time.Second).Unix()), claims["exp"], 2)
+		assert.InDelta(t, float64(before.Unix()), claims["nbf"], 2)
+	})
+
+	t.Run("errors for malformed tokens", func(t *testing.T) {
+		_, err := DecodeClaims("not-a-jwt")
+		assert.Error(t, err)
+	})
+}
+
+func TestDecodeHeader(t *testing.T) {
+	t.Run("returns the key ID and nonce of a generated token", func(t *testing.T) {
+		token, err := GenerateJWT(JwtOptions{
+			KeyID:     "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			KeySecret: generateTestECKey(t),
+		})
+		require.NoError(t, err)
+
+		header, err := DecodeHeader(token)
+		require.NoError(t, err)
+		assert.Equal(t, "ES256", header["alg"])
+		assert.Equal(t, "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", header["kid"])
+		assert.Len(t, header["nonce"], 32)
+	})
+
+	t.Run("errors for malformed tokens", func(t *testing.T) {
+		_, err := DecodeHeader("not-a-jwt")
+		assert.Error(t, err)
+	})
 }
@@ -0,0 +1,129 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrInsufficientBalance is returned by BatchTransfer when the account doesn't hold
+// enough of an asset to cover the transfers of it.
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+// userOperationOverheadGas is a conservative estimate of the gas a user operation
+// uses beyond that of its calls, for account validation and the bundler's overhead.
+const userOperationOverheadGas = 150_000
+
+// BatchTransferItem is one transfer of a BatchTransfer.
+type BatchTransferItem struct {
+	// To is the recipient's address.
+	To string
+	// Amount is the amount to send, in the token's smallest unit.
+	Amount *big.Int
+	// Token is the network's native token symbol, the symbol of a token known to
+	// the SDK, or an ERC-20 contract address, as in NetworkScopedEvmAccount.Transfer.
+	Token string
+}
+
+// BatchTransfer sends transfers, which may mix the native token and ERC-20 tokens,
+// atomically in a single user operation, and returns its hash. Before sending, it
+// checks that the account holds enough of each token to cover all transfers of it,
+// and enough of the native token to also cover the operation's estimated gas
+// unless a paymaster sponsors it; otherwise it returns an error wrapping
+// ErrInsufficientBalance and sends nothing.
+func (s *NetworkScopedSmartAccount) BatchTransfer(ctx context.Context, transfers []BatchTransferItem, opts UserOperationOptions) (string, error) {
+	if err := s.client.checkWritable(); err != nil {
+		return "", err
+	}
+	if len(transfers) == 0 {
+		return "", errors.New("no transfers to send")
+	}
+
+	native := nativeSymbol(s.Network)
+	txs := make([]TransactionRequest, len(transfers))
+	calls := make([]openapi.EvmCall, len(transfers))
+	totals := map[string]*big.Int{}
+	var assets []string
+	for i, transfer := range transfers {
+		tx, err := transferTransaction(s.Network, transfer.To, transfer.Amount, transfer.Token)
+		if err != nil {
+			return "", fmt.Errorf("transfer %d: %w", i, err)
+		}
+		txs[i] = tx
+
+		asset := native
+		if tx.Value == nil {
+			asset = strings.ToLower(tx.To)
+		}
+		if totals[asset] == nil {
+			totals[asset] = new(big.Int)
+			assets = append(assets, asset)
+		}
+		totals[asset].Add(totals[asset], transfer.Amount)
+
+		if calls[i], err = s.client.NewEvmCall(s.Network, tx.To, tx.Value, tx.Data); err != nil {
+			return "", fmt.Errorf("transfer %d: %w", i, err)
+		}
+	}
+
+	// Check the transfers themselves first: estimating the gas of a token
+	// transfer the account cannot cover fails with an opaque revert.
+	balances := map[string]*big.Int{}
+	for _, asset := range assets {
+		balance, err := s.client.balanceOf(ctx, s.Network, s.Address, asset)
+		if err != nil {
+			return "", err
+		}
+		if balance.Cmp(totals[asset]) < 0 {
+			return "", fmt.Errorf("%w: %s balance %s is less than the %s needed", ErrInsufficientBalance, asset, balance, totals[asset])
+		}
+		balances[asset] = balance
+	}
+
+	if !s.gasSponsored(opts) {
+		gas := big.NewInt(userOperationOverheadGas)
+		for i, tx := range txs {
+			callGas := big.NewInt(transferGas)
+			if tx.Value == nil {
+				var err error
+				if callGas, err = s.client.estimateGas(ctx, s.Network, s.Address, tx); err != nil {
+					return "", fmt.Errorf("transfer %d: %w", i, err)
+				}
+			}
+			gas.Add(gas, callGas)
+		}
+		maxFeePerGas, _, err := s.client.estimateGasFees(ctx, s.Network)
+		if err != nil {
+			return "", err
+		}
+		needed := gas.Mul(gas, maxFeePerGas)
+		if totals[native] != nil {
+			needed.Add(needed, totals[native])
+		}
+		balance := balances[native]
+		if balance == nil {
+			if balance, err = s.client.balanceOf(ctx, s.Network, s.Address, native); err != nil {
+				return "", err
+			}
+		}
+		if balance.Cmp(needed) < 0 {
+			return "", fmt.Errorf("%w: %s balance %s is less than the %s needed", ErrInsufficientBalance, native, balance, needed)
+		}
+	}
+
+	op, err := s.SendUserOperation(ctx, calls, opts)
+	if err != nil {
+		return "", err
+	}
+	return op.UserOpHash, nil
+}
+
+// gasSponsored reports whether the gas of a user operation sent with opts is paid
+// by a paymaster rather than by the account.
+func (s *NetworkScopedSmartAccount) gasSponsored(opts UserOperationOptions) bool {
+	return s.paymasterFor(opts) != "" || s.Network == "base-sepolia"
+}
@@ -0,0 +1,115 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBatchTransfer(t *testing.T) {
+	// One native and two USDC transfers, plus the user operation overhead, at twice
+	// the 1 gwei gas price served by newBalanceRPCServer.
+	const fee = 2 * (userOperationOverheadGas + 21000 + 2*65000) * 1e9
+	transfers := []BatchTransferItem{
+		{To: testRecipient, Amount: big.NewInt(1e15), Token: "eth"},
+		{To: testRecipient, Amount: big.NewInt(600), Token: "usdc"},
+		{To: testOtherOwner, Amount: big.NewInt(400), Token: "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913"},
+	}
+
+	tests := []struct {
+		name                        string
+		network                     string
+		opts                        UserOperationOptions
+		nativeBalance, tokenBalance int64
+		wantErr                     string
+	}{
+		{"sufficient balances", "base", UserOperationOptions{}, 1e15 + fee, 1000, ""},
+		{"native short of gas", "base", UserOperationOptions{}, 1e15 + fee - 1, 1000, "ETH balance"},
+		{"token short", "base", UserOperationOptions{}, 1e18, 999, "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913 balance"},
+		{"gas sponsored by a paymaster", "base", UserOperationOptions{PaymasterURL: "https://paymaster.example"}, 1e15, 1000, ""},
+		{"gas sponsored on base-sepolia", "base-sepolia", UserOperationOptions{}, 1e15, 1000, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, _, networks := newScopedSmartAccountServers(t)
+			rpc := newBalanceRPCServer(t, tt.nativeBalance, tt.tokenBalance)
+			client := newTestClientWithOptions(t, api.URL, ClientOptions{
+				RPCURLs: map[string]string{tt.network: rpc.URL},
+			})
+
+			items := transfers
+			if tt.network == "base-sepolia" {
+				items = transfers[:2]
+			}
+			account := NewSmartAccount(client, testOwner, testOtherOwner).OnNetwork(tt.network)
+			hash, err := account.BatchTransfer(context.Background(), items, tt.opts)
+			if tt.wantErr != "" {
+				if !errors.Is(err, ErrInsufficientBalance) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected ErrInsufficientBalance mentioning %q, got %v", tt.wantErr, err)
+				}
+				if len(*networks) != 0 {
+					t.Errorf("sent %d user operations, want none", len(*networks))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BatchTransfer returned an error: %v", err)
+			}
+			if hash != "0xop" || len(*networks) != 1 {
+				t.Errorf("BatchTransfer = %q after %d user operations, want 0xop after one", hash, len(*networks))
+			}
+		})
+	}
+}
+
+func TestBatchTransferChecksBalancesBeforeEstimatingGas(t *testing.T) {
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch req.Method {
+		case "eth_getBalance":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, int64(1e18))
+		case "eth_call":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%064x"}`, 999)
+		case "eth_estimateGas":
+			// Nodes revert estimates of transfers the sender cannot cover.
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted: ERC20: transfer amount exceeds balance"}}`)
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+	}))
+	defer rpc.Close()
+	api, _, networks := newScopedSmartAccountServers(t)
+	client := newTestClientWithOptions(t, api.URL, ClientOptions{
+		RPCURLs: map[string]string{"base": rpc.URL},
+	})
+
+	account := NewSmartAccount(client, testOwner, testOtherOwner).OnNetwork("base")
+	_, err := account.BatchTransfer(context.Background(), []BatchTransferItem{{To: testRecipient, Amount: big.NewInt(1000), Token: "usdc"}}, UserOperationOptions{})
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Fatalf("expected ErrInsufficientBalance, got %v", err)
+	}
+	if len(*networks) != 0 {
+		t.Errorf("sent %d user operations, want none", len(*networks))
+	}
+}
+
+func TestBatchTransferInvalid(t *testing.T) {
+	client := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+	account := NewSmartAccount(client, testOwner, testOtherOwner).OnNetwork("base")
+	if _, err := account.BatchTransfer(context.Background(), nil, UserOperationOptions{}); err == nil {
+		t.Error("expected an error for no transfers")
+	}
+	_, err := account.BatchTransfer(context.Background(), []BatchTransferItem{{To: testRecipient, Amount: big.NewInt(1), Token: "nope"}}, UserOperationOptions{})
+	if err == nil || !strings.Contains(err.Error(), "transfer 0") {
+		t.Errorf("expected an error for an unknown token, got %v", err)
+	}
+}
@@ -3,12 +3,17 @@ package cdp
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/coinbase/cdp-sdk/go/auth"
 	"github.com/coinbase/cdp-sdk/go/openapi"
@@ -20,48 +25,401 @@ var (
 	endUserSolanaRe          = regexp.MustCompile(`/end-users/[^/]+/solana$`)
 )
 
+// defaultBasePath is the base URL of the CDP API used when ClientOptions.BasePath
+// is empty.
+const defaultBasePath = "https://api.cdp.coinbase.com/platform"
+
 // ClientOptions contains configuration options for the CDP client.
 type ClientOptions struct {
 	// APIKeyID is the API key ID. Not required to call public (unauthenticated) endpoints.
 	APIKeyID string
 	// APIKeySecret is the API key secret. Not required to call public (unauthenticated) endpoints.
 	APIKeySecret string
+	// APIKeys lists alternative API keys in order of preference, for example an
+	// Ed25519 key and the EC key it replaces while rotating between key types.
+	// NewClient uses the first key whose secret is a valid EC, Ed25519 or RSA key.
+	// APIKeyID and APIKeySecret, if set, take precedence over APIKeys.
+	APIKeys []APIKey
+	// APIKeySecretPath is the path of a file holding the API key secret, as a
+	// PEM-encoded EC or RSA key or a base64-encoded Ed25519 key. NewClient reads
+	// it if APIKeySecret is empty; if both are set, APIKeySecret is used.
+	APIKeySecretPath string
 	// WalletSecret is the wallet secret.
 	WalletSecret string
-	// Debugging enables debug logging when true.
+	// WalletSecretPath is the path of a file holding the wallet secret. NewClient
+	// reads it if WalletSecret is empty; if both are set, WalletSecret is used.
+	WalletSecretPath string
+	// Debugging enables debug logging when true. Each HTTP request attempt is
+	// logged at debug level to Logger, with its method, URL, request headers,
+	// status, duration, and operation and correlation IDs. The Authorization and
+	// X-Wallet-Auth headers are redacted.
 	Debugging bool
-	// BasePath is the host URL to connect to.
+	// Logger receives the client's debug logs. Nil uses slog.Default().
+	Logger *slog.Logger
+	// LogBodies adds request and response bodies to the debug logs when
+	// Debugging is set. Values of JSON fields that look like secrets, such as
+	// "privateKey" or "walletSecret", are redacted. Response bodies are buffered
+	// in memory to be logged.
+	LogBodies bool
+	// LogBodyLimit caps the number of bytes of each logged body. Zero uses
+	// DefaultLogBodyLimit.
+	LogBodyLimit int
+	// BasePath is the host URL to connect to. If empty, it is the base path of
+	// Environment.
 	BasePath string
-	// Optional expiration time in seconds (defaults to 120).
+	// Environment selects the API deployment to connect to when BasePath is empty.
+	// Defaults to EnvironmentProduction.
+	Environment Environment
+	// Optional expiration time in seconds (defaults to 120). WithTokenLifetime
+	// extends it for individual calls.
 	ExpiresIn int64
+	// Audience lists the audiences of the client's API key JWTs (the "aud" claim).
+	// The server must accept at least one of them; several can be given while
+	// requests go through gateways that expect different audiences, such as during
+	// a migration. If empty, tokens have no audience claim.
+	Audience []string
 	// HostOverride overrides the host used for request routing and JWT signing.
 	// This is for internal use only and should not be used by external consumers.
 	HostOverride string
+	// SigningHost is the host JWTs are signed for, without changing the host
+	// requests are sent to. Set it to the CDP API host (e.g. "api.cdp.coinbase.com")
+	// when requests go through a proxy that forwards them there, so that the JWT
+	// matches the host the API sees rather than the proxy's. It takes precedence
+	// over HostOverride and WithRequestHost for signing only.
+	SigningHost string
+	// StrictValidation validates request bodies against the OpenAPI schema (required
+	// fields, enum values, and formats) and returns a *RequestValidationError before
+	// sending an invalid request. It also checks that each request's API key JWT was
+	// issued for the request's actual method, host and path, returning an error
+	// wrapping ErrTokenMismatch if not. Off by default.
+	StrictValidation bool
+	// FaucetCooldown is the minimum time between successful faucet requests for the
+	// same address, network, and token made through Client.RequestFaucet. Zero (the
+	// default) disables cooldown tracking.
+	FaucetCooldown time.Duration
+	// RPCURLs maps network names (e.g. "base") to the JSON-RPC endpoints used by
+	// helpers that read chain state. Networks without an entry use a public endpoint
+	// where one is known; configure a dedicated node for production use.
+	RPCURLs map[string]string
+	// DefaultNetwork is the network (e.g. "base-sepolia") used by helpers that
+	// take a network when it is left empty, such as SmartAccount.SendUserOperation
+	// and EvmAccount.UseNetwork. A non-empty network always takes precedence. It
+	// must be one of the EVM networks known to the SDK.
+	DefaultNetwork string
+	// AmountEncodings maps network names to the encoding of amounts, such as call
+	// values, the API expects on that network. Networks without an entry use
+	// decimal strings.
+	AmountEncodings map[string]AmountEncoding
+	// RetryPredicate decides whether a request is retried, given the response or
+	// the transport error of the last attempt. Nil uses DefaultRetryPredicate.
+	// Authentication errors (401 and 403) should generally not be retried, since
+	// resending the same credentials fails the same way. The predicate is only
+	// consulted for requests that are safe to resend: POST and PATCH requests
+	// without an X-Idempotency-Key header are never retried unless
+	// RetryOptions.RetryNonIdempotent is set.
+	RetryPredicate func(*http.Response, error) bool
+	// MaxRetries is the maximum number of times a request is retried. Zero uses
+	// the default of 3; a negative value disables retries. RetryOptions.MaxRetries
+	// takes precedence if set.
+	MaxRetries int
+	// RetryOptions configures the backoff between retries, which statuses are
+	// retried, and whether non-idempotent requests are retried.
+	RetryOptions RetryOptions
+	// AutoIdempotencyKeys sends an X-Idempotency-Key header derived from the hash
+	// of the request's method, path, query and body with requests to operations
+	// that honor one and don't set one already, so that they are retried and
+	// deduplicated by the server. Since the key depends only on the request,
+	// identical requests made on purpose, such as two equal transfers without
+	// explicit nonces, are deduplicated too; give them distinct keys with
+	// WithIdempotencyKey. See WithIdempotencyKey for the operations that honor it.
+	AutoIdempotencyKeys bool
+	// Timeout bounds each attempt of a request, from sending it until its
+	// response body has been read; a retried request gets a new timeout for every
+	// attempt. An attempt that times out fails with an error matching ErrTimeout,
+	// and is retried like a network error. A shorter deadline on the request's
+	// context takes precedence. Zero uses the default of 30 seconds; a negative
+	// value disables the timeout.
+	Timeout time.Duration
+	// RequestInterceptors are called in order with each API request before it is
+	// sent, and may modify it, for example to add headers. They run once per API
+	// call, after the SDK has set the request's host and before it is validated
+	// and authenticated, so changes are covered by the request's JWTs. An error
+	// fails the call without sending it. They are not called for JSON-RPC
+	// requests to networks.
+	RequestInterceptors []RequestInterceptor
+	// ResponseInterceptors are called in order with the final response to each API
+	// request, after any retries and before the response is decoded, and may
+	// modify it. A response interceptor that reads the body must replace it. An
+	// error fails the call. They are not called if the request fails without a
+	// response, or for JSON-RPC requests to networks.
+	ResponseInterceptors []ResponseInterceptor
+	// WalletJTIProvider optionally supplies the ID of each wallet JWT the client
+	// generates. See auth.WalletJwtOptions.JTIProvider.
+	WalletJTIProvider func() string
+	// WalletAuthMethods are the HTTP methods that are sent with wallet auth on
+	// routes that require it. If empty, POST, PUT and DELETE requests are, which
+	// covers every route the API currently gates. Add "GET" if a read endpoint
+	// requires wallet auth; its JWT then has no request hash, as there is no body.
+	WalletAuthMethods []string
+	// RecipientAllowlist, if not empty, restricts the addresses that transactions
+	// and user operations sent with the client may send to, as a guardrail
+	// independent of server-side policies. Anything else is rejected with
+	// ErrRecipientNotAllowed before it is signed or submitted. Addresses are
+	// matched exactly, ignoring case; wildcards are not supported.
+	//
+	// The recipient of an ERC-20 transfer is the address tokens are sent to, not
+	// the token contract; for any other transaction or call it is the address
+	// called. Contract deployments are rejected.
+	RecipientAllowlist []string
+	// UserOperationPollStrategy decides how often SmartAccount.WaitForUserOperation
+	// polls. Nil uses an AdaptivePollStrategy starting at 250ms and backing off to
+	// 5s.
+	UserOperationPollStrategy PollStrategy
+	// WalletAuthHeaderName is the name of the header that carries wallet JWTs.
+	// Defaults to X-Wallet-Auth; override it only to test against gateways that
+	// expect another name.
+	WalletAuthHeaderName string
+	// MinTLSVersion is the minimum TLS version of the client's connections,
+	// tls.VersionTLS12 or tls.VersionTLS13; older versions are rejected, since the
+	// connections carry signing credentials. If zero, Go's default minimum
+	// applies (TLS 1.2).
+	MinTLSVersion uint16
+	// TokenCache stores API key JWTs for reuse by later requests with the same
+	// method, host and path, so that a token isn't signed for every request. If
+	// nil, an in-memory cache is used unless DisableTokenCache is set. Wallet JWTs
+	// are bound to the request body and are never cached.
+	TokenCache TokenCache
+	// TokenCacheSkew is how long before expiry a cached token is replaced by a new
+	// one, so that it doesn't expire in flight. Defaults to 10 seconds.
+	TokenCacheSkew time.Duration
+	// DisableTokenCache signs a new API key JWT for every request.
+	DisableTokenCache bool
+	// TrackStats counts the client's requests, errors, retries and bytes per
+	// operation, for reporting with Client.Stats. Off by default.
+	TrackStats bool
+	// ManageNonces assigns nonces to transactions sent with
+	// EvmAccount.SendTransaction locally, using the client's NonceManager, and
+	// recovers from "nonce too low" rejections by re-syncing from chain state.
+	// Requires an RPC endpoint for the networks used (see RPCURLs).
+	ManageNonces bool
+	// ReadOnly makes the client refuse operations that create, change or sign
+	// anything, such as creating accounts, sending transactions or requesting
+	// funds, with ErrReadOnly. Such requests are rejected before they are sent,
+	// including those made with the generated API methods.
+	ReadOnly bool
+	// AllowCrossHostRedirects follows redirects to a host other than the one a
+	// request was sent to. By default such redirects are not followed, and the
+	// redirect response is returned instead, so that credentials signed for one
+	// host are never sent to another. When they are followed, the Authorization
+	// and wallet auth headers are removed from the redirected request. Redirects
+	// to the same host are always followed.
+	AllowCrossHostRedirects bool
+	// CredentialProvider, if set, is called for every request attempt to get the
+	// credentials to authenticate it with, so that API keys and the wallet secret
+	// can be rotated without recreating the client. It takes precedence over
+	// APIKeyID, APIKeySecret, APIKeys and WalletSecret, and their files. It may be
+	// called more than once per attempt, so it should return quickly, e.g. by
+	// loading credentials swapped in atomically elsewhere. If it returns an error,
+	// the request fails with an error wrapping it and is not sent.
+	CredentialProvider CredentialProvider
+	// OnTokenIssued, if set, is called with a description of every JWT the client
+	// signs, including those for retried attempts and for WalletAuthHeader and
+	// WebSocketToken, for audit logging. Tokens reused from TokenCache are not
+	// reported again. It is never given the token or the key it was signed with.
+	// It is called synchronously before the request is sent, so it should return
+	// quickly, and must be safe for concurrent use.
+	OnTokenIssued func(TokenMeta)
+	// DisableGasLimitEstimation leaves gas limits that are not set to the API and
+	// the bundler. By default, the gas limit of transactions sent with
+	// EvmAccount.SendTransaction and NetworkScopedEvmAccount.SendTransactions, and
+	// of the transfers built on them, and the OverrideGasLimit of user operation
+	// calls sent with SmartAccount.SendUserOperation, are set to their estimate
+	// from the network's RPC endpoint (see RPCURLs), padded by
+	// GasLimitMultiplier.
+	DisableGasLimitEstimation bool
+	// GasLimitMultiplier multiplies estimated gas limits, to avoid out-of-gas
+	// failures when execution uses more gas than estimated.
+	// Zero uses DefaultGasLimitMultiplier (1.2); otherwise it must be at least 1.
+	GasLimitMultiplier float64
+	// ReconnectPolicy, if set, makes connections opened with DialWebSocket
+	// reconnect, with a fresh JWT, when they drop. If nil, a dropped connection
+	// stays closed.
+	ReconnectPolicy *ReconnectPolicy
+}
+
+// defaultWalletAuthHeaderName is the header that carries wallet JWTs when
+// ClientOptions.WalletAuthHeaderName is empty.
+const defaultWalletAuthHeaderName = "X-Wallet-Auth"
+
+// walletAuthHeaderName returns the name of the header that carries wallet JWTs.
+func walletAuthHeaderName(options ClientOptions) string {
+	if options.WalletAuthHeaderName != "" {
+		return options.WalletAuthHeaderName
+	}
+	return defaultWalletAuthHeaderName
+}
+
+// APIKey is a CDP API key.
+type APIKey struct {
+	// ID is the API key ID.
+	ID string
+	// Secret is the API key secret: a PEM-encoded EC or RSA key or a
+	// base64-encoded Ed25519 key.
+	Secret string
+}
+
+// selectAPIKey returns the first usable key of options, following the precedence
+// documented on ClientOptions.APIKeys.
+func selectAPIKey(options ClientOptions) (APIKey, error) {
+	keys := options.APIKeys
+	if options.APIKeyID != "" || options.APIKeySecret != "" {
+		keys = append([]APIKey{{ID: options.APIKeyID, Secret: options.APIKeySecret}}, keys...)
+	}
+
+	for _, key := range keys {
+		if key.ID == "" {
+			continue
+		}
+		if _, err := auth.KeyAlgorithm(key.Secret); err == nil {
+			return key, nil
+		}
+	}
+	return APIKey{}, errors.New("none of the configured API keys is a valid EC, Ed25519 or RSA key")
+}
+
+// resolveCredentials reads the secret files of options and resolves APIKeys to a
+// single key, so that the rest of the client only needs to look at APIKeyID,
+// APIKeySecret and WalletSecret.
+func resolveCredentials(options *ClientOptions) error {
+	if err := loadSecretFiles(options); err != nil {
+		return err
+	}
+	if len(options.APIKeys) > 0 {
+		key, err := selectAPIKey(*options)
+		if err != nil {
+			return err
+		}
+		options.APIKeyID, options.APIKeySecret = key.ID, key.Secret
+	}
+	return nil
 }
 
 // NewClient creates a new CDP client based on the provided options.
-func NewClient(options ClientOptions) (*openapi.ClientWithResponses, error) {
+// Call Close on the returned client once it is no longer needed.
+func NewClient(options ClientOptions) (*Client, error) {
+	if options.DefaultNetwork != "" {
+		if _, ok := evmNetworks[options.DefaultNetwork]; !ok {
+			return nil, fmt.Errorf("unsupported default network %q", options.DefaultNetwork)
+		}
+	}
+
+	if err := validateGasLimitMultiplier(options.GasLimitMultiplier); err != nil {
+		return nil, err
+	}
+
+	if err := resolveCredentials(&options); err != nil {
+		return nil, err
+	}
+
+	if options.BasePath == "" {
+		basePath, err := BasePathForEnvironment(options.Environment)
+		if err != nil {
+			return nil, err
+		}
+		options.BasePath = basePath
+	}
 	basePath := options.BasePath
-	if basePath == "" {
-		basePath = "https://api.cdp.coinbase.com/platform"
+
+	if options.TokenCache == nil && !options.DisableTokenCache {
+		options.TokenCache = &MemoryTokenCache{}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if options.MinTLSVersion != 0 {
+		if err := checkMinTLSVersion(options.MinTLSVersion); err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{MinVersion: options.MinTLSVersion}
 	}
 
-	opts := []openapi.ClientOption{}
+	ctx, cancel := context.WithCancel(context.Background())
+	retry := newRetryTransport(newTimeoutTransport(newDebugTransport(transport, options), options), options)
+	var next http.RoundTripper = retry
+	if len(options.ResponseInterceptors) > 0 {
+		next = &interceptTransport{next: next, interceptors: options.ResponseInterceptors}
+	}
+	var stats *usageStats
+	if options.TrackStats {
+		stats = &usageStats{operations: map[string]*operationCounters{}}
+		retry.stats = stats
+		next = &statsTransport{next: next, stats: stats}
+	}
+	httpClient := &http.Client{
+		Transport:     &lifecycleTransport{ctx: ctx, next: next},
+		CheckRedirect: checkRedirectFn(options),
+	}
+
+	opts := []openapi.ClientOption{
+		openapi.WithHTTPClient(httpClient),
+		openapi.WithRequestEditorFn(operationIDFn()),
+	}
+	if options.ReadOnly {
+		opts = append(opts, openapi.WithRequestEditorFn(readOnlyFn()))
+	}
 
 	// Add HostOverride editor FIRST if set (before auth editors that use req.Host)
 	if options.HostOverride != "" {
 		opts = append(opts, openapi.WithRequestEditorFn(hostOverrideFn(options.HostOverride)))
 	}
+	opts = append(opts, openapi.WithRequestEditorFn(requestHostFn()))
+	opts = append(opts, openapi.WithRequestEditorFn(clientCorrelationIDFn()))
+	opts = append(opts, openapi.WithRequestEditorFn(interceptRequestFn(options.RequestInterceptors)))
+
+	if options.StrictValidation {
+		opts = append(opts, openapi.WithRequestEditorFn(strictValidationFn()))
+	}
+	if options.AutoIdempotencyKeys {
+		opts = append(opts, openapi.WithRequestEditorFn(autoIdempotencyKeyFn()))
+	}
 
 	opts = append(opts, openapi.WithRequestEditorFn(apiKeyHeaderFn(options)))
+	if options.StrictValidation {
+		opts = append(opts, openapi.WithRequestEditorFn(tokenSelfCheckFn(options)))
+	}
 	opts = append(opts, openapi.WithRequestEditorFn(walletHeaderFn(options)))
 
 	client, err := openapi.NewClientWithResponses(basePath, opts...)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("failed to create CDP client: %w", err)
 	}
 
-	return client, nil
+	c := &Client{
+		ClientWithResponses: client,
+		options:             options,
+		httpClient:          httpClient,
+		transport:           transport,
+		ctx:                 ctx,
+		cancel:              cancel,
+		stats:               stats,
+	}
+	if options.ManageNonces {
+		c.nonces = newNonceManager(c)
+	}
+	return c, nil
+}
+
+// checkMinTLSVersion returns an error if version is not a supported value of
+// ClientOptions.MinTLSVersion.
+func checkMinTLSVersion(version uint16) error {
+	switch version {
+	case tls.VersionTLS12, tls.VersionTLS13:
+		return nil
+	case tls.VersionTLS10, tls.VersionTLS11:
+		return fmt.Errorf("minimum TLS version %#04x is insecure: use TLS 1.2 or later", version)
+	default:
+		return fmt.Errorf("unsupported minimum TLS version %#04x", version)
+	}
 }
 
 // hostOverrideFn sets the Host header to the specified override value.
@@ -73,9 +431,51 @@ func hostOverrideFn(hostOverride string) openapi.RequestEditorFn {
 	}
 }
 
+// requestHostKey is the context key for the per-request host set by WithRequestHost.
+type requestHostKey struct{}
+
+// WithRequestHost returns a copy of ctx that overrides the host used for routing
+// and JWT signing of requests made with it, taking precedence over
+// ClientOptions.HostOverride.
+//
+// This is intended for tests that route requests from a single client to several
+// mock hosts, and should not be used in production code.
+func WithRequestHost(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, requestHostKey{}, host)
+}
+
+// requestHostFromContext returns the host set on ctx by WithRequestHost, if any.
+func requestHostFromContext(ctx context.Context) (string, bool) {
+	host, ok := ctx.Value(requestHostKey{}).(string)
+	return host, ok && host != ""
+}
+
+// requestHostFn sets the Host header to the per-request host from the request
+// context, if one is set. Like hostOverrideFn, it must run before the auth editors.
+func requestHostFn() openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if host, ok := requestHostFromContext(ctx); ok {
+			req.Host = host
+		}
+		return nil
+	}
+}
+
 // getRequestHost returns the host to use for JWT signing.
-// If HostOverride is set, it takes precedence over req.Host.
+// SigningHost takes precedence over a per-request host set with WithRequestHost,
+// which takes precedence over HostOverride, which in turn takes precedence over
+// req.Host.
+//
+// req.Host is the host the SDK connects to, as the editors above leave it. A proxy
+// on the way may rewrite the Host header, in which case the server sees a host the
+// JWT wasn't signed for; SigningHost names the host the server sees.
 func getRequestHost(options ClientOptions, req *http.Request) string {
+	if options.SigningHost != "" {
+		return options.SigningHost
+	}
+	if host, ok := requestHostFromContext(req.Context()); ok {
+		return host
+	}
 	if options.HostOverride != "" {
 		return options.HostOverride
 	}
@@ -92,7 +492,16 @@ func getRequestHost(options ClientOptions, req *http.Request) string {
 //
 // TODO: Make this configurable by route rather than substring/regex matching.
 func requiresWalletAuth(method, path string) bool {
-	if method != http.MethodPost && method != http.MethodDelete && method != http.MethodPut {
+	return requiresWalletAuthFor(defaultWalletAuthMethods, method, path)
+}
+
+// defaultWalletAuthMethods are the methods sent with wallet auth when
+// ClientOptions.WalletAuthMethods is empty.
+var defaultWalletAuthMethods = []string{http.MethodPost, http.MethodDelete, http.MethodPut}
+
+// requiresWalletAuthFor is requiresWalletAuth for a configured set of methods.
+func requiresWalletAuthFor(methods []string, method, path string) bool {
+	if !slices.ContainsFunc(methods, func(m string) bool { return strings.EqualFold(m, method) }) {
 		return false
 	}
 
@@ -108,16 +517,33 @@ func requiresWalletAuth(method, path string) bool {
 }
 
 // apiKeyHeaderFn generates a JWT for the API key and adds it to the request headers.
+// If options.TokenCache is set, tokens are reused for requests to the same method,
+// host and path until they are within options.TokenCacheSkew of expiry.
 func apiKeyHeaderFn(options ClientOptions) openapi.RequestEditorFn {
-	return func(_ context.Context, req *http.Request) error {
+	skew := options.TokenCacheSkew
+	if skew == 0 {
+		skew = defaultTokenCacheSkew
+	}
+	var flights flightGroup[string]
+
+	return func(ctx context.Context, req *http.Request) error {
 		method := strings.ToUpper(req.Method)
 		if method == "" {
 			method = "GET"
 		}
 
-		req.Header.Set("Content-Type", "application/json")
+		// Bodyless requests must not carry a Content-Type; some proxies reject them.
+		if req.Body != nil && req.Body != http.NoBody {
+			req.Header.Set("Content-Type", "application/json")
+		} else {
+			req.Header.Del("Content-Type")
+		}
 
-		hasCredentials := options.APIKeyID != "" && options.APIKeySecret != ""
+		creds, err := credentials(ctx, options)
+		if err != nil {
+			return err
+		}
+		hasCredentials := creds.APIKeyID != "" && creds.APIKeySecret != ""
 
 		if !hasCredentials {
 			if openapi.IsPublicOperation(method, req.URL.Path) {
@@ -130,15 +556,41 @@ func apiKeyHeaderFn(options ClientOptions) openapi.RequestEditorFn {
 		// operations. This lets the server distinguish an authenticated caller from an
 		// anonymous one.
 		jwtOptions := auth.JwtOptions{
-			KeyID:         options.APIKeyID,
-			KeySecret:     options.APIKeySecret,
+			KeyID:         creds.APIKeyID,
+			KeySecret:     creds.APIKeySecret,
 			RequestMethod: method,
 			RequestHost:   getRequestHost(options, req),
 			RequestPath:   req.URL.Path,
-			ExpiresIn:     options.ExpiresIn,
+			ExpiresIn:     tokenExpiresIn(ctx, options.ExpiresIn),
+			Audience:      options.Audience,
 		}
 
-		jwt, err := auth.GenerateJWT(jwtOptions)
+		meta := TokenMeta{
+			Kind:   TokenKindAPIKey,
+			KeyID:  creds.APIKeyID,
+			Method: method,
+			Host:   jwtOptions.RequestHost,
+			Path:   jwtOptions.RequestPath,
+		}
+		generate := func() (string, error) {
+			return issueToken(req.Context(), options, meta, func() (string, error) {
+				return generateJWT(jwtOptions)
+			})
+		}
+
+		var jwt string
+		// Calls with an extended lifetime need a token valid for all of it, so they
+		// always get a fresh one.
+		if _, extended := ctx.Value(tokenLifetimeKey{}).(time.Duration); options.TokenCache == nil || extended {
+			jwt, err = generate()
+		} else {
+			lifetime := time.Duration(jwtOptions.ExpiresIn) * time.Second
+			if lifetime == 0 {
+				lifetime = defaultTokenLifetime
+			}
+			key := fmt.Sprintf("%s %s %s %s%s %s %q", creds.APIKeyID, secretFingerprint(creds.APIKeySecret), method, jwtOptions.RequestHost, jwtOptions.RequestPath, lifetime, options.Audience)
+			jwt, err = cachedJWT(options.TokenCache, &flights, key, skew, lifetime, generate)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to generate JWT: %w", err)
 		}
@@ -151,8 +603,8 @@ func apiKeyHeaderFn(options ClientOptions) openapi.RequestEditorFn {
 
 // walletHeaderFn generates a JWT for the wallet and adds it to the request headers.
 func walletHeaderFn(options ClientOptions) openapi.RequestEditorFn {
-	return func(_ context.Context, req *http.Request) error {
-		if options.WalletSecret == "" {
+	return func(ctx context.Context, req *http.Request) error {
+		if options.WalletSecret == "" && options.CredentialProvider == nil {
 			return nil
 		}
 
@@ -167,18 +619,32 @@ func walletHeaderFn(options ClientOptions) openapi.RequestEditorFn {
 			return nil
 		}
 
-		if !requiresWalletAuth(req.Method, req.URL.Path) {
+		methods := options.WalletAuthMethods
+		if len(methods) == 0 {
+			methods = defaultWalletAuthMethods
+		}
+		if !requiresWalletAuthFor(methods, method, req.URL.Path) {
 			return nil
 		}
 
-		var body map[string]interface{}
-		bodyBytes, err := io.ReadAll(req.Body)
+		creds, err := credentials(ctx, options)
 		if err != nil {
-			return fmt.Errorf("failed to read request body: %w", err)
+			return err
+		}
+		if creds.WalletSecret == "" {
+			return nil
 		}
 
-		// Restore the body for future readers
-		req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+		var body map[string]interface{}
+		var bodyBytes []byte
+		if req.Body != nil && req.Body != http.NoBody {
+			if bodyBytes, err = io.ReadAll(req.Body); err != nil {
+				return fmt.Errorf("failed to read request body: %w", err)
+			}
+
+			// Restore the body for future readers
+			req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+		}
 
 		if len(bodyBytes) > 0 {
 			if err := json.Unmarshal(bodyBytes, &body); err != nil {
@@ -189,19 +655,28 @@ func walletHeaderFn(options ClientOptions) openapi.RequestEditorFn {
 		}
 
 		walletJwtOptions := auth.WalletJwtOptions{
-			WalletSecret:  options.WalletSecret,
+			WalletSecret:  creds.WalletSecret,
 			RequestMethod: req.Method,
 			RequestHost:   getRequestHost(options, req),
 			RequestPath:   req.URL.Path,
 			RequestData:   body,
+			JTIProvider:   options.WalletJTIProvider,
 		}
 
-		walletJwt, err := auth.GenerateWalletJWT(walletJwtOptions)
+		meta := TokenMeta{
+			Kind:   TokenKindWallet,
+			Method: method,
+			Host:   walletJwtOptions.RequestHost,
+			Path:   walletJwtOptions.RequestPath,
+		}
+		walletJwt, err := issueToken(req.Context(), options, meta, func() (string, error) {
+			return generateWalletJWT(walletJwtOptions)
+		})
 		if err != nil {
 			return fmt.Errorf("failed to generate wallet JWT: %w", err)
 		}
 
-		req.Header.Set("X-Wallet-Auth", walletJwt)
+		req.Header.Set(walletAuthHeaderName(options), walletJwt)
 
 		return nil
 	}
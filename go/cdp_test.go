@@ -3,37 +3,21 @@ package cdp
 import (
 	"context"
 	"crypto/ecdsa"
-	"crypto/elliptic"
+	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/tls"
 	"crypto/x509"
-	"encoding/pem"
+	"encoding/base64"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/coinbase/cdp-sdk/go/openapi"
+	"github.com/golang-jwt/jwt/v5"
 )
 
-func generateTestECKeyForCdpTest(t *testing.T) string {
-	t.Helper()
-	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	if err != nil {
-		t.Fatalf("failed to generate EC key: %v", err)
-	}
-
-	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
-	if err != nil {
-		t.Fatalf("failed to marshal EC key: %v", err)
-	}
-
-	pemBlock := &pem.Block{
-		Type:  "EC PRIVATE KEY",
-		Bytes: keyBytes,
-	}
-
-	return string(pem.EncodeToMemory(pemBlock))
-}
-
 func TestRequiresWalletAuth(t *testing.T) {
 	tests := map[string]struct {
 		method string
@@ -220,7 +204,7 @@ func TestApiKeyHeaderFnAuthenticatesNonPublicOperationsWithCredentials(t *testin
 	}
 
 	fn := apiKeyHeaderFn(ClientOptions{
-		APIKeyID:     "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+		APIKeyID:     testAPIKeyID,
 		APIKeySecret: ecKey,
 	})
 	if err := fn(context.Background(), req); err != nil {
@@ -241,7 +225,7 @@ func TestApiKeyHeaderFnStillAuthenticatesPublicOperationsWhenCredentialsPresent(
 	}
 
 	fn := apiKeyHeaderFn(ClientOptions{
-		APIKeyID:     "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+		APIKeyID:     testAPIKeyID,
 		APIKeySecret: ecKey,
 	})
 	if err := fn(context.Background(), req); err != nil {
@@ -253,6 +237,35 @@ func TestApiKeyHeaderFnStillAuthenticatesPublicOperationsWhenCredentialsPresent(
 	}
 }
 
+func TestApiKeyHeaderFnSetsContentTypeOnlyWithBody(t *testing.T) {
+	fn := apiKeyHeaderFn(ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+	})
+
+	getReq, err := http.NewRequest(http.MethodGet, "https://api.cdp.coinbase.com/platform/v2/evm/accounts", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	if err := fn(context.Background(), getReq); err != nil {
+		t.Fatalf("apiKeyHeaderFn returned an unexpected error: %v", err)
+	}
+	if got := getReq.Header.Get("Content-Type"); got != "" {
+		t.Errorf("expected no Content-Type header on a GET request, got %q", got)
+	}
+
+	postReq, err := http.NewRequest(http.MethodPost, "https://api.cdp.coinbase.com/platform/v2/evm/accounts", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	if err := fn(context.Background(), postReq); err != nil {
+		t.Fatalf("apiKeyHeaderFn returned an unexpected error: %v", err)
+	}
+	if got := postReq.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected Content-Type application/json on a POST request, got %q", got)
+	}
+}
+
 func TestWalletHeaderFnSkipsPublicOperations(t *testing.T) {
 	// Add a synthetic public operation that overlaps a wallet-auth route to prove
 	// walletHeaderFn checks the public-operation gate before wallet auth matching.
@@ -281,3 +294,331 @@ func TestWalletHeaderFnSkipsPublicOperations(t *testing.T) {
 		t.Errorf("expected no X-Wallet-Auth header for a public operation, got %q", got)
 	}
 }
+
+func TestWithRequestHostOverridesJWTRequestHost(t *testing.T) {
+	type seen struct{ host, uri string }
+	requests := make(chan seen, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := jwt.MapClaims{}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Errorf("failed to parse JWT: %v", err)
+		}
+		requests <- seen{host: r.Host, uri: claims["uris"].([]interface{})[0].(string)}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+		BasePath:     server.URL + "/platform",
+		HostOverride: "client-override.example.com",
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx := WithRequestHost(context.Background(), "mock-a.example.com")
+	if _, err := client.ListEvmAccountsWithResponse(ctx, nil); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	tests := []struct{ host, uri string }{
+		{"mock-a.example.com", "GET mock-a.example.com/platform/v2/evm/accounts"},
+		{"client-override.example.com", "GET client-override.example.com/platform/v2/evm/accounts"},
+	}
+	for _, want := range tests {
+		got := <-requests
+		if got.host != want.host || got.uri != want.uri {
+			t.Errorf("got host %q and uri %q, want %q and %q", got.host, got.uri, want.host, want.uri)
+		}
+	}
+}
+
+func TestSigningHostDecouplesJWTFromConnectionHost(t *testing.T) {
+	type seen struct{ host, uri string }
+	requests := make(chan seen, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := jwt.MapClaims{}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Errorf("failed to parse JWT: %v", err)
+		}
+		requests <- seen{host: r.Host, uri: claims["uris"].([]interface{})[0].(string)}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	client, err := NewClient(ClientOptions{
+		APIKeyID:         testAPIKeyID,
+		APIKeySecret:     generateTestECKeyForCdpTest(t),
+		WalletSecret:     generateTestWalletSecret(t),
+		BasePath:         proxy.URL + "/platform",
+		SigningHost:      "api.cdp.coinbase.com",
+		StrictValidation: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	got := <-requests
+	if want := strings.TrimPrefix(proxy.URL, "http://"); got.host != want {
+		t.Errorf("request sent to host %q, want %q", got.host, want)
+	}
+	if want := "GET api.cdp.coinbase.com/platform/v2/evm/accounts"; got.uri != want {
+		t.Errorf("JWT signed for %q, want %q", got.uri, want)
+	}
+
+	header, err := client.WalletAuthHeader(context.Background(), "POST", "/platform/v2/evm/accounts", nil)
+	if err != nil {
+		t.Fatalf("WalletAuthHeader returned an error: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(header, claims); err != nil {
+		t.Fatalf("failed to parse wallet JWT: %v", err)
+	}
+	if uris, _ := claims["uris"].([]interface{}); len(uris) != 1 || uris[0] != "POST api.cdp.coinbase.com/platform/v2/evm/accounts" {
+		t.Errorf("wallet JWT signed for %v", claims["uris"])
+	}
+}
+
+func TestAudienceInJWT(t *testing.T) {
+	audiences := make(chan interface{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := jwt.MapClaims{}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Errorf("failed to parse JWT: %v", err)
+		}
+		audiences <- claims["aud"]
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientOptions{
+		APIKeyID:         testAPIKeyID,
+		APIKeySecret:     generateTestECKeyForCdpTest(t),
+		BasePath:         server.URL,
+		Audience:         []string{"cdp_service", "gateway.example.com"},
+		StrictValidation: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	aud, _ := (<-audiences).([]interface{})
+	if len(aud) != 2 || aud[0] != "cdp_service" || aud[1] != "gateway.example.com" {
+		t.Errorf("aud claim = %v, want both audiences", aud)
+	}
+
+	token, err := client.WebSocketToken(context.Background())
+	if err != nil {
+		t.Fatalf("WebSocketToken returned an error: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		t.Fatalf("failed to parse WebSocket JWT: %v", err)
+	}
+	if aud, _ := claims["aud"].([]interface{}); len(aud) != 2 {
+		t.Errorf("WebSocket JWT aud claim = %v, want both audiences", claims["aud"])
+	}
+}
+
+func generateTestEd25519KeyForCdpTest(t *testing.T) string {
+	t.Helper()
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(privateKey)
+}
+
+func TestAPIKeysPreferenceOrder(t *testing.T) {
+	ecKey := APIKey{ID: "ec-key", Secret: generateTestECKeyForCdpTest(t)}
+	edKey := APIKey{ID: "ed-key", Secret: generateTestEd25519KeyForCdpTest(t)}
+	invalidKey := APIKey{ID: "invalid-key", Secret: "not-a-key"}
+
+	tests := []struct {
+		name    string
+		options ClientOptions
+		wantKid string
+		wantAlg string
+	}{
+		{"first key wins", ClientOptions{APIKeys: []APIKey{edKey, ecKey}}, "ed-key", "EdDSA"},
+		{"order is respected", ClientOptions{APIKeys: []APIKey{ecKey, edKey}}, "ec-key", "ES256"},
+		{"unusable keys are skipped", ClientOptions{APIKeys: []APIKey{invalidKey, edKey, ecKey}}, "ed-key", "EdDSA"},
+		{"APIKeyID takes precedence", ClientOptions{APIKeyID: ecKey.ID, APIKeySecret: ecKey.Secret, APIKeys: []APIKey{edKey}}, "ec-key", "ES256"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := make(chan string, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tokens <- strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			tt.options.BasePath = server.URL
+			client, err := NewClient(tt.options)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			defer client.Close()
+
+			if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			token, _, err := jwt.NewParser().ParseUnverified(<-tokens, jwt.MapClaims{})
+			if err != nil {
+				t.Fatalf("failed to parse JWT: %v", err)
+			}
+			if kid, alg := token.Header["kid"], token.Method.Alg(); kid != tt.wantKid || alg != tt.wantAlg {
+				t.Errorf("got kid %v and alg %s, want %s and %s", kid, alg, tt.wantKid, tt.wantAlg)
+			}
+		})
+	}
+
+	if _, err := NewClient(ClientOptions{APIKeys: []APIKey{invalidKey}}); err == nil {
+		t.Error("expected an error when no configured key is usable")
+	}
+}
+
+func TestWalletHeaderFnConfigurableMethods(t *testing.T) {
+	secret := generateTestWalletSecret(t)
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "https://api.cdp.coinbase.com/platform/v2/evm/accounts/0xabc", nil)
+		if err != nil {
+			t.Fatalf("failed to build request: %v", err)
+		}
+		return req
+	}
+
+	req := newRequest()
+	if err := walletHeaderFn(ClientOptions{WalletSecret: secret})(context.Background(), req); err != nil {
+		t.Fatalf("walletHeaderFn returned an error: %v", err)
+	}
+	if got := req.Header.Get("X-Wallet-Auth"); got != "" {
+		t.Errorf("expected no X-Wallet-Auth header for GET by default, got %q", got)
+	}
+
+	req = newRequest()
+	options := ClientOptions{WalletSecret: secret, WalletAuthMethods: []string{"get", http.MethodPost}}
+	if err := walletHeaderFn(options)(context.Background(), req); err != nil {
+		t.Fatalf("walletHeaderFn returned an error: %v", err)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("failed to decode wallet secret: %v", err)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		t.Fatalf("failed to parse wallet secret: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(req.Header.Get("X-Wallet-Auth"), claims, func(*jwt.Token) (interface{}, error) {
+		return &key.(*ecdsa.PrivateKey).PublicKey, nil
+	}, jwt.WithValidMethods([]string{"ES256"}))
+	if err != nil {
+		t.Fatalf("invalid wallet JWT for GET request: %v", err)
+	}
+	if _, ok := claims["reqHash"]; ok {
+		t.Errorf("expected no reqHash for a GET request, got %v", claims["reqHash"])
+	}
+	if uris, _ := claims["uris"].([]interface{}); len(uris) != 1 || uris[0] != "GET api.cdp.coinbase.com/platform/v2/evm/accounts/0xabc" {
+		t.Errorf("unexpected uris claim %v", claims["uris"])
+	}
+}
+
+func TestWalletAuthHeaderName(t *testing.T) {
+	setFastTimers(t)
+
+	headers := make(chan http.Header, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		if len(headers) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"address":"` + testOwner + `"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		WalletSecret:         generateTestWalletSecret(t),
+		WalletAuthHeaderName: "X-Gateway-Wallet-Auth",
+		RetryOptions:         RetryOptions{RetryNonIdempotent: true},
+	})
+	if _, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{}); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		header := <-headers
+		if header.Get("X-Gateway-Wallet-Auth") == "" || header.Get("X-Wallet-Auth") != "" {
+			t.Errorf("attempt %d: expected the wallet JWT only in X-Gateway-Wallet-Auth, got headers %v", attempt, header)
+		}
+	}
+}
+
+func TestMinTLSVersion(t *testing.T) {
+	newClient := func(t *testing.T, serverURL string, version uint16) (*Client, error) {
+		t.Helper()
+		return NewClient(ClientOptions{
+			APIKeyID:      testAPIKeyID,
+			APIKeySecret:  generateTestECKeyForCdpTest(t),
+			BasePath:      serverURL,
+			MaxRetries:    -1,
+			MinTLSVersion: version,
+		})
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	for _, tt := range []struct {
+		version uint16
+		wantErr bool
+	}{{tls.VersionTLS12, false}, {tls.VersionTLS13, true}} {
+		client, err := newClient(t, server.URL, tt.version)
+		if err != nil {
+			t.Fatalf("NewClient returned an error: %v", err)
+		}
+		if got := client.transport.TLSClientConfig.MinVersion; got != tt.version {
+			t.Errorf("transport MinVersion = %#04x, want %#04x", got, tt.version)
+		}
+		client.transport.TLSClientConfig.RootCAs = server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs
+
+		_, err = client.GetEvmAccountWithResponse(context.Background(), testOwner)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("MinTLSVersion %#04x against a TLS 1.2 server: got error %v, want error: %v", tt.version, err, tt.wantErr)
+		}
+		_ = client.Close()
+	}
+
+	for _, version := range []uint16{tls.VersionTLS10, tls.VersionTLS11, 0x0305} {
+		if _, err := newClient(t, server.URL, version); err == nil {
+			t.Errorf("expected an error for minimum TLS version %#04x", version)
+		}
+	}
+}
@@ -0,0 +1,359 @@
+// Package cdptest provides a fake CDP API server for testing code that uses the
+// SDK over real HTTP, including its authentication.
+package cdptest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// Request is a request received by a Server.
+type Request struct {
+	// OperationID is the ID of the OpenAPI operation the request is for, e.g.
+	// "CreateEvmAccount", or empty if it matches none.
+	OperationID string
+	// Method, Path and Header are those of the request.
+	Method string
+	Path   string
+	Header http.Header
+	// Body is the request body.
+	Body []byte
+	// APIKeyClaims are the claims of the API key JWT in the Authorization header,
+	// or nil if there is none.
+	APIKeyClaims map[string]interface{}
+	// WalletClaims are the claims of the wallet JWT in the X-Wallet-Auth header,
+	// or nil if there is none.
+	WalletClaims map[string]interface{}
+	// AuthErr is the reason the request's authentication headers were rejected,
+	// or nil if they were accepted. Rejected requests are answered with a 401.
+	AuthErr error
+}
+
+// Server is a fake CDP API server. It checks the authentication headers of every
+// request and answers the common operations with deterministic stub responses:
+// CreateEvmAccount, GetEvmAccount, GetEvmAccountByName,
+// PrepareAndSendUserOperation and RequestEvmFaucet. Other operations are
+// answered with a 501 unless a handler is registered for them with Handle.
+//
+// Point a client at it by setting ClientOptions.BasePath to its URL. A Server is
+// safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]http.HandlerFunc
+	requests []Request
+	// accounts are the created EVM accounts, in order.
+	accounts []openapi.EvmAccount
+	// counter numbers the stub transaction and user operation hashes.
+	counter int
+}
+
+// NewServer starts and returns a new Server. Close it when done.
+func NewServer() *Server {
+	s := &Server{handlers: map[string]http.HandlerFunc{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Handle registers handler to answer requests for the operation with the given
+// ID, e.g. "CreateEvmAccount", in place of the stub response. Requests are still
+// authenticated first, and the request body can be read by handler. Use
+// OperationID(r) or the recorded Requests to tell requests apart.
+func (s *Server) Handle(operationID string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[operationID] = handler
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// serveHTTP records and authenticates r, then answers it.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	req := Request{Method: r.Method, Path: r.URL.Path, Header: r.Header.Clone(), Body: body}
+	op, known := openapi.LookupOperation(r.Method, r.URL.Path)
+	if known {
+		req.OperationID = op.ID
+	}
+	if !openapi.IsPublicOperation(r.Method, r.URL.Path) {
+		req.APIKeyClaims, req.AuthErr = checkAPIKeyAuth(r)
+		if req.AuthErr == nil && (op.WalletAuth || r.Header.Get("X-Wallet-Auth") != "") {
+			req.WalletClaims, req.AuthErr = checkWalletAuth(r, body)
+		}
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	handler, custom := s.handlers[req.OperationID]
+	s.mu.Unlock()
+
+	switch {
+	case req.AuthErr != nil:
+		writeError(w, http.StatusUnauthorized, "unauthorized", req.AuthErr.Error())
+	case !known:
+		writeError(w, http.StatusNotFound, "not_found", "unknown route "+r.Method+" "+r.URL.Path)
+	case custom:
+		handler(w, r)
+	default:
+		s.stub(w, r, op.ID, body)
+	}
+}
+
+// OperationID returns the ID of the OpenAPI operation r is for, e.g.
+// "CreateEvmAccount", or empty if it matches none.
+func OperationID(r *http.Request) string {
+	op, ok := openapi.LookupOperation(r.Method, r.URL.Path)
+	if !ok {
+		return ""
+	}
+	return op.ID
+}
+
+// stub answers a request for operationID with a stub response.
+func (s *Server) stub(w http.ResponseWriter, r *http.Request, operationID string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch operationID {
+	case "CreateEvmAccount":
+		var create openapi.CreateEvmAccountJSONBody
+		_ = json.Unmarshal(body, &create)
+		if create.Name != nil && s.accountByName(*create.Name) != nil {
+			writeError(w, http.StatusConflict, "already_exists", "an account named "+*create.Name+" already exists")
+			return
+		}
+		account := openapi.EvmAccount{
+			Address:  fakeAddress(fmt.Sprintf("account-%d", len(s.accounts))),
+			Name:     create.Name,
+			Policies: &[]string{},
+		}
+		s.accounts = append(s.accounts, account)
+		writeJSON(w, http.StatusCreated, account)
+
+	case "GetEvmAccount":
+		address := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		for _, account := range s.accounts {
+			if strings.EqualFold(account.Address, address) {
+				writeJSON(w, http.StatusOK, account)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "not_found", "EVM account "+address+" not found")
+
+	case "GetEvmAccountByName":
+		name := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if account := s.accountByName(name); account != nil {
+			writeJSON(w, http.StatusOK, account)
+			return
+		}
+		writeError(w, http.StatusNotFound, "not_found", "EVM account named "+name+" not found")
+
+	case "PrepareAndSendUserOperation":
+		var send openapi.PrepareAndSendUserOperationJSONBody
+		if err := json.Unmarshal(body, &send); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+		s.counter++
+		writeJSON(w, http.StatusOK, openapi.EvmUserOperation{
+			Calls:      send.Calls,
+			Network:    send.Network,
+			Status:     openapi.EvmUserOperationStatusBroadcast,
+			UserOpHash: fakeHash(fmt.Sprintf("user-operation-%d", s.counter)),
+		})
+
+	case "RequestEvmFaucet":
+		s.counter++
+		writeJSON(w, http.StatusOK, map[string]string{"transactionHash": fakeHash(fmt.Sprintf("faucet-%d", s.counter))})
+
+	default:
+		writeError(w, http.StatusNotImplemented, "not_implemented", "cdptest has no stub for "+operationID)
+	}
+}
+
+// accountByName returns the created account with the given name, or nil.
+func (s *Server) accountByName(name string) *openapi.EvmAccount {
+	for i, account := range s.accounts {
+		if account.Name != nil && *account.Name == name {
+			return &s.accounts[i]
+		}
+	}
+	return nil
+}
+
+// checkAPIKeyAuth checks that r carries a well-formed API key JWT bound to its
+// method and path, and returns the JWT's claims. The signature is not verified,
+// as the server does not know the key.
+func checkAPIKeyAuth(r *http.Request) (map[string]interface{}, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, errors.New("missing bearer token in the Authorization header")
+	}
+	header, err := auth.DecodeHeader(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed API key JWT: %w", err)
+	}
+	if alg, _ := header["alg"].(string); !slices.Contains([]string{"ES256", "EdDSA", "RS256", "PS256"}, alg) {
+		return nil, fmt.Errorf("unsupported API key JWT algorithm %q", alg)
+	}
+	if kid, _ := header["kid"].(string); kid == "" {
+		return nil, errors.New("API key JWT has no kid header")
+	}
+	claims, err := auth.DecodeClaims(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed API key JWT: %w", err)
+	}
+	if iss, _ := claims["iss"].(string); iss != "cdp" {
+		return nil, fmt.Errorf("API key JWT has issuer %q, want cdp", iss)
+	}
+	if sub, _ := claims["sub"].(string); sub == "" || sub != header["kid"] {
+		return nil, errors.New("API key JWT subject does not match its kid")
+	}
+	if err := checkTimes(claims, true); err != nil {
+		return nil, fmt.Errorf("API key JWT %w", err)
+	}
+	if err := checkURIs(claims, r); err != nil {
+		return nil, fmt.Errorf("API key JWT %w", err)
+	}
+	return claims, nil
+}
+
+// checkWalletAuth checks that r carries a well-formed wallet JWT bound to its
+// method, path and body, and returns the JWT's claims.
+func checkWalletAuth(r *http.Request, body []byte) (map[string]interface{}, error) {
+	token := r.Header.Get("X-Wallet-Auth")
+	if token == "" {
+		return nil, errors.New("missing X-Wallet-Auth header")
+	}
+	header, err := auth.DecodeHeader(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed wallet JWT: %w", err)
+	}
+	if alg, _ := header["alg"].(string); alg != "ES256" {
+		return nil, fmt.Errorf("wallet JWT has algorithm %q, want ES256", alg)
+	}
+	claims, err := auth.DecodeClaims(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed wallet JWT: %w", err)
+	}
+	if jti, _ := claims["jti"].(string); jti == "" {
+		return nil, errors.New("wallet JWT has no jti claim")
+	}
+	if err := checkTimes(claims, false); err != nil {
+		return nil, fmt.Errorf("wallet JWT %w", err)
+	}
+	if err := checkURIs(claims, r); err != nil {
+		return nil, fmt.Errorf("wallet JWT %w", err)
+	}
+
+	var data map[string]interface{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &data); err != nil {
+			return nil, fmt.Errorf("request body is not a JSON object: %w", err)
+		}
+	}
+	reqHash, _ := claims["reqHash"].(string)
+	if len(data) == 0 {
+		if reqHash != "" {
+			return nil, errors.New("wallet JWT has a reqHash claim for a request without a body")
+		}
+		return claims, nil
+	}
+	hash, err := auth.HashCanonicalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if reqHash != hex.EncodeToString(hash) {
+		return nil, errors.New("wallet JWT reqHash claim does not match the request body")
+	}
+	return claims, nil
+}
+
+// checkTimes checks the iat and nbf claims, and the exp claim if requireExp is
+// set, against the current time.
+func checkTimes(claims map[string]interface{}, requireExp bool) error {
+	now := time.Now()
+	for _, name := range []string{"iat", "nbf"} {
+		value, ok := claims[name].(float64)
+		if !ok {
+			return fmt.Errorf("has no %s claim", name)
+		}
+		// Allow for clock skew between the client and the server.
+		if time.Unix(int64(value), 0).After(now.Add(time.Minute)) {
+			return fmt.Errorf("%s claim is in the future", name)
+		}
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		if requireExp {
+			return errors.New("has no exp claim")
+		}
+		return nil
+	}
+	if !time.Unix(int64(exp), 0).After(now) {
+		return errors.New("has expired")
+	}
+	return nil
+}
+
+// checkURIs checks that the uris claim binds the token to r's method and path.
+// The host is not checked, since clients may sign with a host other than the
+// server's, for example with ClientOptions.SigningHost.
+func checkURIs(claims map[string]interface{}, r *http.Request) error {
+	uris, _ := claims["uris"].([]interface{})
+	if len(uris) != 1 {
+		return errors.New("must have exactly one uri in its uris claim")
+	}
+	uri, _ := uris[0].(string)
+	method, hostPath, _ := strings.Cut(uri, " ")
+	if method != r.Method || !strings.HasSuffix(hostPath, r.URL.Path) || strings.HasPrefix(hostPath, "/") {
+		return fmt.Errorf("uri %q does not match the request %s %s", uri, r.Method, r.URL.Path)
+	}
+	return nil
+}
+
+// fakeAddress returns a deterministic EVM address derived from seed.
+func fakeAddress(seed string) string {
+	hash := sha256.Sum256([]byte(seed))
+	return "0x" + hex.EncodeToString(hash[:20])
+}
+
+// fakeHash returns a deterministic 32-byte hash derived from seed.
+func fakeHash(seed string) string {
+	hash := sha256.Sum256([]byte(seed))
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// writeJSON writes v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a CDP API error response.
+func writeError(w http.ResponseWriter, status int, errorType, message string) {
+	writeJSON(w, status, map[string]string{"errorType": errorType, "errorMessage": message})
+}
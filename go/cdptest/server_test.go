@@ -0,0 +1,163 @@
+package cdptest_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go"
+	"github.com/coinbase/cdp-sdk/go/cdptest"
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func newClient(t *testing.T, server *cdptest.Server) *cdp.Client {
+	t.Helper()
+	apiKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate API key: %v", err)
+	}
+	apiKeyDER, err := x509.MarshalECPrivateKey(apiKey)
+	if err != nil {
+		t.Fatalf("failed to marshal API key: %v", err)
+	}
+	walletKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate wallet secret: %v", err)
+	}
+	walletDER, err := x509.MarshalPKCS8PrivateKey(walletKey)
+	if err != nil {
+		t.Fatalf("failed to marshal wallet secret: %v", err)
+	}
+
+	client, err := cdp.NewClient(cdp.ClientOptions{
+		APIKeyID:     "test-key",
+		APIKeySecret: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: apiKeyDER})),
+		WalletSecret: base64.StdEncoding.EncodeToString(walletDER),
+		BasePath:     server.URL + "/platform",
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestServerStubsCommonOperations(t *testing.T) {
+	server := cdptest.NewServer()
+	defer server.Close()
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	name := "treasury"
+	created, err := client.CreateEvmAccountWithResponse(ctx, nil, openapi.CreateEvmAccountJSONRequestBody{Name: &name})
+	if err != nil || created.JSON201 == nil {
+		t.Fatalf("CreateEvmAccount failed: %v, status %d: %s", err, created.StatusCode(), created.Body)
+	}
+	byName, err := client.GetEvmAccountByNameWithResponse(ctx, name)
+	if err != nil || byName.JSON200 == nil || byName.JSON200.Address != created.JSON201.Address {
+		t.Fatalf("GetEvmAccountByName did not return the created account: %v, %s", err, byName.Body)
+	}
+	missing, err := client.GetEvmAccountByNameWithResponse(ctx, "missing")
+	if err != nil || missing.StatusCode() != http.StatusNotFound {
+		t.Errorf("expected a 404 for an unknown name, got %v, %d", err, missing.StatusCode())
+	}
+
+	sent, err := client.PrepareAndSendUserOperationWithResponse(ctx, created.JSON201.Address, nil, openapi.PrepareAndSendUserOperationJSONRequestBody{
+		Network: "base-sepolia",
+		Calls:   []openapi.EvmCall{{To: created.JSON201.Address, Value: "0", Data: "0x"}},
+	})
+	if err != nil || sent.JSON200 == nil || sent.JSON200.UserOpHash == "" {
+		t.Fatalf("PrepareAndSendUserOperation failed: %v, status %d: %s", err, sent.StatusCode(), sent.Body)
+	}
+	faucet, err := client.RequestEvmFaucetWithResponse(ctx, openapi.RequestEvmFaucetJSONRequestBody{Address: created.JSON201.Address, Network: "base-sepolia", Token: "eth"})
+	if err != nil || faucet.JSON200 == nil || faucet.JSON200.TransactionHash == "" {
+		t.Fatalf("RequestEvmFaucet failed: %v, status %d: %s", err, faucet.StatusCode(), faucet.Body)
+	}
+
+	requests := server.Requests()
+	var operations []string
+	for _, req := range requests {
+		if req.AuthErr != nil {
+			t.Errorf("%s was rejected: %v", req.OperationID, req.AuthErr)
+		}
+		if req.APIKeyClaims["sub"] != "test-key" {
+			t.Errorf("%s has API key claims %v", req.OperationID, req.APIKeyClaims)
+		}
+		operations = append(operations, req.OperationID)
+	}
+	want := "CreateEvmAccount,GetEvmAccountByName,GetEvmAccountByName,PrepareAndSendUserOperation,RequestEvmFaucet"
+	if got := strings.Join(operations, ","); got != want {
+		t.Errorf("got operations %s, want %s", got, want)
+	}
+	if requests[0].WalletClaims == nil || requests[3].WalletClaims == nil {
+		t.Error("expected wallet auth on CreateEvmAccount and PrepareAndSendUserOperation")
+	}
+}
+
+func TestServerRejectsMissingAuth(t *testing.T) {
+	server := cdptest.NewServer()
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/platform/v2/evm/accounts", "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got status %d, want 401", resp.StatusCode)
+	}
+	if requests := server.Requests(); len(requests) != 1 || requests[0].AuthErr == nil {
+		t.Errorf("expected the request to be recorded with an auth error, got %+v", requests)
+	}
+}
+
+func TestServerRejectsWalletJWTForAnotherBody(t *testing.T) {
+	server := cdptest.NewServer()
+	defer server.Close()
+	client := newClient(t, server)
+
+	// Change the body after the client has signed it.
+	tamper := func(_ context.Context, req *http.Request) error {
+		req.Body = http.NoBody
+		req.ContentLength = 0
+		return nil
+	}
+	name := "signed-name"
+	resp, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{Name: &name}, tamper)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("got status %d, want 401", resp.StatusCode())
+	}
+	if requests := server.Requests(); !strings.Contains(requests[0].AuthErr.Error(), "reqHash") {
+		t.Errorf("expected a reqHash error, got %v", requests[0].AuthErr)
+	}
+}
+
+func TestServerHandle(t *testing.T) {
+	server := cdptest.NewServer()
+	defer server.Close()
+	client := newClient(t, server)
+
+	server.Handle("GetEvmAccount", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"address":"0x1111111111111111111111111111111111111111","name":"custom"}`))
+	})
+	resp, err := client.GetEvmAccountWithResponse(context.Background(), "0x1111111111111111111111111111111111111111")
+	if err != nil || resp.JSON200 == nil || resp.JSON200.Name == nil || *resp.JSON200.Name != "custom" {
+		t.Fatalf("expected the custom response, got %v, %s", err, resp.Body)
+	}
+
+	unstubbed, err := client.ListPoliciesWithResponse(context.Background(), nil)
+	if err != nil || unstubbed.StatusCode() != http.StatusNotImplemented {
+		t.Errorf("expected a 501 for an operation without a stub, got %v, %d", err, unstubbed.StatusCode())
+	}
+}
@@ -0,0 +1,148 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrChunkSkipped is reported for chunks that were not sent because an earlier
+// chunk failed in sequential mode.
+var ErrChunkSkipped = errors.New("chunk skipped because an earlier chunk failed")
+
+// ChunkCalls splits calls into consecutive chunks of at most maxPerOp calls each,
+// preserving order. It returns an error if maxPerOp is not positive.
+func ChunkCalls(calls []openapi.EvmCall, maxPerOp int) ([][]openapi.EvmCall, error) {
+	if maxPerOp <= 0 {
+		return nil, fmt.Errorf("maxPerOp must be positive, got %d", maxPerOp)
+	}
+
+	chunks := make([][]openapi.EvmCall, 0, (len(calls)+maxPerOp-1)/maxPerOp)
+	for start := 0; start < len(calls); start += maxPerOp {
+		end := min(start+maxPerOp, len(calls))
+		chunks = append(chunks, calls[start:end:end])
+	}
+	return chunks, nil
+}
+
+// ChunkedUserOperationOptions configures SendUserOperationsChunked.
+type ChunkedUserOperationOptions struct {
+	UserOperationOptions
+
+	// MaxCallsPerOperation is the maximum number of calls in each user operation.
+	// Defaults to 50.
+	MaxCallsPerOperation int
+	// Concurrency is the maximum number of user operations submitted at once when
+	// not running sequentially. Defaults to 4.
+	Concurrency int
+	// Sequential submits chunks one at a time, waiting for each user operation to
+	// complete before sending the next, for batches whose calls depend on earlier
+	// ones. The first failure stops the batch; remaining chunks report
+	// ErrChunkSkipped.
+	Sequential bool
+}
+
+// ChunkResult is the outcome of submitting one chunk of calls.
+type ChunkResult struct {
+	// Calls are the calls in the chunk.
+	Calls []openapi.EvmCall
+	// UserOpHash is the hash of the user operation, if it was submitted.
+	UserOpHash string
+	// Err is the error that occurred while submitting the chunk, if any.
+	Err error
+}
+
+// SendUserOperationsChunked splits calls into chunks of at most
+// opts.MaxCallsPerOperation calls and submits each chunk as its own user operation
+// on network. It returns one result per chunk, in order.
+//
+// By default chunks are submitted in parallel, with at most opts.Concurrency in
+// flight, and SendUserOperationsChunked returns once all have been submitted. With
+// opts.Sequential, each user operation must complete before the next is sent. If
+// any chunk fails, the returned error is a *BatchError with one entry per chunk.
+func (s *SmartAccount) SendUserOperationsChunked(
+	ctx context.Context,
+	calls []openapi.EvmCall,
+	network string,
+	opts ChunkedUserOperationOptions,
+) ([]ChunkResult, error) {
+	if err := s.client.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	maxPerOp := opts.MaxCallsPerOperation
+	if maxPerOp <= 0 {
+		maxPerOp = 50
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	chunks, err := ChunkCalls(calls, maxPerOp)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]ChunkResult, len(chunks))
+	for i, chunk := range chunks {
+		results[i].Calls = chunk
+	}
+
+	if opts.Sequential {
+		for i := range results {
+			if i > 0 && results[i-1].Err != nil {
+				results[i].Err = ErrChunkSkipped
+				continue
+			}
+			results[i].UserOpHash, results[i].Err = s.sendChunk(ctx, results[i].Calls, network, opts.UserOperationOptions, true)
+		}
+	} else {
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, concurrency)
+		for i := range results {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				results[i].UserOpHash, results[i].Err = s.sendChunk(ctx, results[i].Calls, network, opts.UserOperationOptions, false)
+			}()
+		}
+		wg.Wait()
+	}
+
+	errs := make([]error, len(results))
+	failed := false
+	for i, result := range results {
+		errs[i] = result.Err
+		failed = failed || result.Err != nil
+	}
+	if failed {
+		return results, &BatchError{Errors: errs}
+	}
+	return results, nil
+}
+
+// sendChunk submits calls as a single user operation and returns its hash,
+// optionally waiting for it to complete.
+func (s *SmartAccount) sendChunk(ctx context.Context, calls []openapi.EvmCall, network string, opts UserOperationOptions, wait bool) (string, error) {
+	op, err := s.SendUserOperation(ctx, calls, network, opts)
+	if err != nil {
+		return "", err
+	}
+	if wait {
+		if _, err := s.WaitForUserOperation(ctx, op.UserOpHash); err != nil {
+			return op.UserOpHash, fmt.Errorf("user operation %s did not complete: %w", op.UserOpHash, err)
+		}
+	}
+	return op.UserOpHash, nil
+}
@@ -0,0 +1,167 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func testCalls(n int) []openapi.EvmCall {
+	calls := make([]openapi.EvmCall, n)
+	for i := range calls {
+		calls[i] = openapi.EvmCall{To: testRecipient, Value: fmt.Sprint(i), Data: "0x"}
+	}
+	return calls
+}
+
+func TestChunkCalls(t *testing.T) {
+	tests := []struct {
+		calls, maxPerOp int
+		want            []int
+	}{
+		{0, 3, []int{}},
+		{3, 3, []int{3}},
+		{7, 3, []int{3, 3, 1}},
+		{2, 5, []int{2}},
+	}
+
+	for _, tt := range tests {
+		chunks, err := ChunkCalls(testCalls(tt.calls), tt.maxPerOp)
+		if err != nil {
+			t.Fatalf("ChunkCalls(%d, %d) returned an error: %v", tt.calls, tt.maxPerOp, err)
+		}
+		if len(chunks) != len(tt.want) {
+			t.Fatalf("ChunkCalls(%d, %d) returned %d chunks, want %d", tt.calls, tt.maxPerOp, len(chunks), len(tt.want))
+		}
+		next := 0
+		for i, chunk := range chunks {
+			if len(chunk) != tt.want[i] {
+				t.Errorf("chunk %d has %d calls, want %d", i, len(chunk), tt.want[i])
+			}
+			for _, call := range chunk {
+				if call.Value != fmt.Sprint(next) {
+					t.Errorf("calls out of order: got value %s, want %d", call.Value, next)
+				}
+				next++
+			}
+		}
+	}
+}
+
+func TestChunkCallsRejectsNonPositiveMax(t *testing.T) {
+	for _, maxPerOp := range []int{0, -1} {
+		if chunks, err := ChunkCalls(testCalls(3), maxPerOp); err == nil {
+			t.Errorf("ChunkCalls(3, %d) = %v, want an error", maxPerOp, chunks)
+		}
+	}
+}
+
+// newUserOperationServer serves prepare-and-send and get user operation requests.
+// A user operation fails to send if any of its calls has a value of "fail", and
+// completes as soon as it is sent.
+func newUserOperationServer(t *testing.T) (*httptest.Server, *[][]string) {
+	t.Helper()
+	var mu sync.Mutex
+	var sent [][]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			hash := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			fmt.Fprintf(w, `{"network":"base-sepolia","calls":[],"status":"complete","userOpHash":%q}`, hash)
+			return
+		}
+
+		var body openapi.PrepareAndSendUserOperationJSONRequestBody
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		var values []string
+		for _, call := range body.Calls {
+			values = append(values, call.Value)
+		}
+		if strings.Contains(strings.Join(values, ","), "fail") {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorType":"invalid_request","errorMessage":"bad call"}`)
+			return
+		}
+
+		mu.Lock()
+		sent = append(sent, values)
+		mu.Unlock()
+		_ = json.NewEncoder(w).Encode(openapi.EvmUserOperation{
+			Calls:      body.Calls,
+			Network:    body.Network,
+			Status:     openapi.EvmUserOperationStatusBroadcast,
+			UserOpHash: "0xop-" + strings.Join(values, "-"),
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &sent
+}
+
+func TestSendUserOperationsChunked(t *testing.T) {
+	server, sent := newUserOperationServer(t)
+	account := &SmartAccount{client: newTestClient(t, server.URL), Address: testOwner}
+
+	results, err := account.SendUserOperationsChunked(context.Background(), testCalls(5), "base-sepolia", ChunkedUserOperationOptions{
+		MaxCallsPerOperation: 2,
+		Concurrency:          2,
+	})
+	if err != nil {
+		t.Fatalf("SendUserOperationsChunked returned an error: %v", err)
+	}
+
+	want := []string{"0xop-0-1", "0xop-2-3", "0xop-4"}
+	for i, result := range results {
+		if result.UserOpHash != want[i] {
+			t.Errorf("results[%d].UserOpHash = %q, want %q", i, result.UserOpHash, want[i])
+		}
+	}
+	if len(*sent) != 3 {
+		t.Errorf("expected 3 user operations, got %d", len(*sent))
+	}
+}
+
+func TestSendUserOperationsChunkedSequentialStopsOnFailure(t *testing.T) {
+	strategy := defaultUserOperationPollStrategy
+	defaultUserOperationPollStrategy = FixedPollStrategy{Interval: time.Millisecond}
+	t.Cleanup(func() { defaultUserOperationPollStrategy = strategy })
+
+	server, sent := newUserOperationServer(t)
+	account := &SmartAccount{client: newTestClient(t, server.URL), Address: testOwner}
+
+	calls := testCalls(6)
+	calls[2].Value = "fail"
+
+	results, err := account.SendUserOperationsChunked(context.Background(), calls, "base-sepolia", ChunkedUserOperationOptions{
+		MaxCallsPerOperation: 2,
+		Sequential:           true,
+	})
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected *BatchError, got %v", err)
+	}
+	if results[0].Err != nil || results[0].UserOpHash != "0xop-0-1" {
+		t.Errorf("unexpected first result %+v", results[0])
+	}
+	if results[1].Err == nil {
+		t.Error("expected the second chunk to fail")
+	}
+	if !errors.Is(results[2].Err, ErrChunkSkipped) {
+		t.Errorf("expected the third chunk to be skipped, got %v", results[2].Err)
+	}
+	if len(*sent) != 1 {
+		t.Errorf("expected 1 user operation to be sent, got %d", len(*sent))
+	}
+}
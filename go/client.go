@@ -0,0 +1,117 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrClientClosed is returned for requests issued after Client.Close has been called.
+var ErrClientClosed = errors.New("cdp: client is closed")
+
+// Client is the CDP client returned by NewClient.
+//
+// It embeds the generated OpenAPI client, so every API operation (e.g.
+// CreateEvmAccountWithResponse) can be called on it directly.
+//
+// A Client owns its HTTP connections. Long-running services that rebuild
+// clients should call Close on the old client once it is no longer needed.
+type Client struct {
+	*openapi.ClientWithResponses
+
+	options    ClientOptions
+	httpClient *http.Client
+	transport  *http.Transport
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+
+	faucets faucetTracker
+	tokens  tokenMetadataCache
+	stats   *usageStats
+	nonces  *NonceManager
+	userOps sentUserOperations
+
+	evmAccountFlights    flightGroup[*EvmAccount]
+	smartAccountFlights  flightGroup[*SmartAccount]
+	solanaAccountFlights flightGroup[*SolanaAccount]
+}
+
+// Close shuts the client down. It cancels all in-flight requests and closes idle
+// connections. Requests issued after Close fail with ErrClientClosed.
+//
+// Close is safe to call multiple times and from multiple goroutines; calls after
+// the first are no-ops. It always returns nil.
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		c.cancel()
+		c.transport.CloseIdleConnections()
+	})
+	return nil
+}
+
+// lifecycleTransport ties every request to the owning client's lifetime, so that
+// closing the client cancels requests that are still in flight.
+type lifecycleTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *lifecycleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.ctx.Err() != nil {
+		return nil, ErrClientClosed
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	stop := context.AfterFunc(t.ctx, cancel)
+	release := func() {
+		stop()
+		cancel()
+	}
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		release()
+		if t.ctx.Err() != nil {
+			return nil, ErrClientClosed
+		}
+		return nil, err
+	}
+
+	// Keep the request context alive until the caller is done reading the body.
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// releaseOnCloseBody runs release once the response body is closed.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close implements io.Closer.
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// WithBasePath returns a new client for a different API surface (e.g. another
+// Coinbase API host) that shares this client's configuration, including its
+// credentials. JWTs issued by the new client are signed for its own host and path.
+//
+// The returned client is independent of c: it has its own connections and must be
+// closed separately.
+func (c *Client) WithBasePath(basePath string) (*Client, error) {
+	options := c.options
+	options.BasePath = basePath
+	return NewClient(options)
+}
@@ -0,0 +1,105 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestClientCloseCancelsInFlightRequests(t *testing.T) {
+	received := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := client.ListEvmAccountsWithResponse(context.Background(), nil)
+		errCh <- err
+	}()
+
+	<-received
+	_ = client.Close()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, ErrClientClosed) {
+			t.Fatalf("expected ErrClientClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("in-flight request was not canceled by Close")
+	}
+}
+
+func TestClientCloseIsIdempotent(t *testing.T) {
+	client := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("first Close returned an error: %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("second Close returned an error: %v", err)
+	}
+
+	_, err := client.ListEvmAccountsWithResponse(context.Background(), nil)
+	if !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("expected ErrClientClosed after Close, got %v", err)
+	}
+}
+
+func TestClientWithBasePathSharesCredentialsAcrossHosts(t *testing.T) {
+	newServer := func(uris chan<- []string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			claims := jwt.MapClaims{}
+			if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+				t.Errorf("failed to parse JWT: %v", err)
+			}
+			var got []string
+			for _, uri := range claims["uris"].([]interface{}) {
+				got = append(got, uri.(string))
+			}
+			uris <- got
+			w.WriteHeader(http.StatusOK)
+		}))
+	}
+
+	platformURIs, otherURIs := make(chan []string, 1), make(chan []string, 1)
+	platform, other := newServer(platformURIs), newServer(otherURIs)
+	defer platform.Close()
+	defer other.Close()
+
+	client := newTestClient(t, platform.URL+"/platform")
+	otherClient, err := client.WithBasePath(other.URL + "/api")
+	if err != nil {
+		t.Fatalf("WithBasePath returned an error: %v", err)
+	}
+	defer otherClient.Close()
+
+	if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); err != nil {
+		t.Fatalf("request to platform host failed: %v", err)
+	}
+	if _, err := otherClient.ListEvmAccountsWithResponse(context.Background(), nil); err != nil {
+		t.Fatalf("request to other host failed: %v", err)
+	}
+
+	platformHost := strings.TrimPrefix(platform.URL, "http://")
+	otherHost := strings.TrimPrefix(other.URL, "http://")
+
+	if got, want := <-platformURIs, "GET "+platformHost+"/platform/v2/evm/accounts"; len(got) != 1 || got[0] != want {
+		t.Errorf("platform uris = %v, want [%s]", got, want)
+	}
+	if got, want := <-otherURIs, "GET "+otherHost+"/api/v2/evm/accounts"; len(got) != 1 || got[0] != want {
+		t.Errorf("other uris = %v, want [%s]", got, want)
+	}
+}
@@ -0,0 +1,29 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+)
+
+// IsContract reports whether there is code deployed at address on network, for
+// deciding whether a transfer goes to a contract that runs code on receipt, such
+// as a smart account, rather than to a plain EOA. Addresses with no code,
+// including unfunded addresses and smart accounts that are not deployed yet,
+// report false. EOAs that delegate to a contract with EIP-7702 have code and
+// report true, since transfers to them run that code.
+func IsContract(ctx context.Context, client *Client, network, address string) (bool, error) {
+	network = client.networkOrDefault(network)
+	if _, err := addressWord(address); err != nil {
+		return false, err
+	}
+
+	var code string
+	if err := client.rpcCall(ctx, network, &code, "eth_getCode", address, "latest"); err != nil {
+		return false, fmt.Errorf("failed to get code on %s: %w", network, err)
+	}
+	data, err := decodeHexData(code)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode code on %s: %w", network, err)
+	}
+	return len(data) > 0, nil
+}
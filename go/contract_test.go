@@ -0,0 +1,36 @@
+package cdp
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIsContract(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    bool
+		wantErr bool
+	}{
+		{"contract", "0x6080604052", true, false},
+		{"EOA", "0x", false, false},
+		{"node error", "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := newCodeRPCServer(t, tt.code)
+			client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+			got, err := IsContract(context.Background(), client, "base-sepolia", testRecipient)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("IsContract = %v, %v, want %v (error: %v)", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", newCodeRPCServer(t, "0x").URL)
+	if _, err := IsContract(context.Background(), client, "base-sepolia", "not-an-address"); err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+}
@@ -0,0 +1,40 @@
+package cdp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// clientCorrelationIDHeader is the header that carries the caller's correlation ID.
+const clientCorrelationIDHeader = "X-Client-Correlation-Id"
+
+// clientCorrelationIDKey is the context key for the ID set by
+// WithClientCorrelationID.
+type clientCorrelationIDKey struct{}
+
+// WithClientCorrelationID returns a copy of ctx that tags API requests made with
+// it with the caller's own correlation ID. The ID is sent in the
+// X-Client-Correlation-Id header and included in the client's debug logs, so
+// application logs, SDK logs and CDP's logs can be matched up. It is distinct
+// from the correlation ID CDP assigns to each request (see APIError).
+func WithClientCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientCorrelationIDKey{}, id)
+}
+
+// ClientCorrelationID returns the ID set on ctx by WithClientCorrelationID, if any.
+func ClientCorrelationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(clientCorrelationIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// clientCorrelationIDFn sets the correlation ID header from the request context.
+func clientCorrelationIDFn() openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if id, ok := ClientCorrelationID(ctx); ok {
+			req.Header.Set(clientCorrelationIDHeader, id)
+		}
+		return nil
+	}
+}
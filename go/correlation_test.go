@@ -0,0 +1,52 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithClientCorrelationID(t *testing.T) {
+	headers := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Get("X-Client-Correlation-Id")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	client := newTestClientWithOptions(t, server.URL+"/platform", ClientOptions{
+		Debugging: true,
+		Logger:    slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+
+	ctx := WithClientCorrelationID(context.Background(), "my-trace-123")
+	if _, err := client.ListEvmAccountsWithResponse(ctx, nil); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	if got := <-headers; got != "my-trace-123" {
+		t.Errorf("got header %q, want %q", got, "my-trace-123")
+	}
+	if got := <-headers; got != "" {
+		t.Errorf("got header %q without a correlation ID, want none", got)
+	}
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d log lines, want 2:\n%s", len(lines), logs.String())
+	}
+	if !strings.Contains(lines[0], "client_correlation_id=my-trace-123") || !strings.Contains(lines[0], "operation=ListEvmAccounts") {
+		t.Errorf("first log line is missing the correlation ID or operation: %s", lines[0])
+	}
+	if strings.Contains(lines[1], "client_correlation_id") {
+		t.Errorf("second log line has a correlation ID: %s", lines[1])
+	}
+}
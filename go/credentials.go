@@ -0,0 +1,42 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+)
+
+// Credentials is the key material the client authenticates requests with.
+type Credentials struct {
+	// APIKeyID is the API key ID.
+	APIKeyID string
+	// APIKeySecret is the API key secret: a PEM-encoded EC or RSA key or a
+	// base64-encoded Ed25519 key.
+	APIKeySecret string
+	// WalletSecret is the wallet secret, or empty if requests are not sent with
+	// wallet auth.
+	WalletSecret string
+}
+
+// CredentialProvider returns the credentials to authenticate a request with. It is
+// called with the request's context, and must be safe for concurrent use. See
+// ClientOptions.CredentialProvider.
+type CredentialProvider func(ctx context.Context) (Credentials, error)
+
+// credentials returns the credentials to authenticate a request made with ctx:
+// those returned by options.CredentialProvider if it is set, or else the static
+// credentials of options. A provider error is wrapped, so that the request fails
+// rather than being sent without credentials.
+func credentials(ctx context.Context, options ClientOptions) (Credentials, error) {
+	if options.CredentialProvider == nil {
+		return Credentials{
+			APIKeyID:     options.APIKeyID,
+			APIKeySecret: options.APIKeySecret,
+			WalletSecret: options.WalletSecret,
+		}, nil
+	}
+	creds, err := options.CredentialProvider(ctx)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to get credentials: %w", err)
+	}
+	return creds, nil
+}
@@ -0,0 +1,115 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// newKeyRecordingServer records the key ID of each API key JWT it receives, and
+// whether the request carried wallet auth.
+func newKeyRecordingServer(t *testing.T) (*httptest.Server, func() ([]string, int)) {
+	t.Helper()
+	var mu sync.Mutex
+	var keyIDs []string
+	walletAuthed := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			claims, err := auth.DecodeClaims(token)
+			if err != nil {
+				t.Errorf("failed to decode JWT: %v", err)
+			}
+			keyIDs = append(keyIDs, fmt.Sprint(claims["sub"]))
+		}
+		if r.Header.Get("X-Wallet-Auth") != "" {
+			walletAuthed++
+		}
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() ([]string, int) {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), keyIDs...), walletAuthed
+	}
+}
+
+func TestCredentialProviderRotatesKeys(t *testing.T) {
+	server, recorded := newKeyRecordingServer(t)
+
+	var current atomic.Pointer[Credentials]
+	current.Store(&Credentials{APIKeyID: "key-1", APIKeySecret: generateTestECKeyForCdpTest(t), WalletSecret: generateTestWalletSecret(t)})
+	client, err := NewClient(ClientOptions{
+		BasePath: server.URL,
+		CredentialProvider: func(context.Context) (Credentials, error) {
+			return *current.Load(), nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	if _, err := client.GetEvmAccountWithResponse(ctx, testOwner); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	current.Store(&Credentials{APIKeyID: "key-2", APIKeySecret: generateTestECKeyForCdpTest(t), WalletSecret: generateTestWalletSecret(t)})
+	if _, err := client.GetEvmAccountWithResponse(ctx, testOwner); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if _, err := client.CreateEvmAccountWithResponse(ctx, nil, openapi.CreateEvmAccountJSONRequestBody{}); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	keyIDs, walletAuthed := recorded()
+	if want := []string{"key-1", "key-2", "key-2"}; strings.Join(keyIDs, ",") != strings.Join(want, ",") {
+		t.Errorf("expected requests signed with %v, got %v", want, keyIDs)
+	}
+	if walletAuthed != 1 {
+		t.Errorf("expected 1 request with wallet auth, got %d", walletAuthed)
+	}
+}
+
+func TestCredentialProviderErrorFailsRequest(t *testing.T) {
+	server, recorded := newKeyRecordingServer(t)
+
+	errVault := errors.New("vault unavailable")
+	client, err := NewClient(ClientOptions{
+		APIKeyID:     "static-key",
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+		BasePath:     server.URL,
+		CredentialProvider: func(context.Context) (Credentials, error) {
+			return Credentials{}, errVault
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); !errors.Is(err, errVault) {
+		t.Fatalf("expected the provider's error, got %v", err)
+	}
+	if _, err := client.WebSocketToken(context.Background()); !errors.Is(err, errVault) {
+		t.Errorf("expected the provider's error from WebSocketToken, got %v", err)
+	}
+	if keyIDs, _ := recorded(); len(keyIDs) != 0 {
+		t.Errorf("expected no request to be sent, got %d", len(keyIDs))
+	}
+}
@@ -0,0 +1,187 @@
+package cdp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultLogBodyLimit is the number of bytes of each body logged when
+// ClientOptions.LogBodies is set and ClientOptions.LogBodyLimit is zero.
+const DefaultLogBodyLimit = 4096
+
+// redacted replaces logged header values and body fields that carry secrets.
+const redacted = "[REDACTED]"
+
+// redactedHeaders are the request headers whose values are never logged, besides
+// the wallet auth header.
+var redactedHeaders = []string{"Authorization", "Cookie"}
+
+// secretFieldNames are substrings of JSON field names, lowercased and without
+// separators, whose values are redacted from logged bodies.
+var secretFieldNames = []string{
+	"secret", "privatekey", "password", "passphrase", "mnemonic", "seed",
+	"accesstoken", "refreshtoken", "jwt", "authorization",
+}
+
+// debugTransport logs each HTTP request attempt made by the client.
+type debugTransport struct {
+	next      http.RoundTripper
+	logger    *slog.Logger
+	logBodies bool
+	bodyLimit int
+	// redacted holds the canonical names of the headers whose values are never
+	// logged.
+	redacted map[string]bool
+}
+
+// newDebugTransport wraps next to log requests if options.Debugging is set, and
+// returns next unchanged otherwise.
+func newDebugTransport(next http.RoundTripper, options ClientOptions) http.RoundTripper {
+	if !options.Debugging {
+		return next
+	}
+	limit := options.LogBodyLimit
+	if limit <= 0 {
+		limit = DefaultLogBodyLimit
+	}
+	redacted := map[string]bool{http.CanonicalHeaderKey(walletAuthHeaderName(options)): true}
+	for _, name := range redactedHeaders {
+		redacted[name] = true
+	}
+	return &debugTransport{next: next, logger: clientLogger(options), logBodies: options.LogBodies, bodyLimit: limit, redacted: redacted}
+}
+
+// clientLogger returns the logger the client logs to.
+func clientLogger(options ClientOptions) *slog.Logger {
+	if options.Logger != nil {
+		return options.Logger
+	}
+	return slog.Default()
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.Redacted()),
+	}
+	if operation, ok := OperationID(ctx); ok {
+		attrs = append(attrs, slog.String("operation", operation))
+	}
+	if id, ok := ClientCorrelationID(ctx); ok {
+		attrs = append(attrs, slog.String("client_correlation_id", id))
+	}
+	attrs = append(attrs, slog.Any("headers", t.redactHeaders(req.Header)))
+	if t.logBodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			raw, _ := io.ReadAll(body)
+			body.Close()
+			attrs = append(attrs, slog.String("request_body", t.formatBody(raw)))
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+	if err != nil {
+		t.logger.DebugContext(ctx, "cdp request failed", append(attrs, slog.Any("error", err))...)
+		return nil, err
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	for _, header := range correlationIDHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			attrs = append(attrs, slog.String("correlation_id", id))
+			break
+		}
+	}
+	if t.logBodies && resp.Body != nil {
+		// Buffer the body so the caller can still read it.
+		raw, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		var body io.Reader = bytes.NewReader(raw)
+		if readErr != nil {
+			body = io.MultiReader(body, errReader{readErr})
+		}
+		resp.Body = io.NopCloser(body)
+		attrs = append(attrs, slog.String("response_body", t.formatBody(raw)))
+	}
+	t.logger.DebugContext(ctx, "cdp request", attrs...)
+	return resp, nil
+}
+
+// errReader returns err from every read, so that a response body read error is
+// reported to the caller after the buffered part of the body.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// redactHeaders returns the headers to log, with the values of t.redacted
+// replaced.
+func (t *debugTransport) redactHeaders(header http.Header) map[string]string {
+	logged := make(map[string]string, len(header))
+	for name, values := range header {
+		if t.redacted[http.CanonicalHeaderKey(name)] {
+			logged[name] = redacted
+			continue
+		}
+		logged[name] = strings.Join(values, ", ")
+	}
+	return logged
+}
+
+// formatBody returns body for logging: with the values of secret-looking fields
+// redacted if it is JSON, and cut to the body limit.
+func (t *debugTransport) formatBody(body []byte) string {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// Keep large integers, such as wei amounts, exact.
+	decoder.UseNumber()
+	if decoder.Decode(&value) == nil && !decoder.More() {
+		if redactedBody, err := json.Marshal(redactSecrets(value)); err == nil {
+			body = redactedBody
+		}
+	}
+	if len(body) > t.bodyLimit {
+		return string(body[:t.bodyLimit]) + "...(truncated)"
+	}
+	return string(body)
+}
+
+// redactSecrets replaces the values of secret-looking fields in a decoded JSON
+// value, at any depth.
+func redactSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSecretField(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactSecrets(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactSecrets(item)
+		}
+	}
+	return value
+}
+
+// isSecretField reports whether a JSON field with the given name looks like it
+// holds a secret.
+func isSecretField(name string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	for _, secret := range secretFieldNames {
+		if strings.Contains(normalized, secret) {
+			return true
+		}
+	}
+	return false
+}
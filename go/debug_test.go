@@ -0,0 +1,129 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func newLoggingTestClient(t *testing.T, handler http.HandlerFunc, options ClientOptions) (*Client, *bytes.Buffer) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	var logs bytes.Buffer
+	options.APIKeyID = testAPIKeyID
+	options.APIKeySecret = generateTestECKeyForCdpTest(t)
+	options.WalletSecret = generateTestWalletSecret(t)
+	options.BasePath = server.URL
+	options.Debugging = true
+	options.Logger = slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := NewClient(options)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client, &logs
+}
+
+func TestDebugLogsRedactAuthHeaders(t *testing.T) {
+	client, logs := newLoggingTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-123")
+		fmt.Fprintf(w, `{"address":%q,"privateKey":"0xdeadbeef"}`, testOwner)
+	}, ClientOptions{})
+
+	if _, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{}); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	logged := logs.String()
+	for _, want := range []string{`"operation":"CreateEvmAccount"`, `"Authorization":"[REDACTED]"`, `"X-Wallet-Auth":"[REDACTED]"`, `"correlation_id":"req-123"`, `"status":200`} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("log is missing %s: %s", want, logged)
+		}
+	}
+	for _, secret := range []string{"Bearer", "0xdeadbeef", "response_body"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains %q: %s", secret, logged)
+		}
+	}
+}
+
+func TestDebugLogsRedactCustomWalletAuthHeader(t *testing.T) {
+	var walletJWT string
+	client, logs := newLoggingTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		walletJWT = r.Header.Get("X-Custom-Wallet-Auth")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}, ClientOptions{WalletAuthHeaderName: "X-Custom-Wallet-Auth"})
+
+	if _, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{}); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if walletJWT == "" {
+		t.Fatal("the wallet JWT was not sent in the custom header")
+	}
+	logged := logs.String()
+	if !strings.Contains(logged, `"X-Custom-Wallet-Auth":"[REDACTED]"`) {
+		t.Errorf("log does not redact the custom wallet auth header: %s", logged)
+	}
+	if strings.Contains(logged, walletJWT) {
+		t.Errorf("log contains the wallet JWT: %s", logged)
+	}
+}
+
+func TestDebugLogsRedactBodies(t *testing.T) {
+	client, logs := newLoggingTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q,"nested":[{"wallet_secret":"s3cr3t"}],"value":"123456789012345678901234567890","balance":123456789012345678901234567890}`, testOwner)
+	}, ClientOptions{LogBodies: true})
+
+	resp, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Address != testOwner {
+		t.Errorf("response body was not readable by the caller: %s", resp.Body)
+	}
+
+	logged := logs.String()
+	if strings.Contains(logged, "s3cr3t") {
+		t.Errorf("log contains a secret body field: %s", logged)
+	}
+	for _, want := range []string{`wallet_secret\":\"[REDACTED]\"`, testOwner, "123456789012345678901234567890,"} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("log is missing %s: %s", want, logged)
+		}
+	}
+}
+
+func TestDebugLogsTruncateBodies(t *testing.T) {
+	large := strings.Repeat("x", 1000)
+	client, logs := newLoggingTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, large)
+	}, ClientOptions{LogBodies: true, LogBodyLimit: 100})
+
+	resp, err := client.ListEvmAccounts(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != large {
+		t.Errorf("caller read %d bytes of the body, want %d", len(body), len(large))
+	}
+
+	logged := logs.String()
+	if !strings.Contains(logged, strings.Repeat("x", 100)+"...(truncated)") || strings.Contains(logged, strings.Repeat("x", 101)) {
+		t.Errorf("body was not truncated to 100 bytes: %s", logged)
+	}
+}
@@ -0,0 +1,63 @@
+package cdp
+
+import (
+	"context"
+	"sync"
+)
+
+// maxDeploymentStatusConcurrency bounds the number of networks DeploymentStatus
+// queries at once.
+const maxDeploymentStatusConcurrency = 4
+
+// DeploymentResult is the deployment status of a smart account on one network.
+type DeploymentResult struct {
+	// Deployed reports whether the smart account's contract is deployed.
+	Deployed bool
+	// Err is the error that occurred while checking the network, if any. Deployed
+	// is false when Err is set.
+	Err error
+}
+
+// IsDeployed reports whether the smart account's contract is deployed on network.
+// Smart accounts are deployed lazily with their first user operation, so an
+// account can be usable on a network before it is deployed there.
+func (s *SmartAccount) IsDeployed(ctx context.Context, network string) (bool, error) {
+	return IsContract(ctx, s.client, network, s.Address)
+}
+
+// DeploymentStatus checks on which of networks the smart account is deployed. A
+// smart account has the same address on every EVM network but is deployed on each
+// one separately.
+//
+// Networks are checked concurrently, at most 4 at a time. The result has an entry
+// for every network; a network that could not be checked has its error set rather
+// than failing the whole call. Canceling ctx stops checks that have not started.
+func (s *SmartAccount) DeploymentStatus(ctx context.Context, networks []string) map[string]DeploymentResult {
+	results := make(map[string]DeploymentResult, len(networks))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxDeploymentStatusConcurrency)
+	for _, network := range networks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[network] = DeploymentResult{Err: ctx.Err()}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			deployed, err := s.IsDeployed(ctx, network)
+
+			mu.Lock()
+			results[network] = DeploymentResult{Deployed: deployed, Err: err}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
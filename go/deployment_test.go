@@ -0,0 +1,73 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newCodeRPCServer returns a JSON-RPC server that answers eth_getCode with code,
+// or with a server error if code is empty.
+func newCodeRPCServer(t *testing.T, code string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "eth_getCode" {
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+		if code == "" {
+			http.Error(w, "unavailable", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, code)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSmartAccountDeploymentStatus(t *testing.T) {
+	client := newTestClientWithOptions(t, "", ClientOptions{
+		RPCURLs: map[string]string{
+			"base":         newCodeRPCServer(t, "0x6080").URL,
+			"base-sepolia": newCodeRPCServer(t, "0x").URL,
+			"ethereum":     newCodeRPCServer(t, "").URL,
+		},
+	})
+
+	account := &SmartAccount{client: client, Address: testOwner}
+	got := account.DeploymentStatus(context.Background(), []string{"base", "base-sepolia", "ethereum"})
+
+	if len(got) != 3 {
+		t.Fatalf("got %d results, want 3", len(got))
+	}
+	if r := got["base"]; !r.Deployed || r.Err != nil {
+		t.Errorf("base: got %+v, want deployed", r)
+	}
+	if r := got["base-sepolia"]; r.Deployed || r.Err != nil {
+		t.Errorf("base-sepolia: got %+v, want not deployed", r)
+	}
+	if r := got["ethereum"]; r.Deployed || r.Err == nil {
+		t.Errorf("ethereum: got %+v, want an error", r)
+	}
+}
+
+func TestSmartAccountDeploymentStatusCanceled(t *testing.T) {
+	client := newTestClientWithOptions(t, "", ClientOptions{
+		RPCURLs: map[string]string{"base": newCodeRPCServer(t, "0x6080").URL},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	account := &SmartAccount{client: client, Address: testOwner}
+	got := account.DeploymentStatus(ctx, []string{"base"})
+	if r := got["base"]; r.Err == nil {
+		t.Errorf("got %+v, want an error", r)
+	}
+}
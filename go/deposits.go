@@ -0,0 +1,262 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// depositPollInterval is how often WatchDeposits polls for new blocks.
+var depositPollInterval = 2 * time.Second
+
+// depositRescanBlocks is how many already scanned blocks WatchDeposits scans
+// again on each poll, to pick up transfers that a lagging RPC node did not return
+// the first time. Deposits found again are deduplicated.
+const depositRescanBlocks = 3
+
+// depositMaxBlockRange caps the number of blocks scanned in a single poll, so that
+// catching up after an outage does not exceed RPC providers' eth_getLogs limits.
+const depositMaxBlockRange = 500
+
+// erc20TransferTopic is the topic of the ERC-20 Transfer(address,address,uint256)
+// event.
+var erc20TransferTopic = "0x" + hex.EncodeToString(keccak256([]byte("Transfer(address,address,uint256)")))
+
+// Deposit is an incoming transfer to an account, reported by WatchDeposits.
+type Deposit struct {
+	// From is the address of the sender.
+	From string
+	// Amount is the amount transferred, in the token's smallest unit (wei for the
+	// native token).
+	Amount *big.Int
+	// Token is the native token's symbol (e.g. "ETH") for native transfers, or the
+	// ERC-20 contract address.
+	Token string
+	// TransactionHash is the hash of the transaction that made the transfer.
+	TransactionHash string
+	// BlockNumber is the number of the block the transaction was included in.
+	BlockNumber uint64
+	// LogIndex is the index of the Transfer log in the block for ERC-20 transfers,
+	// and -1 for native transfers.
+	LogIndex int
+}
+
+// key identifies the deposit for deduplication.
+func (d Deposit) key() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(d.TransactionHash), d.LogIndex)
+}
+
+// WatchDeposits watches the account's network for incoming transfers of token,
+// either the native token's symbol (e.g. "ETH"), a known token symbol such as
+// "usdc", or an ERC-20 contract address, and sends each one on the returned
+// channel. Only transfers in blocks after the call are reported. The channel is
+// closed once ctx is done.
+//
+// Transfers are found by polling the client's RPC endpoint for the network:
+// ERC-20 transfers from the token's Transfer logs, and native transfers from the
+// top-level transactions of each block that send value to the account and
+// succeed. Native value received through internal calls, such as from a smart
+// account, is not reported. Failed RPC requests are logged to
+// ClientOptions.Logger and retried on the next poll, and each deposit is sent
+// once even if blocks are scanned again. Deposits are reported as soon as they
+// are included, so credit them only after enough confirmations, for example with
+// WaitForTransactionReceipt, as they may still be reorganized out.
+func (a *NetworkScopedEvmAccount) WatchDeposits(ctx context.Context, token string) (<-chan Deposit, error) {
+	watcher := &depositWatcher{client: a.client, network: a.Network, token: nativeSymbol(a.Network), seen: map[string]uint64{}}
+	if !strings.EqualFold(token, nativeSymbol(a.Network)) {
+		watcher.contract = token
+		if known, ok := lookupTokenBySymbol(a.Network, token); ok {
+			watcher.contract = known.Address
+		} else if _, err := addressWord(token); err != nil {
+			return nil, fmt.Errorf("unknown token %q on %s", token, a.Network)
+		}
+		watcher.token = watcher.contract
+	}
+	recipient, err := addressWord(a.Address)
+	if err != nil {
+		return nil, err
+	}
+	watcher.address = a.Address
+	watcher.recipientTopic = "0x" + hex.EncodeToString(recipient)
+
+	head, err := a.client.blockNumber(ctx, a.Network)
+	if err != nil {
+		return nil, err
+	}
+	watcher.next = head + 1
+
+	deposits := make(chan Deposit)
+	go func() {
+		defer close(deposits)
+		ticker := time.NewTicker(depositPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := watcher.poll(ctx, deposits); err != nil && ctx.Err() == nil {
+				clientLogger(a.client.options).WarnContext(ctx, "cdp deposit watch poll failed",
+					"network", a.Network, "address", a.Address, "token", token, "error", err)
+			}
+		}
+	}()
+	return deposits, nil
+}
+
+// depositWatcher is the state of a WatchDeposits call.
+type depositWatcher struct {
+	client  *Client
+	network string
+	address string
+	// token is the token reported in deposits; contract is the ERC-20 contract
+	// address, or empty when watching native transfers.
+	token          string
+	contract       string
+	recipientTopic string
+
+	// next is the first block that has not been scanned yet.
+	next uint64
+	// seen maps the keys of sent deposits to their block numbers.
+	seen map[string]uint64
+}
+
+// poll scans the blocks from a few before w.next up to the latest block, and
+// sends the deposits found that were not sent before.
+func (w *depositWatcher) poll(ctx context.Context, deposits chan<- Deposit) error {
+	head, err := w.client.blockNumber(ctx, w.network)
+	if err != nil {
+		return err
+	}
+	if head < w.next {
+		return nil
+	}
+	from := w.next - min(w.next, depositRescanBlocks)
+	to := min(head, w.next+depositMaxBlockRange-1)
+
+	var found []Deposit
+	if w.contract != "" {
+		found, err = w.tokenDeposits(ctx, from, to)
+	} else {
+		found, err = w.nativeDeposits(ctx, from, to)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, deposit := range found {
+		key := deposit.key()
+		if _, ok := w.seen[key]; ok {
+			continue
+		}
+		select {
+		case deposits <- deposit:
+			w.seen[key] = deposit.BlockNumber
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	w.next = to + 1
+	for key, block := range w.seen {
+		if block+depositRescanBlocks < w.next {
+			delete(w.seen, key)
+		}
+	}
+	return nil
+}
+
+// tokenDeposits returns the ERC-20 transfers of w.contract to w.address in blocks
+// from through to.
+func (w *depositWatcher) tokenDeposits(ctx context.Context, from, to uint64) ([]Deposit, error) {
+	var logs []struct {
+		Topics          []string `json:"topics"`
+		Data            string   `json:"data"`
+		BlockNumber     string   `json:"blockNumber"`
+		TransactionHash string   `json:"transactionHash"`
+		LogIndex        string   `json:"logIndex"`
+		Removed         bool     `json:"removed"`
+	}
+	filter := map[string]interface{}{
+		"fromBlock": fmt.Sprintf("0x%x", from),
+		"toBlock":   fmt.Sprintf("0x%x", to),
+		"address":   w.contract,
+		"topics":    []interface{}{erc20TransferTopic, nil, w.recipientTopic},
+	}
+	if err := w.client.rpcCall(ctx, w.network, &logs, "eth_getLogs", filter); err != nil {
+		return nil, fmt.Errorf("failed to get transfer logs: %w", err)
+	}
+
+	var found []Deposit
+	for _, log := range logs {
+		if log.Removed || len(log.Topics) != 3 {
+			continue
+		}
+		sender, err := decodeHexData(log.Topics[1])
+		if err != nil || len(sender) != abiWordSize {
+			continue
+		}
+		found = append(found, Deposit{
+			From:            wordToAddress(sender),
+			Amount:          hexToBigInt(log.Data),
+			Token:           w.token,
+			TransactionHash: log.TransactionHash,
+			BlockNumber:     hexToBigInt(log.BlockNumber).Uint64(),
+			LogIndex:        int(hexToBigInt(log.LogIndex).Int64()),
+		})
+	}
+	return found, nil
+}
+
+// nativeDeposits returns the successful native transfers to w.address in the
+// top-level transactions of blocks from through to.
+func (w *depositWatcher) nativeDeposits(ctx context.Context, from, to uint64) ([]Deposit, error) {
+	var found []Deposit
+	for number := from; number <= to; number++ {
+		var block *struct {
+			Transactions []struct {
+				Hash  string  `json:"hash"`
+				From  string  `json:"from"`
+				To    *string `json:"to"`
+				Value string  `json:"value"`
+			} `json:"transactions"`
+		}
+		if err := w.client.rpcCall(ctx, w.network, &block, "eth_getBlockByNumber", fmt.Sprintf("0x%x", number), true); err != nil {
+			return nil, fmt.Errorf("failed to get block %d: %w", number, err)
+		}
+		if block == nil {
+			return nil, fmt.Errorf("block %d not found", number)
+		}
+
+		for _, tx := range block.Transactions {
+			amount := hexToBigInt(tx.Value)
+			if tx.To == nil || !strings.EqualFold(*tx.To, w.address) || amount.Sign() == 0 {
+				continue
+			}
+			receipt, err := w.client.GetTransactionReceipt(ctx, w.network, tx.Hash)
+			if err != nil {
+				return nil, err
+			}
+			if receipt == nil {
+				// Scan the block again on the next poll, once the node has the receipt.
+				return nil, fmt.Errorf("receipt of transaction %s not found", tx.Hash)
+			}
+			if receipt.Status == 0 {
+				continue
+			}
+			found = append(found, Deposit{
+				From:            tx.From,
+				Amount:          amount,
+				Token:           w.token,
+				TransactionHash: tx.Hash,
+				BlockNumber:     number,
+				LogIndex:        -1,
+			})
+		}
+	}
+	return found, nil
+}
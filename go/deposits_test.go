@@ -0,0 +1,145 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newDepositRPCServer serves a chain whose head advances from block 100 by one
+// block per eth_blockNumber call, up to block 110. Block 101 holds the given
+// transactions and logs, and transactions whose hash contains "bad" revert.
+func newDepositRPCServer(t *testing.T, transactions, logs string) *httptest.Server {
+	t.Helper()
+	var head atomic.Int64
+	head.Store(99)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		var result string
+		switch req.Method {
+		case "eth_blockNumber":
+			result = fmt.Sprintf("%q", fmt.Sprintf("0x%x", min(head.Add(1), 110)))
+		case "eth_getLogs":
+			var filter struct{ FromBlock, ToBlock string }
+			_ = json.Unmarshal(req.Params[0], &filter)
+			result = "[]"
+			if hexToBigInt(filter.FromBlock).Int64() <= 101 && hexToBigInt(filter.ToBlock).Int64() >= 101 {
+				result = logs
+			}
+		case "eth_getBlockByNumber":
+			result = `{"transactions":[]}`
+			if string(req.Params[0]) == `"0x65"` {
+				result = `{"transactions":` + transactions + `}`
+			}
+		case "eth_getTransactionReceipt":
+			status := "0x1"
+			if strings.Contains(string(req.Params[0]), "bad") {
+				status = "0x0"
+			}
+			result = fmt.Sprintf(`{"transactionHash":%s,"blockNumber":"0x65","status":%q}`, req.Params[0], status)
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%s}`, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// collectDeposits receives deposits until the watch has scanned past block 101
+// and been canceled, and checks that the channel is then closed.
+func collectDeposits(t *testing.T, deposits <-chan Deposit, cancel context.CancelFunc) []Deposit {
+	t.Helper()
+	var got []Deposit
+	timeout := time.After(5 * time.Second)
+	stop := time.After(50 * time.Millisecond)
+	for {
+		select {
+		case deposit, ok := <-deposits:
+			if !ok {
+				return got
+			}
+			got = append(got, deposit)
+		case <-stop:
+			cancel()
+		case <-timeout:
+			t.Fatal("deposit channel was not closed after canceling")
+		}
+	}
+}
+
+func TestWatchDepositsReportsTokenTransfers(t *testing.T) {
+	setFastTimers(t)
+	sender := "0x000000000000000000000000" + testRecipient[2:]
+	logs := fmt.Sprintf(`[{"topics":[%q,%q,"0x0"],"data":"0x0f4240","blockNumber":"0x65","transactionHash":%q,"logIndex":"0x2"}]`,
+		erc20TransferTopic, sender, testTxHash)
+	rpc := newDepositRPCServer(t, "[]", logs)
+	account := &EvmAccount{client: newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL), Address: testOwner}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deposits, err := account.UseNetwork("base-sepolia").WatchDeposits(ctx, "usdc")
+	if err != nil {
+		t.Fatalf("WatchDeposits failed: %v", err)
+	}
+
+	got := collectDeposits(t, deposits, cancel)
+	if len(got) != 1 {
+		t.Fatalf("got %d deposits, want 1 after deduplication: %+v", len(got), got)
+	}
+	deposit := got[0]
+	if deposit.From != testRecipient || deposit.Amount.Int64() != 1_000_000 || deposit.TransactionHash != testTxHash ||
+		deposit.BlockNumber != 101 || deposit.LogIndex != 2 || deposit.Token != "0x036CbD53842c5426634e7929541eC2318f3dCF7e" {
+		t.Errorf("unexpected deposit %+v", deposit)
+	}
+}
+
+func TestWatchDepositsReportsNativeTransfers(t *testing.T) {
+	setFastTimers(t)
+	transactions := fmt.Sprintf(`[
+		{"hash":%q,"from":%q,"to":%q,"value":"0xde0b6b3a7640000"},
+		{"hash":"0xbad","from":%q,"to":%q,"value":"0x1"},
+		{"hash":"0x02","from":%q,"to":%q,"value":"0x1"},
+		{"hash":"0x03","from":%q,"to":%q,"value":"0x0"}
+	]`, testTxHash, testRecipient, strings.ToUpper(testOwner),
+		testRecipient, testOwner,
+		testOwner, testRecipient,
+		testRecipient, testOwner)
+	rpc := newDepositRPCServer(t, transactions, "[]")
+	account := &EvmAccount{client: newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL), Address: testOwner}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deposits, err := account.UseNetwork("base-sepolia").WatchDeposits(ctx, "ETH")
+	if err != nil {
+		t.Fatalf("WatchDeposits failed: %v", err)
+	}
+
+	got := collectDeposits(t, deposits, cancel)
+	if len(got) != 1 {
+		t.Fatalf("got %d deposits, want 1: %+v", len(got), got)
+	}
+	deposit := got[0]
+	if deposit.From != testRecipient || deposit.Amount.String() != "1000000000000000000" || deposit.TransactionHash != testTxHash ||
+		deposit.BlockNumber != 101 || deposit.LogIndex != -1 || deposit.Token != "ETH" {
+		t.Errorf("unexpected deposit %+v", deposit)
+	}
+}
+
+func TestWatchDepositsRejectsUnknownToken(t *testing.T) {
+	account := &EvmAccount{client: newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", "http://127.0.0.1:0"), Address: testOwner}
+	if _, err := account.UseNetwork("base-sepolia").WatchDeposits(context.Background(), "doge"); err == nil {
+		t.Fatal("expected an error for an unknown token")
+	}
+}
@@ -0,0 +1,34 @@
+package cdp
+
+import "fmt"
+
+// Environment is a CDP API deployment.
+type Environment string
+
+// Known environments.
+const (
+	// EnvironmentProduction is the production API, the default.
+	EnvironmentProduction Environment = "production"
+	// EnvironmentStaging is the staging API. It only accepts credentials created
+	// for staging.
+	EnvironmentStaging Environment = "staging"
+)
+
+// environmentBasePaths are the base URLs of the known environments.
+var environmentBasePaths = map[Environment]string{
+	EnvironmentProduction: defaultBasePath,
+	EnvironmentStaging:    "https://api-staging.cdp.coinbase.com/platform",
+}
+
+// BasePathForEnvironment returns the base URL of the CDP API in env, or of
+// production if env is empty. It returns an error for unknown environments.
+func BasePathForEnvironment(env Environment) (string, error) {
+	if env == "" {
+		env = EnvironmentProduction
+	}
+	basePath, ok := environmentBasePaths[env]
+	if !ok {
+		return "", fmt.Errorf("unknown environment %q", env)
+	}
+	return basePath, nil
+}
@@ -0,0 +1,64 @@
+package cdp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBasePathForEnvironment(t *testing.T) {
+	tests := []struct {
+		env  Environment
+		want string
+	}{
+		{"", "https://api.cdp.coinbase.com/platform"},
+		{EnvironmentProduction, "https://api.cdp.coinbase.com/platform"},
+		{EnvironmentStaging, "https://api-staging.cdp.coinbase.com/platform"},
+	}
+	for _, tt := range tests {
+		got, err := BasePathForEnvironment(tt.env)
+		if err != nil || got != tt.want {
+			t.Errorf("BasePathForEnvironment(%q) = %q, %v, want %q", tt.env, got, err, tt.want)
+		}
+	}
+
+	if _, err := BasePathForEnvironment("prod"); err == nil || !strings.Contains(err.Error(), "prod") {
+		t.Errorf("expected an error for an unknown environment, got %v", err)
+	}
+}
+
+func TestClientEnvironment(t *testing.T) {
+	options := ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+	}
+
+	tests := []struct {
+		name     string
+		basePath string
+		env      Environment
+		wantHost string
+	}{
+		{"default", "", "", "api.cdp.coinbase.com"},
+		{"staging", "", EnvironmentStaging, "api-staging.cdp.coinbase.com"},
+		{"base path takes precedence", "https://example.com/platform", EnvironmentStaging, "example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := options
+			options.BasePath, options.Environment = tt.basePath, tt.env
+			client, err := NewClient(options)
+			if err != nil {
+				t.Fatalf("NewClient returned an error: %v", err)
+			}
+			defer client.Close()
+			if host, _ := client.requestHost(t.Context()); host != tt.wantHost {
+				t.Errorf("request host = %q, want %q", host, tt.wantHost)
+			}
+		})
+	}
+
+	options.Environment = "prod"
+	if _, err := NewClient(options); err == nil {
+		t.Error("expected NewClient to reject an unknown environment")
+	}
+}
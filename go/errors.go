@@ -0,0 +1,395 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// APIError is an error response returned by the CDP API.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// ErrorType is the API's error code (e.g. "invalid_request"), if the response
+	// body could be parsed.
+	ErrorType string
+	// ErrorMessage is the API's description of the error.
+	ErrorMessage string
+	// CorrelationID identifies the request in CDP's logs; include it when
+	// contacting support.
+	CorrelationID string
+	// ErrorLink links to documentation for the error type.
+	ErrorLink string
+	// FieldErrors lists the request fields that failed validation, for 400
+	// responses that include field-level details.
+	FieldErrors []FieldError
+	// Operation is the ID of the API operation that failed (e.g.
+	// "GetEvmAccount"), if known.
+	Operation string
+	// Body is the raw response body.
+	Body []byte
+}
+
+// NewAPIError parses an API error response. Bodies that are not in the expected
+// shape are tolerated: the returned error then only carries the status code and
+// the raw body.
+func NewAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Body: body}
+
+	var parsed struct {
+		ErrorType     string          `json:"errorType"`
+		ErrorMessage  string          `json:"errorMessage"`
+		CorrelationID string          `json:"correlationId"`
+		ErrorLink     string          `json:"errorLink"`
+		FieldErrors   json.RawMessage `json:"fieldErrors"`
+		Errors        json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+
+	apiErr.ErrorType = parsed.ErrorType
+	apiErr.ErrorMessage = parsed.ErrorMessage
+	apiErr.CorrelationID = parsed.CorrelationID
+	apiErr.ErrorLink = parsed.ErrorLink
+
+	apiErr.FieldErrors = parseFieldErrors(parsed.FieldErrors)
+	if apiErr.FieldErrors == nil {
+		apiErr.FieldErrors = parseFieldErrors(parsed.Errors)
+	}
+
+	return apiErr
+}
+
+// parseFieldErrors parses a list of field-level validation errors, accepting
+// either "field" or "path" for the field name. Entries without a field name, and
+// lists that are not in this shape, are ignored.
+func parseFieldErrors(raw json.RawMessage) []FieldError {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var entries []struct {
+		Field   string `json:"field"`
+		Path    string `json:"path"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	for _, entry := range entries {
+		field := entry.Field
+		if field == "" {
+			field = entry.Path
+		}
+		if field == "" {
+			continue
+		}
+		fieldErrors = append(fieldErrors, FieldError{Field: field, Message: entry.Message})
+	}
+	return fieldErrors
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.ErrorType == "" && e.ErrorMessage == "" {
+		return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, strings.TrimSpace(string(e.Body)))
+	}
+
+	msg := fmt.Sprintf("%s (status %d): %s", e.ErrorType, e.StatusCode, e.ErrorMessage)
+	for _, fe := range e.FieldErrors {
+		msg += fmt.Sprintf("; %s: %s", fe.Field, fe.Message)
+	}
+	if e.CorrelationID != "" {
+		msg += fmt.Sprintf(" (correlation ID %s)", e.CorrelationID)
+	}
+	return msg
+}
+
+// Common API errors, matched by *APIError with errors.Is. They let callers
+// branch on the kind of failure without comparing ErrorType strings.
+var (
+	// ErrNotFound matches API errors for resources that do not exist: 404
+	// responses, the not_found error type, and errors matching
+	// ErrAccountNotFound.
+	ErrNotFound = errors.New("not found")
+	// ErrAccountNotFound matches API errors for accounts that do not exist: the
+	// source_account_not_found and target_account_not_found error types, and 404
+	// responses and the not_found error type of the account lookups (e.g.
+	// GetEvmAccount). Other 404 responses, such as for unknown routes, do not
+	// match it.
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrInsufficientFunds matches API errors for accounts whose balance does not
+	// cover the amount and fees of an operation. Such errors also match
+	// ErrInsufficientBalance, which BatchTransfer's own balance check returns.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrUnauthorized matches 401 responses and the unauthorized error type,
+	// usually due to invalid or expired credentials.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited matches 429 responses and the rate_limit_exceeded error
+	// type.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrAlreadyExists matches 409 responses and the already_exists error type,
+	// such as when creating an account with a name that is taken.
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// Errors of sending a transaction that the network rejected because a
+// transaction with the same nonce is already pending, matched by *APIError and
+// *RPCError with errors.Is.
+var (
+	// ErrReplacementUnderpriced matches rejections of a transaction that would
+	// replace a pending one with the same nonce but does not raise its fees
+	// enough. Resend it with higher fees, or wait for the pending one.
+	ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrAlreadyKnown matches rejections of a transaction that the network
+	// already has, such as one resent after its first send timed out. It has been
+	// submitted, so it can be tracked by its hash rather than sent again.
+	ErrAlreadyKnown = errors.New("transaction already known")
+)
+
+// replacementUnderpricedMessages and alreadyKnownMessages match the messages with
+// which nodes reject such transactions. The phrases must start at a word
+// boundary, so that "known transaction" does not match "unknown transaction
+// type".
+var (
+	replacementUnderpricedMessages = regexp.MustCompile(`(?i)\b(replacement transaction underpriced|replacement_underpriced)`)
+	alreadyKnownMessages           = regexp.MustCompile(`(?i)\b(already known|known transaction|already imported)`)
+)
+
+// isSendError reports whether message is one of the rejection messages for
+// target, ErrReplacementUnderpriced or ErrAlreadyKnown.
+func isSendError(target error, message string) bool {
+	switch target {
+	case ErrReplacementUnderpriced:
+		return replacementUnderpricedMessages.MatchString(message)
+	case ErrAlreadyKnown:
+		return alreadyKnownMessages.MatchString(message)
+	default:
+		return false
+	}
+}
+
+// message returns the error message of e, or its body if it has none.
+func (e *APIError) message() string {
+	if e.ErrorMessage != "" {
+		return e.ErrorMessage
+	}
+	return string(e.Body)
+}
+
+// Is reports whether target is one of the common API errors that e represents,
+// so that errors.Is(err, ErrNotFound) and the like work on wrapped API errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.ErrorType == "not_found" || e.Is(ErrAccountNotFound)
+	case ErrAccountNotFound:
+		if e.ErrorType == "source_account_not_found" || e.ErrorType == "target_account_not_found" {
+			return true
+		}
+		return accountLookupOperations[e.Operation] && (e.StatusCode == http.StatusNotFound || e.ErrorType == "not_found")
+	case ErrInsufficientFunds, ErrInsufficientBalance:
+		return e.ErrorType == "insufficient_balance"
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.ErrorType == "unauthorized"
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.ErrorType == "rate_limit_exceeded"
+	case ErrAlreadyExists:
+		return e.StatusCode == http.StatusConflict || e.ErrorType == "already_exists"
+	case ErrReplacementUnderpriced, ErrAlreadyKnown:
+		return isSendError(target, e.message())
+	}
+	return false
+}
+
+// accountLookupOperations are the operations that look up a single account, whose
+// not found errors match ErrAccountNotFound.
+var accountLookupOperations = map[string]bool{
+	"GetEvmAccount":            true,
+	"GetEvmAccountByName":      true,
+	"GetEvmSmartAccount":       true,
+	"GetEvmSmartAccountByName": true,
+	"GetSolanaAccount":         true,
+	"GetSolanaAccountByName":   true,
+}
+
+// correlationIDHeaders are the response headers that may carry the ID of a
+// request in CDP's logs, in order of preference.
+var correlationIDHeaders = []string{"X-Correlation-Id", "X-Request-Id"}
+
+// ParseError returns the *APIError for resp if its status is not 2xx, and nil
+// otherwise. It is meant for responses of the generated API methods, which return
+// error responses without an error; the response body is read and then restored,
+// so it can still be read by the caller. The correlation ID is taken from the
+// X-Correlation-Id or X-Request-Id header if the body does not carry one, and the
+// operation from the request.
+//
+// For the *WithResponse methods, whose bodies have already been read, use
+// NewAPIError(resp.StatusCode(), resp.Body) and check the status first.
+func ParseError(resp *http.Response) error {
+	if resp == nil || resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var body []byte
+	if resp.Body != nil {
+		body, _ = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	apiErr := NewAPIError(resp.StatusCode, body)
+	for _, header := range correlationIDHeaders {
+		if apiErr.CorrelationID != "" {
+			break
+		}
+		apiErr.CorrelationID = resp.Header.Get(header)
+	}
+	apiErr.Operation = requestOperation(resp.Request)
+	return apiErr
+}
+
+// requestOperation returns the ID of the API operation of req, or "" if req is
+// nil or not an API request.
+func requestOperation(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+	operation, _ := openapi.LookupOperation(req.Method, req.URL.Path)
+	return operation.ID
+}
+
+// unexpectedStatusError returns an error wrapping the *APIError for a response
+// with an unexpected status code. The action describes what the SDK was trying to
+// do (e.g. "request faucet funds").
+func unexpectedStatusError(action string, statusCode int, body []byte) error {
+	return fmt.Errorf("failed to %s: %w", action, NewAPIError(statusCode, body))
+}
+
+// unexpectedResponseError is unexpectedStatusError for the response resp of a
+// *WithResponse method, whose body has already been read into body. The returned
+// *APIError also records the operation of the request.
+func unexpectedResponseError(action string, resp *http.Response, body []byte) error {
+	if resp == nil {
+		return unexpectedStatusError(action, 0, body)
+	}
+	apiErr := NewAPIError(resp.StatusCode, body)
+	apiErr.Operation = requestOperation(resp.Request)
+	return fmt.Errorf("failed to %s: %w", action, apiErr)
+}
+
+// BatchError is returned by batch operations when one or more items fail. Errors
+// has one entry per input item, in input order; entries for items that succeeded
+// are nil.
+type BatchError struct {
+	Errors []error
+}
+
+// Error implements the error interface.
+func (e *BatchError) Error() string {
+	var failed []string
+	for i, err := range e.Errors {
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("[%d] %v", i, err))
+		}
+	}
+	return fmt.Sprintf("%d of %d items failed: %s", len(failed), len(e.Errors), strings.Join(failed, "; "))
+}
+
+// Unwrap returns the errors of the failed items, so errors.Is and errors.As match
+// any of them.
+func (e *BatchError) Unwrap() []error {
+	var errs []error
+	for _, err := range e.Errors {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Reasons a request failed without a response, reported by RequestError.
+var (
+	// ErrContextDeadline means the request's context deadline passed. Allow more
+	// time in the context, or make the work smaller.
+	ErrContextDeadline = errors.New("context deadline exceeded")
+	// ErrClientTimeout means a timeout of the HTTP client, such as a dial or
+	// response header timeout or ClientOptions.Timeout, expired. It usually points
+	// at network problems between the client and CDP, or at a slow API.
+	ErrClientTimeout = errors.New("HTTP client timeout")
+	// ErrRetriesExhausted means every attempt allowed by ClientOptions.MaxRetries
+	// failed with a retryable error. The API or the network was unavailable for
+	// longer than the retry budget.
+	ErrRetriesExhausted = errors.New("retries exhausted")
+)
+
+// RequestError is returned when an HTTP request fails without a response, with
+// diagnostics on how it failed. errors.Is matches its Reason, the underlying
+// error (e.g. context.DeadlineExceeded), and ErrClientTimeout whenever the last
+// attempt hit a network timeout. Timeouts reported by the server arrive as
+// responses (e.g. 504 Gateway Timeout) rather than as a RequestError.
+type RequestError struct {
+	// Reason is ErrContextDeadline, ErrClientTimeout or ErrRetriesExhausted, or
+	// nil if the failure was none of these.
+	Reason error
+	// Elapsed is the time from the first attempt until the request gave up.
+	Elapsed time.Duration
+	// Attempts is the number of attempts made.
+	Attempts int
+	// Err is the error of the last attempt.
+	Err error
+}
+
+// newRequestError wraps err, the error that made req give up after attempts
+// attempts since start. exhausted reports whether the error was retryable.
+func newRequestError(req *http.Request, err error, start time.Time, attempts int, exhausted bool) error {
+	reqErr := &RequestError{Elapsed: time.Since(start), Attempts: attempts, Err: err}
+	switch {
+	case errors.Is(req.Context().Err(), context.DeadlineExceeded):
+		reqErr.Reason = ErrContextDeadline
+	case exhausted && attempts > 1:
+		reqErr.Reason = ErrRetriesExhausted
+	case isNetTimeout(err):
+		reqErr.Reason = ErrClientTimeout
+	}
+	return reqErr
+}
+
+// isNetTimeout reports whether err is a network timeout.
+func isNetTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// Error implements the error interface.
+func (e *RequestError) Error() string {
+	if e.Reason == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v after %s and %d attempt(s): %v", e.Reason, e.Elapsed.Round(time.Millisecond), e.Attempts, e.Err)
+}
+
+// Unwrap returns the reason and the underlying error.
+func (e *RequestError) Unwrap() []error {
+	errs := []error{e.Err}
+	if e.Reason != nil {
+		errs = append(errs, e.Reason)
+	}
+	if e.Reason != ErrClientTimeout && isNetTimeout(e.Err) {
+		errs = append(errs, ErrClientTimeout)
+	}
+	return errs
+}
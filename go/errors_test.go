@@ -0,0 +1,246 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewAPIErrorParsesFieldErrors(t *testing.T) {
+	body := []byte(`{
+		"errorType": "invalid_request",
+		"errorMessage": "Request body is invalid.",
+		"correlationId": "8f2c0b1a",
+		"errorLink": "https://docs.cdp.coinbase.com/api-reference/v2/errors#invalid-request",
+		"fieldErrors": [
+			{"field": "network", "message": "must be one of base, base-sepolia"},
+			{"field": "calls[0].to", "message": "must be a valid address"}
+		]
+	}`)
+
+	apiErr := NewAPIError(http.StatusBadRequest, body)
+
+	if apiErr.ErrorType != "invalid_request" || apiErr.CorrelationID != "8f2c0b1a" {
+		t.Errorf("unexpected error %+v", apiErr)
+	}
+	want := []FieldError{
+		{Field: "network", Message: "must be one of base, base-sepolia"},
+		{Field: "calls[0].to", Message: "must be a valid address"},
+	}
+	if fmt.Sprint(apiErr.FieldErrors) != fmt.Sprint(want) {
+		t.Errorf("FieldErrors = %+v, want %+v", apiErr.FieldErrors, want)
+	}
+	if !strings.Contains(apiErr.Error(), "calls[0].to: must be a valid address") {
+		t.Errorf("Error() = %q does not mention the field errors", apiErr.Error())
+	}
+}
+
+func TestNewAPIErrorAcceptsErrorsListWithPaths(t *testing.T) {
+	apiErr := NewAPIError(http.StatusBadRequest, []byte(`{
+		"errorType": "invalid_request",
+		"errorMessage": "bad",
+		"errors": [{"path": "address", "message": "is required"}, {"message": "no field"}]
+	}`))
+
+	if len(apiErr.FieldErrors) != 1 || apiErr.FieldErrors[0].Field != "address" {
+		t.Errorf("FieldErrors = %+v", apiErr.FieldErrors)
+	}
+}
+
+func TestNewAPIErrorToleratesUnexpectedBodies(t *testing.T) {
+	tests := []string{
+		`<html>Bad Gateway</html>`,
+		``,
+		`{"errorType":"invalid_request","errorMessage":"bad","fieldErrors":"not a list"}`,
+	}
+
+	for _, body := range tests {
+		apiErr := NewAPIError(http.StatusBadRequest, []byte(body))
+		if apiErr.StatusCode != http.StatusBadRequest || apiErr.FieldErrors != nil {
+			t.Errorf("NewAPIError(%q) = %+v", body, apiErr)
+		}
+		if apiErr.Error() == "" {
+			t.Errorf("NewAPIError(%q) has an empty message", body)
+		}
+	}
+}
+
+func TestHelpersReturnAPIError(t *testing.T) {
+	server := newJSONServer(t, http.StatusBadRequest, `{"errorType":"invalid_request","errorMessage":"bad","fieldErrors":[{"field":"message","message":"is required"}]}`)
+
+	account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+	_, err := account.SignMessage(context.Background(), nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %v", err)
+	}
+	if len(apiErr.FieldErrors) != 1 || apiErr.FieldErrors[0].Field != "message" {
+		t.Errorf("FieldErrors = %+v", apiErr.FieldErrors)
+	}
+}
+
+func TestAPIErrorMatchesCommonErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   []error
+	}{
+		{"not found status", http.StatusNotFound, `{}`, []error{ErrNotFound}},
+		{"account not found", http.StatusNotFound, `{"errorType":"target_account_not_found","errorMessage":"no such account"}`, []error{ErrNotFound, ErrAccountNotFound}},
+		{"not found type", http.StatusBadRequest, `{"errorType":"not_found","errorMessage":"no such account"}`, []error{ErrNotFound}},
+		{"insufficient balance", http.StatusBadRequest, `{"errorType":"insufficient_balance","errorMessage":"not enough ETH"}`, []error{ErrInsufficientFunds, ErrInsufficientBalance}},
+		{"unauthorized", http.StatusUnauthorized, `{"errorType":"unauthorized","errorMessage":"bad key"}`, []error{ErrUnauthorized}},
+		{"rate limited", http.StatusTooManyRequests, `{"errorType":"rate_limit_exceeded","errorMessage":"slow down"}`, []error{ErrRateLimited}},
+		{"already exists", http.StatusConflict, `{"errorType":"already_exists","errorMessage":"name taken"}`, []error{ErrAlreadyExists}},
+		{"other", http.StatusBadRequest, `{"errorType":"invalid_request","errorMessage":"bad"}`, nil},
+	}
+	all := []error{ErrNotFound, ErrAccountNotFound, ErrInsufficientFunds, ErrUnauthorized, ErrRateLimited, ErrAlreadyExists}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := unexpectedStatusError("do something", tt.status, []byte(tt.body))
+			for _, target := range all {
+				want := false
+				for _, w := range tt.want {
+					want = want || w == target
+				}
+				if got := errors.Is(err, target); got != want {
+					t.Errorf("errors.Is(err, %v) = %v, want %v", target, got, want)
+				}
+			}
+			for _, target := range tt.want {
+				if !errors.Is(err, target) {
+					t.Errorf("expected err to match %v", target)
+				}
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v2/evm/accounts/"+testOwner {
+			fmt.Fprintf(w, `{"address":%q}`, testOwner)
+			return
+		}
+		w.Header().Set("X-Correlation-Id", "corr-123")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorType":"not_found","errorMessage":"account not found"}`)
+	}))
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	resp, err := client.GetEvmAccount(context.Background(), testOwner)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if err := ParseError(resp); err != nil {
+		t.Errorf("expected no error for a 200 response, got %v", err)
+	}
+
+	resp, err = client.GetEvmAccountByName(context.Background(), "missing")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	err = ParseError(resp)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected a not found *APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.ErrorType != "not_found" || apiErr.ErrorMessage != "account not found" || apiErr.CorrelationID != "corr-123" {
+		t.Errorf("unexpected error %+v", apiErr)
+	}
+	if body, _ := io.ReadAll(resp.Body); !strings.Contains(string(body), "not_found") {
+		t.Errorf("expected the body to remain readable, got %q", body)
+	}
+}
+
+func TestAccountLookupNotFound(t *testing.T) {
+	server := newJSONServer(t, http.StatusNotFound, `{}`)
+	client := newTestClient(t, server.URL)
+
+	resp, err := client.GetEvmAccount(context.Background(), testOwner)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	err = ParseError(resp)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Operation != "GetEvmAccount" {
+		t.Fatalf("expected an *APIError for GetEvmAccount, got %v", err)
+	}
+	if !errors.Is(err, ErrAccountNotFound) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a 404 from GetEvmAccount to match ErrAccountNotFound and ErrNotFound, got %v", err)
+	}
+
+	if _, err := client.GetSolanaAccount(context.Background(), testSolanaAddress); !errors.Is(err, ErrAccountNotFound) {
+		t.Errorf("expected GetSolanaAccount to return ErrAccountNotFound, got %v", err)
+	}
+
+	resp, err = client.GetPolicyById(context.Background(), "missing")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if err := ParseError(resp); errors.Is(err, ErrAccountNotFound) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a 404 from another operation to match only ErrNotFound, got %v", err)
+	}
+}
+
+func TestSendErrorsAreClassified(t *testing.T) {
+	tests := []struct {
+		message string
+		want    error
+	}{
+		{"replacement transaction underpriced", ErrReplacementUnderpriced},
+		{"failed to send transaction: Replacement transaction underpriced", ErrReplacementUnderpriced},
+		{"REPLACEMENT_UNDERPRICED", ErrReplacementUnderpriced},
+		{"already known", ErrAlreadyKnown},
+		{"known transaction: 0x1234", ErrAlreadyKnown},
+		{"Transaction with the same hash was already imported.", ErrAlreadyKnown},
+		{"unknown transaction type", nil},
+		{"rlp: unknown transaction type 5", nil},
+		{"transaction underpriced", nil},
+		{"nonce too low", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, `{"errorType":"invalid_request","errorMessage":%q}`, tt.message)
+			}))
+			defer server.Close()
+			account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+
+			_, err := account.SendTransaction(context.Background(), "base-sepolia", TransactionRequest{To: testRecipient, Data: "0x"})
+			if err == nil {
+				t.Fatal("expected SendTransaction to fail")
+			}
+			for _, target := range []error{ErrReplacementUnderpriced, ErrAlreadyKnown} {
+				if got := errors.Is(err, target); got != (target == tt.want) {
+					t.Errorf("errors.Is(err, %v) = %v for %q", target, got, tt.message)
+				}
+			}
+
+			rpcErr := fmt.Errorf("wrapped: %w", &RPCError{Code: -32000, Message: tt.message})
+			for _, target := range []error{ErrReplacementUnderpriced, ErrAlreadyKnown} {
+				if got := errors.Is(rpcErr, target); got != (target == tt.want) {
+					t.Errorf("errors.Is(rpcErr, %v) = %v for %q", target, got, tt.message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package cdp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrExportNotAllowed is returned by Export when the account's policies do not allow
+// its private key to be exported.
+var ErrExportNotAllowed = errors.New("private key export is not allowed for this account")
+
+// exportKeyBits is the size of the ephemeral RSA key used to encrypt exported keys
+// in transport.
+var exportKeyBits = 4096
+
+// Export exports the account's private key and returns it as a hex string without
+// a 0x prefix. It returns an error wrapping ErrExportNotAllowed if export is
+// disabled for the account by policy.
+//
+// The key is encrypted in transport: Export generates a single-use RSA key pair,
+// sends only its public part to the API, and decrypts the returned ciphertext
+// locally with RSA-OAEP-SHA256. The SDK never logs the exported key; callers are
+// responsible for storing it securely.
+func (a *EvmAccount) Export(ctx context.Context) (string, error) {
+	exportKey, err := rsa.GenerateKey(rand.Reader, exportKeyBits)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate export encryption key: %w", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&exportKey.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode export encryption key: %w", err)
+	}
+
+	resp, err := a.client.ExportEvmAccountWithResponse(ctx, a.Address, nil, openapi.ExportEvmAccountJSONRequestBody{
+		ExportEncryptionKey: base64.StdEncoding.EncodeToString(publicKey),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to export account: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		// Only policy rejections mean export is disabled; other errors, including
+		// other 403s, are returned as they are.
+		if NewAPIError(resp.StatusCode(), resp.Body).ErrorType == string(openapi.ErrorTypePolicyViolation) {
+			return "", fmt.Errorf("%w: %s", ErrExportNotAllowed, a.Address)
+		}
+		return "", unexpectedStatusError("export account", resp.StatusCode(), resp.Body)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(resp.JSON200.EncryptedPrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode encrypted private key: %w", err)
+	}
+	privateKey, err := rsa.DecryptOAEP(sha256.New(), nil, exportKey, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt private key: %w", err)
+	}
+	defer clear(privateKey)
+
+	return hex.EncodeToString(privateKey), nil
+}
@@ -0,0 +1,88 @@
+package cdp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExportDecryptsPrivateKey(t *testing.T) {
+	exportKeyBits = 2048
+	t.Cleanup(func() { exportKeyBits = 4096 })
+
+	privateKey := make([]byte, 32)
+	for i := range privateKey {
+		privateKey[i] = byte(i)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ ExportEncryptionKey string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		der, _ := base64.StdEncoding.DecodeString(body.ExportEncryptionKey)
+		publicKey, err := x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			t.Errorf("failed to parse export encryption key: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ciphertext, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey.(*rsa.PublicKey), privateKey, nil)
+		if err != nil {
+			t.Errorf("failed to encrypt: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"encryptedPrivateKey":%q}`, base64.StdEncoding.EncodeToString(ciphertext))
+	}))
+	defer server.Close()
+
+	account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+	got, err := account.Export(context.Background())
+	if err != nil {
+		t.Fatalf("Export returned an error: %v", err)
+	}
+	if want := "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"; got != want {
+		t.Errorf("Export = %s, want %s", got, want)
+	}
+}
+
+func TestExportNotAllowedByPolicy(t *testing.T) {
+	exportKeyBits = 2048
+	t.Cleanup(func() { exportKeyBits = 4096 })
+
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		notAllowed bool
+	}{
+		{"policy violation", http.StatusBadRequest, `{"errorType":"policy_violation","errorMessage":"export is disabled"}`, true},
+		{"forbidden policy violation", http.StatusForbidden, `{"errorType":"policy_violation","errorMessage":"export is disabled"}`, true},
+		{"other forbidden", http.StatusForbidden, `{"errorType":"forbidden","errorMessage":"project is suspended"}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newJSONServer(t, tt.status, tt.body)
+
+			account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+			_, err := account.Export(context.Background())
+			var apiErr *APIError
+			if tt.notAllowed && !errors.Is(err, ErrExportNotAllowed) {
+				t.Errorf("expected ErrExportNotAllowed, got %v", err)
+			}
+			if !tt.notAllowed && (errors.Is(err, ErrExportNotAllowed) || !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status) {
+				t.Errorf("expected a %d *APIError, got %v", tt.status, err)
+			}
+		})
+	}
+}
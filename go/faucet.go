@@ -0,0 +1,309 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrFaucetCooldown is matched (via errors.Is) by the *FaucetCooldownError returned
+// when a faucet request is made before the configured cooldown has elapsed.
+var ErrFaucetCooldown = errors.New("faucet cooldown in effect")
+
+// FaucetCooldownError is returned by Client.RequestFaucet when the same address,
+// network, and token were funded too recently. No request is sent.
+type FaucetCooldownError struct {
+	Address string
+	Network string
+	Token   string
+	// Remaining is how long until the cooldown expires.
+	Remaining time.Duration
+}
+
+// Error implements the error interface.
+func (e *FaucetCooldownError) Error() string {
+	return fmt.Sprintf("faucet cooldown in effect for %s %s on %s: retry in %s", e.Address, e.Token, e.Network, e.Remaining.Round(time.Millisecond))
+}
+
+// Is reports whether target is ErrFaucetCooldown.
+func (e *FaucetCooldownError) Is(target error) bool {
+	return target == ErrFaucetCooldown
+}
+
+// ErrFaucetUnavailable is matched (via errors.Is) by the *FaucetUnavailableError
+// returned when the faucet refuses a request because it is rate-limited or has run
+// out of funds.
+var ErrFaucetUnavailable = errors.New("faucet unavailable")
+
+// faucetRetryDelay is how long RequestFaucet waits before retrying a request the
+// faucet refused, if the faucet doesn't say when to retry.
+var faucetRetryDelay = 30 * time.Second
+
+// FaucetUnavailableError is returned by Client.RequestFaucet when the faucet refuses
+// to fund an address because it is rate-limited or exhausted. The address is left
+// unfunded.
+type FaucetUnavailableError struct {
+	Address string
+	Network string
+	Token   string
+	// RateLimited is true if the faucet's limit for the address, project or token
+	// was exceeded, and false if the faucet is exhausted or otherwise unavailable.
+	RateLimited bool
+	// RetryAfter is how long the faucet asked to wait before retrying, from the
+	// response's Retry-After header, or zero if it didn't say.
+	RetryAfter time.Duration
+	// Err is the faucet's error response.
+	Err *APIError
+}
+
+// Error implements the error interface.
+func (e *FaucetUnavailableError) Error() string {
+	reason := "unavailable"
+	if e.RateLimited {
+		reason = "rate-limited"
+	}
+	msg := fmt.Sprintf("faucet %s for %s %s on %s", reason, e.Address, e.Token, e.Network)
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(": retry in %s", e.RetryAfter)
+	}
+	return msg + ": " + e.Err.Error()
+}
+
+// Is reports whether target is ErrFaucetUnavailable.
+func (e *FaucetUnavailableError) Is(target error) bool {
+	return target == ErrFaucetUnavailable
+}
+
+// Unwrap returns the faucet's error response.
+func (e *FaucetUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// FaucetRequest describes a request for testnet funds.
+type FaucetRequest struct {
+	// Address is the address to fund.
+	Address string
+	// Network is the testnet to fund the address on (e.g. "base-sepolia", or
+	// "solana-devnet" for a Solana address). Other Solana networks are rejected.
+	Network string
+	// Token is the token to request (e.g. "eth", "sol" or "usdc").
+	Token string
+	// WaitForCooldown waits for the cooldown to expire instead of returning a
+	// *FaucetCooldownError. Has no effect unless ClientOptions.FaucetCooldown is set.
+	WaitForCooldown bool
+	// RetryIfUnavailable waits and retries the request once if the faucet is
+	// rate-limited or exhausted, instead of returning a *FaucetUnavailableError
+	// straight away. It waits as long as the faucet's Retry-After header asks, or 30
+	// seconds if it doesn't say.
+	RetryIfUnavailable bool
+}
+
+// faucetKey identifies a faucet request for cooldown tracking.
+type faucetKey struct {
+	address, network, token string
+}
+
+// faucetTracker records when each (address, network, token) was last funded.
+type faucetTracker struct {
+	mu   sync.Mutex
+	last map[faucetKey]time.Time
+}
+
+// reserve claims key for a faucet request if its cooldown has expired, and
+// otherwise returns how long until it expires. Claiming and checking happen under
+// one lock, so concurrent requests for the same key cannot both pass the check.
+// A claim counts as funding until release is called, which undoes the claim if
+// the request failed and records the funding time if it succeeded.
+func (t *faucetTracker) reserve(key faucetKey, cooldown time.Duration) (remaining time.Duration, release func(funded bool)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prev, hadPrev := t.last[key]
+	if hadPrev {
+		if remaining := cooldown - time.Since(prev); remaining > 0 {
+			return remaining, nil
+		}
+	}
+	if t.last == nil {
+		t.last = make(map[faucetKey]time.Time)
+	}
+	claimed := time.Now()
+	t.last[key] = claimed
+
+	return 0, func(funded bool) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		switch {
+		case !t.last[key].Equal(claimed):
+			// The key was claimed again since; leave that claim alone.
+		case funded:
+			t.last[key] = time.Now()
+		case hadPrev:
+			t.last[key] = prev
+		default:
+			delete(t.last, key)
+		}
+	}
+}
+
+// RequestFaucet requests testnet funds for an EVM address, or a Solana address on
+// solana-devnet, and returns the hash of the funding transaction (its signature
+// on Solana).
+//
+// When ClientOptions.FaucetCooldown is set, the client remembers when each
+// (address, network, token) was last funded successfully. A repeat request within
+// the cooldown returns a *FaucetCooldownError without contacting the faucet, or
+// waits for the cooldown to expire if req.WaitForCooldown is set.
+//
+// If the faucet is rate-limited or exhausted, RequestFaucet returns a
+// *FaucetUnavailableError, after retrying once if req.RetryIfUnavailable is set.
+func (c *Client) RequestFaucet(ctx context.Context, req FaucetRequest) (string, error) {
+	if err := c.checkWritable(); err != nil {
+		return "", err
+	}
+
+	key := faucetKey{
+		address: req.Address,
+		network: req.Network,
+		token:   strings.ToLower(req.Token),
+	}
+	if isSolanaNetwork(req.Network) {
+		if req.Network != SolanaDevnet {
+			return "", fmt.Errorf("no faucet for Solana network %q: only %s can be funded", req.Network, SolanaDevnet)
+		}
+		if err := ValidateSolanaAddress(req.Address); err != nil {
+			return "", err
+		}
+	} else {
+		// EVM addresses are case-insensitive; Solana addresses are not.
+		key.address = strings.ToLower(req.Address)
+	}
+
+	if cooldown := c.options.FaucetCooldown; cooldown > 0 {
+		for {
+			remaining, release := c.faucets.reserve(key, cooldown)
+			if release != nil {
+				hash, err := c.requestFaucetRetrying(ctx, req)
+				release(err == nil)
+				return hash, err
+			}
+			if !req.WaitForCooldown {
+				return "", &FaucetCooldownError{
+					Address:   req.Address,
+					Network:   req.Network,
+					Token:     req.Token,
+					Remaining: remaining,
+				}
+			}
+
+			timer := time.NewTimer(remaining)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return "", ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	return c.requestFaucetRetrying(ctx, req)
+}
+
+// requestFaucetRetrying sends a faucet request, retrying once if the faucet is
+// unavailable and req.RetryIfUnavailable is set.
+func (c *Client) requestFaucetRetrying(ctx context.Context, req FaucetRequest) (string, error) {
+	hash, err := c.requestFaucet(ctx, req)
+	var unavailable *FaucetUnavailableError
+	if req.RetryIfUnavailable && errors.As(err, &unavailable) {
+		delay := unavailable.RetryAfter
+		if delay <= 0 {
+			delay = faucetRetryDelay
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return "", ctx.Err()
+		case <-timer.C:
+		}
+		hash, err = c.requestFaucet(ctx, req)
+	}
+	if err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// requestFaucet sends a single faucet request and returns the hash of the funding
+// transaction.
+func (c *Client) requestFaucet(ctx context.Context, req FaucetRequest) (string, error) {
+	var status int
+	var body []byte
+	var header http.Header
+	if isSolanaNetwork(req.Network) {
+		solanaResp, err := c.RequestSolanaFaucetWithResponse(ctx, openapi.RequestSolanaFaucetJSONRequestBody{
+			Address: req.Address,
+			Token:   openapi.RequestSolanaFaucetJSONBodyToken(strings.ToLower(req.Token)),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to request faucet funds: %w", err)
+		}
+		if solanaResp.StatusCode() == http.StatusOK && solanaResp.JSON200 != nil {
+			return solanaResp.JSON200.TransactionSignature, nil
+		}
+		status, body, header = solanaResp.StatusCode(), solanaResp.Body, solanaResp.HTTPResponse.Header
+	} else {
+		evmResp, err := c.RequestEvmFaucetWithResponse(ctx, openapi.RequestEvmFaucetJSONRequestBody{
+			Address: req.Address,
+			Network: openapi.RequestEvmFaucetJSONBodyNetwork(req.Network),
+			Token:   openapi.RequestEvmFaucetJSONBodyToken(req.Token),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to request faucet funds: %w", err)
+		}
+		if evmResp.StatusCode() == http.StatusOK && evmResp.JSON200 != nil {
+			return evmResp.JSON200.TransactionHash, nil
+		}
+		status, body, header = evmResp.StatusCode(), evmResp.Body, evmResp.HTTPResponse.Header
+	}
+
+	apiErr := NewAPIError(status, body)
+	rateLimited := status == http.StatusTooManyRequests ||
+		apiErr.ErrorType == string(openapi.ErrorTypeFaucetLimitExceeded) ||
+		apiErr.ErrorType == string(openapi.ErrorTypeRateLimitExceeded)
+	if rateLimited || status == http.StatusServiceUnavailable {
+		return "", &FaucetUnavailableError{
+			Address:     req.Address,
+			Network:     req.Network,
+			Token:       req.Token,
+			RateLimited: rateLimited,
+			RetryAfter:  parseRetryAfter(header.Get("Retry-After")),
+			Err:         apiErr,
+		}
+	}
+	return "", fmt.Errorf("failed to request faucet funds: %w", apiErr)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date. It returns zero if the value is empty or
+// invalid.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0)
+	}
+	return 0
+}
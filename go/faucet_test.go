@@ -0,0 +1,238 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFaucetServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":"0x%064x"}`, n)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newFaucetClient(t *testing.T, serverURL string, cooldown time.Duration) *Client {
+	t.Helper()
+	return newTestClientWithOptions(t, serverURL, ClientOptions{FaucetCooldown: cooldown})
+}
+
+var testFaucetRequest = FaucetRequest{
+	Address: "0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8",
+	Network: "base-sepolia",
+	Token:   "eth",
+}
+
+func TestRequestFaucetCooldownRejectsBackToBackRequests(t *testing.T) {
+	var requests atomic.Int32
+	client := newFaucetClient(t, newFaucetServer(t, &requests).URL, time.Hour)
+
+	if _, err := client.RequestFaucet(context.Background(), testFaucetRequest); err != nil {
+		t.Fatalf("first request failed: %v", err)
+	}
+
+	_, err := client.RequestFaucet(context.Background(), testFaucetRequest)
+	if !errors.Is(err, ErrFaucetCooldown) {
+		t.Fatalf("expected ErrFaucetCooldown, got %v", err)
+	}
+
+	var cooldownErr *FaucetCooldownError
+	if !errors.As(err, &cooldownErr) {
+		t.Fatalf("expected *FaucetCooldownError, got %T", err)
+	}
+	if cooldownErr.Remaining <= 59*time.Minute || cooldownErr.Remaining > time.Hour {
+		t.Errorf("Remaining = %s, want just under 1h", cooldownErr.Remaining)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected 1 request to reach the faucet, got %d", n)
+	}
+
+	// A different token is tracked separately.
+	other := testFaucetRequest
+	other.Token = "usdc"
+	if _, err := client.RequestFaucet(context.Background(), other); err != nil {
+		t.Fatalf("request for a different token failed: %v", err)
+	}
+}
+
+func TestRequestFaucetCooldownIsAtomic(t *testing.T) {
+	var requests atomic.Int32
+	client := newFaucetClient(t, newFaucetServer(t, &requests).URL, time.Hour)
+
+	var wg sync.WaitGroup
+	var funded, cooledDown atomic.Int32
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.RequestFaucet(context.Background(), testFaucetRequest)
+			switch {
+			case err == nil:
+				funded.Add(1)
+			case errors.Is(err, ErrFaucetCooldown):
+				cooledDown.Add(1)
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if funded.Load() != 1 || cooledDown.Load() != 9 || requests.Load() != 1 {
+		t.Errorf("got %d funded and %d cooled down with %d faucet requests, want 1, 9 and 1", funded.Load(), cooledDown.Load(), requests.Load())
+	}
+}
+
+func TestRequestFaucetFailureDoesNotStartCooldown(t *testing.T) {
+	var requests atomic.Int32
+	server := newUnavailableFaucetServer(t, 1, http.StatusTooManyRequests, "faucet_limit_exceeded", "", &requests)
+	client := newFaucetClient(t, server.URL, time.Hour)
+
+	if _, err := client.RequestFaucet(context.Background(), testFaucetRequest); err == nil {
+		t.Fatal("expected the first request to fail")
+	}
+	if _, err := client.RequestFaucet(context.Background(), testFaucetRequest); err != nil {
+		t.Fatalf("expected a retry after a failed request to reach the faucet, got %v", err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected 2 requests to reach the faucet, got %d", n)
+	}
+}
+
+func TestRequestFaucetWaitsForCooldown(t *testing.T) {
+	var requests atomic.Int32
+	client := newFaucetClient(t, newFaucetServer(t, &requests).URL, 50*time.Millisecond)
+
+	req := testFaucetRequest
+	req.WaitForCooldown = true
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, err := client.RequestFaucet(context.Background(), req); err != nil {
+			t.Fatalf("request %d failed: %v", i, err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("second request did not wait out the cooldown (elapsed %s)", elapsed)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected 2 requests to reach the faucet, got %d", n)
+	}
+}
+
+func TestRequestFaucetWithoutCooldownTracking(t *testing.T) {
+	var requests atomic.Int32
+	client := newFaucetClient(t, newFaucetServer(t, &requests).URL, 0)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.RequestFaucet(context.Background(), testFaucetRequest); err != nil {
+			t.Fatalf("request %d failed: %v", i, err)
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected 2 requests to reach the faucet, got %d", n)
+	}
+}
+
+// newUnavailableFaucetServer returns a faucet that refuses the first refusals
+// requests with status and errorType, and funds the address afterwards.
+func newUnavailableFaucetServer(t *testing.T, refusals int32, status int, errorType, retryAfter string, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if n <= refusals {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			fmt.Fprintf(w, `{"errorType":%q,"errorMessage":"faucet refused"}`, errorType)
+			return
+		}
+		fmt.Fprintf(w, `{"transactionHash":"0x%064x"}`, n)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRequestFaucetUnavailable(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		errorType       string
+		retryAfter      string
+		wantRateLimited bool
+		wantRetryAfter  time.Duration
+	}{
+		{"rate-limited", http.StatusTooManyRequests, "faucet_limit_exceeded", "120", true, 2 * time.Minute},
+		{"exhausted", http.StatusServiceUnavailable, "service_unavailable", "", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := newUnavailableFaucetServer(t, 1, tt.status, tt.errorType, tt.retryAfter, &requests)
+			client := newTestClientWithOptions(t, server.URL, ClientOptions{MaxRetries: -1})
+
+			_, err := client.RequestFaucet(context.Background(), testFaucetRequest)
+			if !errors.Is(err, ErrFaucetUnavailable) {
+				t.Fatalf("expected ErrFaucetUnavailable, got %v", err)
+			}
+			var unavailable *FaucetUnavailableError
+			if !errors.As(err, &unavailable) {
+				t.Fatalf("expected *FaucetUnavailableError, got %T", err)
+			}
+			if unavailable.RateLimited != tt.wantRateLimited || unavailable.RetryAfter != tt.wantRetryAfter {
+				t.Errorf("RateLimited = %v, RetryAfter = %s; want %v, %s", unavailable.RateLimited, unavailable.RetryAfter, tt.wantRateLimited, tt.wantRetryAfter)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.ErrorType != tt.errorType {
+				t.Errorf("expected the faucet's %s error response, got %v", tt.errorType, err)
+			}
+			if n := requests.Load(); n != 1 {
+				t.Errorf("expected 1 request, got %d", n)
+			}
+		})
+	}
+}
+
+func TestRequestFaucetRetriesIfUnavailable(t *testing.T) {
+	setFastTimers(t)
+
+	var requests atomic.Int32
+	server := newUnavailableFaucetServer(t, 1, http.StatusTooManyRequests, "faucet_limit_exceeded", "", &requests)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{MaxRetries: -1})
+
+	req := testFaucetRequest
+	req.RetryIfUnavailable = true
+	hash, err := client.RequestFaucet(context.Background(), req)
+	if err != nil {
+		t.Fatalf("RequestFaucet returned an error: %v", err)
+	}
+	if want := fmt.Sprintf("0x%064x", 2); hash != want {
+		t.Errorf("hash = %s, want %s", hash, want)
+	}
+
+	// The request is retried only once.
+	var refusedRequests atomic.Int32
+	refusing := newUnavailableFaucetServer(t, 2, http.StatusTooManyRequests, "faucet_limit_exceeded", "", &refusedRequests)
+	client = newTestClientWithOptions(t, refusing.URL, ClientOptions{MaxRetries: -1})
+	if _, err := client.RequestFaucet(context.Background(), req); !errors.Is(err, ErrFaucetUnavailable) {
+		t.Errorf("expected ErrFaucetUnavailable after a single retry, got %v", err)
+	}
+	if n := refusedRequests.Load(); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
@@ -0,0 +1,54 @@
+package cdp
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup deduplicates concurrent calls with the same key, so that only one of
+// them runs and the others wait for and share its result.
+type flightGroup[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall[T]
+}
+
+// flightCall is an in-flight or completed call of a flightGroup.
+type flightCall[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// do runs fn and returns its result, unless a call with the same key is already in
+// flight, in which case it waits for that call and returns its result instead.
+// fn runs with whatever context the first caller captured in it, so a waiter may
+// get that caller's cancellation error; waiting itself stops early with ctx.Err()
+// if ctx is done first.
+func (g *flightGroup[T]) do(ctx context.Context, key string, fn func() (T, error)) (T, error) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.val, call.err
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall[T])
+	}
+	call := &flightCall[T]{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+	call.val, call.err = fn()
+	return call.val, call.err
+}
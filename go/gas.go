@@ -0,0 +1,95 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// DefaultGasLimitMultiplier pads estimated gas limits by 20% when
+// ClientOptions.GasLimitMultiplier is zero, which covers the usual difference
+// between a transaction's estimate and its execution.
+const DefaultGasLimitMultiplier = 1.2
+
+// validateGasLimitMultiplier returns an error if multiplier is set and less than
+// 1, which would send transactions with less gas than they are estimated to use.
+func validateGasLimitMultiplier(multiplier float64) error {
+	if multiplier == 0 {
+		return nil
+	}
+	if !(multiplier >= 1) || math.IsInf(multiplier, 1) {
+		return fmt.Errorf("gas limit multiplier %v must be at least 1", multiplier)
+	}
+	return nil
+}
+
+// withGasLimit returns tx with its gas limit set to the estimate for sending it
+// from the account on network, padded by ClientOptions.GasLimitMultiplier, unless
+// tx has a gas limit or ClientOptions.DisableGasLimitEstimation is set, in which
+// case it returns tx unchanged.
+func (a *EvmAccount) withGasLimit(ctx context.Context, network string, tx TransactionRequest) (TransactionRequest, error) {
+	if a.client.options.DisableGasLimitEstimation || tx.Gas != 0 {
+		return tx, nil
+	}
+	gas, err := a.client.estimateGas(ctx, network, a.Address, tx)
+	if err != nil {
+		return tx, err
+	}
+	padded := padGasLimit(gas, a.client.options.GasLimitMultiplier)
+	if !padded.IsUint64() {
+		return tx, fmt.Errorf("gas limit %s is too large", padded)
+	}
+	tx.Gas = padded.Uint64()
+	return tx, nil
+}
+
+// padGasLimit returns gas times multiplier, or DefaultGasLimitMultiplier if it is
+// zero, rounded up. The multiplier must be finite.
+func padGasLimit(gas *big.Int, multiplier float64) *big.Int {
+	if multiplier == 0 {
+		multiplier = DefaultGasLimitMultiplier
+	}
+	// Use the multiplier's shortest decimal form, so that 1.1 multiplies by
+	// exactly 11/10 rather than by the binary float nearest to it.
+	padded, _ := new(big.Rat).SetString(strconv.FormatFloat(multiplier, 'f', -1, 64))
+	padded.Mul(padded, new(big.Rat).SetInt(gas))
+	limit, rem := new(big.Int).QuoRem(padded.Num(), padded.Denom(), new(big.Int))
+	if rem.Sign() > 0 {
+		limit.Add(limit, big.NewInt(1))
+	}
+	return limit
+}
+
+// withCallGasLimits returns a copy of calls with the OverrideGasLimit of each call
+// that has none set to the estimate for making it from the smart account on
+// network, padded by ClientOptions.GasLimitMultiplier, unless
+// ClientOptions.DisableGasLimitEstimation is set. A call that cannot be
+// estimated on its own, such as one that depends on an earlier call in the same
+// operation, is left to the bundler's estimate.
+func (s *SmartAccount) withCallGasLimits(ctx context.Context, network string, calls []openapi.EvmCall) []openapi.EvmCall {
+	if s.client.options.DisableGasLimitEstimation {
+		return calls
+	}
+	padded := make([]openapi.EvmCall, len(calls))
+	for i, call := range calls {
+		padded[i] = call
+		if call.OverrideGasLimit != nil {
+			continue
+		}
+		value, err := ParseAmount(call.Value)
+		if err != nil {
+			continue
+		}
+		gas, err := s.client.estimateGas(ctx, network, s.Address, TransactionRequest{To: call.To, Value: value, Data: call.Data})
+		if err != nil {
+			continue
+		}
+		limit := padGasLimit(gas, s.client.options.GasLimitMultiplier).String()
+		padded[i].OverrideGasLimit = &limit
+	}
+	return padded
+}
@@ -0,0 +1,210 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestPadGasLimit(t *testing.T) {
+	tests := []struct {
+		gas        int64
+		multiplier float64
+		want       int64
+	}{
+		{21000, 0, 25200},
+		{21000, 1, 21000},
+		{21000, 1.1, 23100},
+		{65000, 1.5, 97500},
+		{3, 1.25, 4},
+	}
+	for _, tt := range tests {
+		if got := padGasLimit(big.NewInt(tt.gas), tt.multiplier); got.Int64() != tt.want {
+			t.Errorf("padGasLimit(%d, %v) = %s, want %d", tt.gas, tt.multiplier, got, tt.want)
+		}
+	}
+}
+
+func TestGasLimitMultiplierValidation(t *testing.T) {
+	for _, multiplier := range []float64{0.9, -1, math.NaN(), math.Inf(1)} {
+		if _, err := NewClient(ClientOptions{GasLimitMultiplier: multiplier}); err == nil || !strings.Contains(err.Error(), "must be at least 1") {
+			t.Errorf("expected multiplier %v to be rejected, got %v", multiplier, err)
+		}
+	}
+	client, err := NewClient(ClientOptions{GasLimitMultiplier: 1})
+	if err != nil {
+		t.Fatalf("expected a multiplier of 1 to be accepted, got %v", err)
+	}
+	client.Close()
+}
+
+func TestSendTransactionPadsEstimatedGas(t *testing.T) {
+	transactions := make(chan string, 1)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		transactions <- body.Transaction
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, testTxHash)
+	}))
+	defer api.Close()
+	var estimates atomic.Int32
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Method string }
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "eth_estimateGas" {
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+		estimates.Add(1)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0xfde8"}`)
+	}))
+	defer rpc.Close()
+
+	tests := []struct {
+		name       string
+		multiplier float64
+		disabled   bool
+		gas        uint64
+		want       uint64
+		estimated  bool
+	}{
+		{"default multiplier", 0, false, 0, 78000, true},
+		{"configured multiplier", 1.5, false, 0, 97500, true},
+		{"explicit gas limit", 1.5, false, 50000, 50000, false},
+		{"estimation disabled", 0, true, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(ClientOptions{
+				APIKeyID:                  testAPIKeyID,
+				APIKeySecret:              generateTestECKeyForCdpTest(t),
+				BasePath:                  api.URL,
+				RPCURLs:                   map[string]string{"base-sepolia": rpc.URL},
+				DisableGasLimitEstimation: tt.disabled,
+				GasLimitMultiplier:        tt.multiplier,
+			})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			defer client.Close()
+			account := &EvmAccount{client: client, Address: testOwner}
+			estimates.Store(0)
+
+			tx := TransactionRequest{To: testRecipient, Value: big.NewInt(1), Data: "0x", Gas: tt.gas}
+			if _, err := account.SendTransaction(context.Background(), "base-sepolia", tx); err != nil {
+				t.Fatalf("SendTransaction returned %v", err)
+			}
+			tx.Gas = tt.want
+			want, err := SerializeTransaction("base-sepolia", tx)
+			if err != nil {
+				t.Fatalf("failed to serialize the expected transaction: %v", err)
+			}
+			if got := <-transactions; !strings.EqualFold(got, want) {
+				t.Errorf("sent %s, want %s", got, want)
+			}
+			if estimated := estimates.Load() > 0; estimated != tt.estimated {
+				t.Errorf("expected estimated = %v, got %d estimates", tt.estimated, estimates.Load())
+			}
+		})
+	}
+}
+
+func TestSendUserOperationPadsEstimatedCallGas(t *testing.T) {
+	var sent []openapi.EvmCall
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body openapi.PrepareAndSendUserOperationJSONRequestBody
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		sent = body.Calls
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(openapi.EvmUserOperation{
+			Calls:      body.Calls,
+			Network:    body.Network,
+			Status:     openapi.EvmUserOperationStatusBroadcast,
+			UserOpHash: "0xop",
+		})
+	}))
+	defer api.Close()
+	var estimates atomic.Int32
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string
+			Params []map[string]string
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "eth_estimateGas" {
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+		estimates.Add(1)
+		if req.Params[0]["from"] != testOwner {
+			t.Errorf("estimated from %s, want the smart account", req.Params[0]["from"])
+		}
+		if req.Params[0]["to"] == testNFT {
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0xfde8"}`)
+	}))
+	defer rpc.Close()
+
+	explicit := "50000"
+	calls := []openapi.EvmCall{
+		{To: testRecipient, Value: "0x1", Data: "0x"},
+		// Depends on an earlier call, so it cannot be estimated on its own.
+		{To: testNFT, Value: "0", Data: "0x"},
+		{To: testRecipient, Value: "0", Data: "0x", OverrideGasLimit: &explicit},
+	}
+	tests := []struct {
+		name      string
+		disabled  bool
+		want      []string
+		estimates int32
+	}{
+		{"estimated", false, []string{"78000", "", "50000"}, 2},
+		{"estimation disabled", true, []string{"", "", "50000"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(ClientOptions{
+				APIKeyID:                  testAPIKeyID,
+				APIKeySecret:              generateTestECKeyForCdpTest(t),
+				BasePath:                  api.URL,
+				RPCURLs:                   map[string]string{"base-sepolia": rpc.URL},
+				DisableGasLimitEstimation: tt.disabled,
+			})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			defer client.Close()
+			estimates.Store(0)
+
+			account := NewSmartAccount(client, testOwner, testOtherOwner)
+			if _, err := account.SendUserOperation(context.Background(), calls, "base-sepolia", UserOperationOptions{}); err != nil {
+				t.Fatalf("SendUserOperation returned %v", err)
+			}
+			if len(sent) != len(tt.want) {
+				t.Fatalf("sent %d calls, want %d", len(sent), len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := ""
+				if sent[i].OverrideGasLimit != nil {
+					got = *sent[i].OverrideGasLimit
+				}
+				if got != want {
+					t.Errorf("call %d has OverrideGasLimit %q, want %q", i, got, want)
+				}
+			}
+			if n := estimates.Load(); n != tt.estimates {
+				t.Errorf("made %d estimates, want %d", n, tt.estimates)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package geth adapts CDP-managed accounts to go-ethereum, for use with contract
+// bindings and transactions built with go-ethereum types.
+//
+// It is a module of its own, so that the SDK itself does not depend on
+// go-ethereum.
+package geth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Signer returns a go-ethereum bind.SignerFn that signs transactions for network
+// with account via CDP, for plugging a CDP-managed account into go-ethereum
+// contract bindings:
+//
+//	opts := &bind.TransactOpts{
+//		From:   common.HexToAddress(account.Address),
+//		Signer: geth.Signer(account, "base-sepolia"),
+//	}
+//
+// The signer fails with bind.ErrNotAuthorized for any sender other than the
+// account, and otherwise has the limitations of EvmAccount.AsTransactionSigner:
+// legacy transactions, which bindings build when TransactOpts.GasPrice is set,
+// cannot be signed, and every signature is a round trip to the CDP API. As a
+// bind.SignerFn takes no context, signing is only bounded by the client's
+// timeouts; use AsTransactionSigner to sign with a context.
+func Signer(account *cdp.EvmAccount, network string) bind.SignerFn {
+	sign := account.AsTransactionSigner(network)
+	return func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if !strings.EqualFold(from.Hex(), account.Address) {
+			return nil, bind.ErrNotAuthorized
+		}
+		unsigned, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		signed, err := sign(context.Background(), unsigned)
+		if err != nil {
+			return nil, err
+		}
+		signedTx := new(types.Transaction)
+		if err := signedTx.UnmarshalBinary(signed); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction: %w", err)
+		}
+		return signedTx, nil
+	}
+}
+
+// SendTransaction signs the unsigned go-ethereum transaction tx with account via
+// CDP, sends it on the account's network, and returns the transaction hash. Legacy
+// and dynamic fee transactions are supported, and both are sent with the nonce and
+// gas they were built with, as described for
+// NetworkScopedEvmAccount.SendUnsignedTransaction.
+func SendTransaction(ctx context.Context, account *cdp.NetworkScopedEvmAccount, tx *types.Transaction) (string, error) {
+	unsigned, err := tx.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	return account.SendUnsignedTransaction(ctx, unsigned)
+}
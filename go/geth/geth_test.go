@@ -0,0 +1,162 @@
+package geth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go"
+	"github.com/coinbase/cdp-sdk/go/cdptest"
+	"github.com/coinbase/cdp-sdk/go/geth"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testRecipient = "0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8"
+
+// newAccount returns an account created on server through a client with fresh
+// credentials.
+func newAccount(t *testing.T, server *cdptest.Server) *cdp.EvmAccount {
+	t.Helper()
+	apiKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate API key: %v", err)
+	}
+	apiKeyDER, err := x509.MarshalECPrivateKey(apiKey)
+	if err != nil {
+		t.Fatalf("failed to marshal API key: %v", err)
+	}
+	walletKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate wallet secret: %v", err)
+	}
+	walletDER, err := x509.MarshalPKCS8PrivateKey(walletKey)
+	if err != nil {
+		t.Fatalf("failed to marshal wallet secret: %v", err)
+	}
+
+	client, err := cdp.NewClient(cdp.ClientOptions{
+		APIKeyID:     "test-key",
+		APIKeySecret: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: apiKeyDER})),
+		WalletSecret: base64.StdEncoding.EncodeToString(walletDER),
+		BasePath:     server.URL + "/platform",
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	account, err := client.GetOrCreateEvmAccount(context.Background(), cdp.CreateOptions{Name: "geth"})
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+	return account
+}
+
+func TestSigner(t *testing.T) {
+	recipient := common.HexToAddress(testRecipient)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(84532),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2e9),
+		Gas:       21000,
+		To:        &recipient,
+		Value:     big.NewInt(1000),
+	})
+	unsigned, _ := tx.MarshalBinary()
+	key, _ := crypto.GenerateKey()
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(tx.ChainId()), key)
+	if err != nil {
+		t.Fatalf("failed to sign the expected transaction: %v", err)
+	}
+	signed, _ := signedTx.MarshalBinary()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.Handle("SignEvmTransaction", func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if want := "0x" + hex.EncodeToString(unsigned); body.Transaction != want {
+			t.Errorf("transaction = %s, want %s", body.Transaction, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"signedTransaction":"0x%x"}`, signed)
+	})
+	account := newAccount(t, server)
+	sign := geth.Signer(account, "base-sepolia")
+
+	got, err := sign(common.HexToAddress(account.Address), tx)
+	if err != nil {
+		t.Fatalf("signer returned an error: %v", err)
+	}
+	if got.Hash() != signedTx.Hash() {
+		t.Errorf("signed transaction hash = %s, want %s", got.Hash(), signedTx.Hash())
+	}
+
+	if _, err := sign(recipient, tx); !errors.Is(err, bind.ErrNotAuthorized) {
+		t.Errorf("expected bind.ErrNotAuthorized signing for another address, got %v", err)
+	}
+}
+
+func TestSendTransaction(t *testing.T) {
+	const txHash = "0x5a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+	sent := make(chan string, 1)
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.Handle("SendEvmTransaction", func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Network, Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Network != "base-sepolia" {
+			t.Errorf("unexpected network %s", body.Network)
+		}
+		sent <- body.Transaction
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, txHash)
+	})
+	account := newAccount(t, server).UseNetwork("base-sepolia")
+	to := common.HexToAddress(testRecipient)
+
+	// Both transactions have nonce 0, which must be sent as it is.
+	dynamicFee := types.NewTx(&types.DynamicFeeTx{
+		ChainID: big.NewInt(84532), GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2e9), Gas: 21000,
+		To: &to, Value: big.NewInt(1000), Data: []byte{0xab},
+	})
+	unsigned, _ := dynamicFee.MarshalBinary()
+	legacy, _ := cdp.SerializeTransaction("base-sepolia", cdp.TransactionRequest{
+		MaxPriorityFeePerGas: big.NewInt(1e9), MaxFeePerGas: big.NewInt(1e9), Gas: 21000, To: testRecipient, Value: big.NewInt(1000),
+	})
+	tests := []struct {
+		name string
+		tx   *types.Transaction
+		want string
+	}{
+		{"dynamic fee", dynamicFee, "0x" + hex.EncodeToString(unsigned)},
+		{"legacy", types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1000)}), legacy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := geth.SendTransaction(context.Background(), account, tt.tx)
+			if err != nil || got != txHash {
+				t.Fatalf("SendTransaction = %q, %v", got, err)
+			}
+			if got := <-sent; got != tt.want {
+				t.Errorf("sent transaction %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package cdp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testAPIKeyID is the API key ID of the test clients.
+const testAPIKeyID = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+func generateTestECKeyForCdpTest(t *testing.T) string {
+	t.Helper()
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate EC key: %v", err)
+	}
+
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal EC key: %v", err)
+	}
+
+	pemBlock := &pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: keyBytes,
+	}
+
+	return string(pem.EncodeToMemory(pemBlock))
+}
+
+func generateTestWalletSecret(t *testing.T) string {
+	t.Helper()
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate EC key: %v", err)
+	}
+
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal EC key: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(keyBytes)
+}
+
+// newTestClientWithOptions returns a client for the API at serverURL with options
+// and a fresh test API key, closed when the test ends. Gas limit estimation is
+// disabled; tests that exercise it build their own client.
+func newTestClientWithOptions(t *testing.T, serverURL string, options ClientOptions) *Client {
+	t.Helper()
+	options.APIKeyID = testAPIKeyID
+	options.APIKeySecret = generateTestECKeyForCdpTest(t)
+	options.BasePath = serverURL
+	options.DisableGasLimitEstimation = true
+	client, err := NewClient(options)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// newTestClient returns a client for the API at serverURL with default options.
+func newTestClient(t *testing.T, serverURL string) *Client {
+	t.Helper()
+	return newTestClientWithOptions(t, serverURL, ClientOptions{})
+}
+
+// newReceiptTestClient returns a client for the API at apiURL that reads
+// base-sepolia from the JSON-RPC server at rpcURL.
+func newReceiptTestClient(t *testing.T, apiURL, rpcURL string) *Client {
+	t.Helper()
+	return newTestClientWithOptions(t, apiURL, ClientOptions{RPCURLs: map[string]string{"base-sepolia": rpcURL}})
+}
+
+// setFastTimers shortens the retry backoff, every polling interval and the
+// faucet retry delay to a millisecond until the test ends.
+func setFastTimers(t *testing.T) {
+	t.Helper()
+	timers := []*time.Duration{&retryBaseDelay, &retryMaxDelay, &receiptPollInterval, &accountPollInterval, &depositPollInterval, &faucetRetryDelay}
+	saved := make([]time.Duration, len(timers))
+	for i, timer := range timers {
+		saved[i], *timer = *timer, time.Millisecond
+	}
+	t.Cleanup(func() {
+		for i, timer := range timers {
+			*timer = saved[i]
+		}
+	})
+}
+
+// newJSONServer returns a server that answers every request with status and the
+// JSON body, closed when the test ends.
+func newJSONServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
@@ -0,0 +1,96 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// idempotencyKeyHeader is the header the server deduplicates requests by.
+const idempotencyKeyHeader = "X-Idempotency-Key"
+
+// WithIdempotencyKey returns a request editor that sends key as the request's
+// X-Idempotency-Key header, for passing to a generated API method:
+//
+//	resp, err := client.SendEvmTransactionWithResponse(ctx, address, nil, body, cdp.WithIdempotencyKey(transferID))
+//
+// The server returns the response of the first request with a given key for any
+// later request with the same key, so a request that is retried, by the client's
+// retry layer or by the caller after a lost response, takes effect at most once.
+// Requests carrying a key are also retried by the client even if they are POST or
+// PATCH requests. The operations that honor the header are those with
+// openapi.Operation.IdempotencyKey set, including CreateEvmAccount,
+// SendEvmTransaction, SignEvmTransaction, PrepareAndSendUserOperation and
+// CreatePolicy; others ignore it. The key overrides one set through the method's
+// params or generated by ClientOptions.AutoIdempotencyKeys.
+func WithIdempotencyKey(key string) openapi.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		if key == "" {
+			return nil
+		}
+		req.Header.Set(idempotencyKeyHeader, key)
+		return nil
+	}
+}
+
+// autoIdempotencyKeyFn sets an idempotency key derived from the request on
+// requests to operations that honor one and don't carry one already.
+func autoIdempotencyKeyFn() openapi.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		if req.Header.Get(idempotencyKeyHeader) != "" {
+			return nil
+		}
+		op, ok := openapi.LookupOperation(req.Method, req.URL.Path)
+		if !ok || !op.IdempotencyKey {
+			return nil
+		}
+
+		key, err := requestIdempotencyKey(req)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(idempotencyKeyHeader, key)
+		return nil
+	}
+}
+
+// requestIdempotencyKey returns the hex-encoded SHA-256 hash of the canonical
+// JSON encoding of req's method, path, query and JSON body, so that identical
+// requests get the same key.
+func requestIdempotencyKey(req *http.Request) (string, error) {
+	var body interface{}
+	if req.Body != nil && req.Body != http.NoBody {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			return "", fmt.Errorf("failed to read request body: %w", err)
+		}
+		// Restore the body for future readers
+		req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+
+		if len(bodyBytes) > 0 {
+			decoder := json.NewDecoder(bytes.NewReader(bodyBytes))
+			decoder.UseNumber()
+			if err := decoder.Decode(&body); err != nil {
+				return "", fmt.Errorf("failed to parse request body: %w", err)
+			}
+		}
+	}
+
+	hash, err := auth.HashCanonicalJSON(map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+		"query":  req.URL.RawQuery,
+		"body":   body,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash request: %w", err)
+	}
+	return hex.EncodeToString(hash), nil
+}
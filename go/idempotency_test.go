@@ -0,0 +1,105 @@
+package cdp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// newIdempotencyRecordingServer records the idempotency key of each request it
+// receives, failing every first attempt with a 503 so that it is retried.
+func newIdempotencyRecordingServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("X-Idempotency-Key"))
+		attempt := len(keys)
+		mu.Unlock()
+		if attempt%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"address":"` + testOwner + `"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), keys...)
+	}
+}
+
+func TestWithIdempotencyKeyIsReusedOnRetry(t *testing.T) {
+	setFastTimers(t)
+	server, recorded := newIdempotencyRecordingServer(t)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+
+	resp, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{}, WithIdempotencyKey("transfer-42"))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("expected the retry to succeed, got status %d", resp.StatusCode())
+	}
+	if keys := recorded(); len(keys) != 2 || keys[0] != "transfer-42" || keys[1] != "transfer-42" {
+		t.Errorf("expected both attempts to carry the key, got %q", keys)
+	}
+}
+
+func TestAutoIdempotencyKeys(t *testing.T) {
+	setFastTimers(t)
+	server, recorded := newIdempotencyRecordingServer(t)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{AutoIdempotencyKeys: true})
+	ctx := context.Background()
+
+	name := func(s string) openapi.CreateEvmAccountJSONRequestBody {
+		return openapi.CreateEvmAccountJSONRequestBody{Name: &s}
+	}
+	for _, body := range []openapi.CreateEvmAccountJSONRequestBody{name("alice"), name("alice"), name("bob")} {
+		if _, err := client.CreateEvmAccountWithResponse(ctx, nil, body); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	if _, err := client.CreateEvmAccountWithResponse(ctx, nil, name("alice"), WithIdempotencyKey("explicit")); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	keys := recorded()
+	if len(keys) != 8 {
+		t.Fatalf("expected 8 attempts, got %d", len(keys))
+	}
+	for i := 0; i < len(keys); i += 2 {
+		if keys[i] == "" || keys[i] != keys[i+1] {
+			t.Errorf("request %d: expected the retry to reuse the key, got %q and %q", i/2, keys[i], keys[i+1])
+		}
+	}
+	if keys[0] != keys[2] {
+		t.Errorf("expected identical requests to get the same key, got %q and %q", keys[0], keys[2])
+	}
+	if keys[0] == keys[4] {
+		t.Errorf("expected different requests to get different keys, both got %q", keys[0])
+	}
+	if keys[6] != "explicit" {
+		t.Errorf("expected the explicit key to take precedence, got %q", keys[6])
+	}
+}
+
+func TestAutoIdempotencyKeysSkipOperationsWithoutKeys(t *testing.T) {
+	server, recorded := newIdempotencyRecordingServer(t)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{AutoIdempotencyKeys: true, MaxRetries: -1})
+
+	if _, err := requestFaucet(client); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if keys := recorded(); len(keys) != 1 || keys[0] != "" {
+		t.Errorf("expected a single request without a key, got %q", keys)
+	}
+}
@@ -0,0 +1,75 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// RequestInterceptor observes or modifies an API request before it is sent. See
+// ClientOptions.RequestInterceptors.
+type RequestInterceptor func(ctx context.Context, req *http.Request) error
+
+// ResponseInterceptor observes or modifies the response to an API request. See
+// ClientOptions.ResponseInterceptors.
+type ResponseInterceptor func(resp *http.Response) error
+
+// apiRequestKey marks the context of requests made through the generated API
+// client, as opposed to JSON-RPC calls.
+type apiRequestKey struct{}
+
+// interceptRequestFn marks the request as an API request and runs interceptors in
+// order, stopping at the first error. Because an interceptor may replace the body
+// without updating GetBody, the body is buffered afterwards so that retries resend
+// the intercepted body rather than the original one.
+func interceptRequestFn(interceptors []RequestInterceptor) openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), apiRequestKey{}, true))
+		for _, intercept := range interceptors {
+			if err := intercept(ctx, req); err != nil {
+				return fmt.Errorf("request interceptor failed: %w", err)
+			}
+		}
+		if len(interceptors) == 0 || req.Body == nil || req.Body == http.NoBody {
+			return nil
+		}
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read intercepted request body: %w", err)
+		}
+		req.ContentLength = int64(len(body))
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		return nil
+	}
+}
+
+// interceptTransport runs response interceptors on the final response to each API
+// request.
+type interceptTransport struct {
+	next         http.RoundTripper
+	interceptors []ResponseInterceptor
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *interceptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || req.Context().Value(apiRequestKey{}) == nil {
+		return resp, err
+	}
+
+	for _, intercept := range t.interceptors {
+		if err := intercept(resp); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("response interceptor failed: %w", err)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,133 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestInterceptors(t *testing.T) {
+	setFastTimers(t)
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.Header.Get("X-Test") != "first,second" {
+			t.Errorf("got X-Test %q, want interceptors applied in order", r.Header.Get("X-Test"))
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer server.Close()
+
+	var order []string
+	var statuses []int
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		RequestInterceptors: []RequestInterceptor{
+			func(_ context.Context, req *http.Request) error {
+				order = append(order, "request")
+				req.Header.Set("X-Test", "first")
+				return nil
+			},
+			func(_ context.Context, req *http.Request) error {
+				req.Header.Set("X-Test", req.Header.Get("X-Test")+",second")
+				return nil
+			},
+		},
+		ResponseInterceptors: []ResponseInterceptor{
+			func(resp *http.Response) error {
+				order = append(order, "response")
+				statuses = append(statuses, resp.StatusCode)
+				return nil
+			},
+		},
+	})
+
+	resp, err := client.ListEvmAccountsWithResponse(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusTeapot {
+		t.Errorf("got status %d", resp.StatusCode())
+	}
+	if fmt.Sprint(order) != "[request response]" {
+		t.Errorf("interceptors ran as %v, want once each with requests first", order)
+	}
+	if len(statuses) != 1 || statuses[0] != http.StatusTeapot {
+		t.Errorf("response interceptor saw %v, want only the final status", statuses)
+	}
+}
+
+func TestInterceptedBodyIsResentOnRetry(t *testing.T) {
+	setFastTimers(t)
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer server.Close()
+
+	const intercepted = `{"name":"intercepted"}`
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		RetryOptions: RetryOptions{RetryNonIdempotent: true},
+		RequestInterceptors: []RequestInterceptor{
+			func(_ context.Context, req *http.Request) error {
+				req.Body = io.NopCloser(strings.NewReader(intercepted))
+				req.ContentLength = int64(len(intercepted))
+				return nil
+			},
+		},
+	})
+
+	if _, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{}); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if fmt.Sprint(bodies) != fmt.Sprint([]string{intercepted, intercepted}) {
+		t.Errorf("server received bodies %q, want the intercepted body on every attempt", bodies)
+	}
+}
+
+func TestInterceptorErrors(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	errIntercepted := errors.New("intercepted")
+
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		RequestInterceptors: []RequestInterceptor{
+			func(context.Context, *http.Request) error { return errIntercepted },
+		},
+	})
+	if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); !errors.Is(err, errIntercepted) {
+		t.Errorf("got error %v, want the request interceptor's error", err)
+	}
+	if requests != 0 {
+		t.Errorf("request was sent despite the request interceptor failing")
+	}
+
+	client = newTestClientWithOptions(t, server.URL, ClientOptions{
+		ResponseInterceptors: []ResponseInterceptor{
+			func(*http.Response) error { return errIntercepted },
+		},
+	})
+	if _, err := client.ListEvmAccountsWithResponse(context.Background(), nil); !errors.Is(err, errIntercepted) {
+		t.Errorf("got error %v, want the response interceptor's error", err)
+	}
+}
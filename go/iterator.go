@@ -0,0 +1,264 @@
+package cdp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ListOptions configures the list iterators.
+type ListOptions struct {
+	// PageSize is the number of items fetched per request. Zero uses the API's
+	// default page size, or the page size stored in Cursor.
+	PageSize int
+	// Cursor resumes iteration from a value previously returned by
+	// Iterator.Cursor. Empty starts from the beginning. A cursor stores the page
+	// size it was created with, as its offset counts items within a page of that
+	// size; a nonzero PageSize must match it.
+	Cursor string
+}
+
+// pageFetcher fetches the page of pageSize items (zero for the API's default)
+// identified by pageToken (empty for the first page) and returns the token of the
+// following page, or empty if it is the last one.
+type pageFetcher[T any] func(ctx context.Context, pageToken string, pageSize int) (items []T, nextPageToken string, err error)
+
+// Iterator iterates over the items of a paginated list, fetching pages as needed.
+//
+// Long-running exports can be interrupted and resumed: Cursor returns an opaque
+// position that, passed as ListOptions.Cursor to a new iterator over the same
+// list, continues with the next item not yet returned. A cursor refers to a page
+// token issued by the API plus an offset into that page and the page size, so it
+// stays valid only as long as the API accepts the page token, and the resumed
+// iterator fetches pages of the same size. Items added to or removed from the list
+// while iterating may shift items across the cursor, so a resumed export can skip
+// or repeat items near it; deduplicate by address if exactly-once matters.
+//
+// An Iterator is not safe for concurrent use.
+type Iterator[T any] struct {
+	fetch    pageFetcher[T]
+	pageSize int
+
+	// pageToken identifies the buffered page; offset is the index of the next
+	// item to return from it.
+	pageToken string
+	offset    int
+	page      []T
+	loaded    bool
+	next      string
+	err       error
+}
+
+// iteratorCursor is the decoded form of a cursor.
+type iteratorCursor struct {
+	PageToken string `json:"p,omitempty"`
+	Offset    int    `json:"o,omitempty"`
+	PageSize  int    `json:"s,omitempty"`
+}
+
+// newIterator returns an iterator over the pages returned by fetch, with the page
+// size and starting cursor of opts.
+func newIterator[T any](fetch pageFetcher[T], opts ListOptions) *Iterator[T] {
+	it := &Iterator[T]{fetch: fetch, pageSize: opts.PageSize}
+	if opts.Cursor == "" {
+		return it
+	}
+
+	var c iteratorCursor
+	raw, err := base64.RawURLEncoding.DecodeString(opts.Cursor)
+	if err == nil {
+		err = json.Unmarshal(raw, &c)
+	}
+	if err != nil || c.Offset < 0 || c.PageSize < 0 {
+		it.err = fmt.Errorf("invalid cursor %q", opts.Cursor)
+		return it
+	}
+	if opts.PageSize != 0 && opts.PageSize != c.PageSize {
+		it.err = fmt.Errorf("page size %d does not match the cursor's page size %d", opts.PageSize, c.PageSize)
+		return it
+	}
+	it.pageToken, it.offset, it.pageSize = c.PageToken, c.Offset, c.PageSize
+	return it
+}
+
+// Next returns the next item. It returns false once the list is exhausted, or
+// with an error if fetching a page failed; ctx is used for any page fetch and
+// canceling it stops iteration with ctx.Err(). A failed page fetch does not
+// advance the iterator: calling Next again retries it, and Cursor still points at
+// the first item that was not returned.
+func (it *Iterator[T]) Next(ctx context.Context) (T, bool, error) {
+	var zero T
+	for {
+		if it.err != nil {
+			return zero, false, it.err
+		}
+
+		if it.loaded && it.offset < len(it.page) {
+			item := it.page[it.offset]
+			it.offset++
+			return item, true, nil
+		}
+
+		if it.loaded {
+			if it.next == "" {
+				return zero, false, nil
+			}
+			it.pageToken, it.offset, it.loaded = it.next, 0, false
+		}
+
+		if err := ctx.Err(); err != nil {
+			return zero, false, err
+		}
+		page, next, err := it.fetch(ctx, it.pageToken, it.pageSize)
+		if err != nil {
+			// Leave the position untouched so Cursor still resumes here.
+			return zero, false, err
+		}
+		it.page, it.next, it.loaded = page, next, true
+	}
+}
+
+// All returns an iterator over the remaining items, for use with range. Iteration
+// stops after the first error, which is yielded with a zero item; ctx is used as
+// with Next. Breaking out of the loop leaves the iterator positioned after the
+// last item yielded, so Next, All and Cursor continue from there.
+func (it *Iterator[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			item, ok, err := it.Next(ctx)
+			if err != nil {
+				yield(item, err)
+				return
+			}
+			if !ok || !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Cursor returns an opaque cursor for the position of the next item Next would
+// return and the iterator's page size. See the Iterator documentation for its
+// stability guarantees.
+func (it *Iterator[T]) Cursor() string {
+	pageToken, offset := it.pageToken, it.offset
+	if it.loaded && offset >= len(it.page) && it.next != "" {
+		// The buffered page is exhausted, so point at the start of the next one.
+		pageToken, offset = it.next, 0
+	}
+
+	raw, _ := json.Marshal(iteratorCursor{PageToken: pageToken, Offset: offset, PageSize: it.pageSize})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// errNilPage is returned when a list response has an unexpected empty body.
+var errNilPage = errors.New("list response has no body")
+
+// ListEvmAccounts returns an iterator over the project's EVM accounts.
+func ListEvmAccounts(client *Client, opts ListOptions) *Iterator[*EvmAccount] {
+	return newIterator(func(ctx context.Context, pageToken string, pageSize int) ([]*EvmAccount, string, error) {
+		resp, err := client.ListEvmAccountsWithResponse(ctx, &openapi.ListEvmAccountsParams{
+			PageSize:  optionalPageSize(pageSize),
+			PageToken: optionalString(pageToken),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list EVM accounts: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, "", unexpectedStatusError("list EVM accounts", resp.StatusCode(), resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return nil, "", errNilPage
+		}
+
+		accounts := make([]*EvmAccount, len(resp.JSON200.Accounts))
+		for i := range resp.JSON200.Accounts {
+			accounts[i] = newEvmAccount(client, &resp.JSON200.Accounts[i])
+		}
+		return accounts, stringValue(resp.JSON200.NextPageToken), nil
+	}, opts)
+}
+
+// ListSmartAccounts returns an iterator over the project's EVM smart accounts.
+func ListSmartAccounts(client *Client, opts ListOptions) *Iterator[*SmartAccount] {
+	return newIterator(func(ctx context.Context, pageToken string, pageSize int) ([]*SmartAccount, string, error) {
+		resp, err := client.ListEvmSmartAccountsWithResponse(ctx, &openapi.ListEvmSmartAccountsParams{
+			PageSize:  optionalPageSize(pageSize),
+			PageToken: optionalString(pageToken),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list smart accounts: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, "", unexpectedStatusError("list smart accounts", resp.StatusCode(), resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return nil, "", errNilPage
+		}
+
+		accounts := make([]*SmartAccount, len(resp.JSON200.Accounts))
+		for i := range resp.JSON200.Accounts {
+			accounts[i] = newSmartAccount(client, &resp.JSON200.Accounts[i])
+		}
+		return accounts, stringValue(resp.JSON200.NextPageToken), nil
+	}, opts)
+}
+
+// ListTokenBalances returns an iterator over the token balances of the EVM
+// account at address on network.
+func ListTokenBalances(client *Client, address, network string, opts ListOptions) *Iterator[TokenBalance] {
+	network = client.networkOrDefault(network)
+	return newIterator(func(ctx context.Context, pageToken string, pageSize int) ([]TokenBalance, string, error) {
+		resp, err := client.ListEvmTokenBalancesWithResponse(ctx, openapi.ListEvmTokenBalancesNetwork(network), address, &openapi.ListEvmTokenBalancesParams{
+			PageSize:  optionalPageSize(pageSize),
+			PageToken: optionalString(pageToken),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list token balances: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, "", unexpectedStatusError("list token balances", resp.StatusCode(), resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return nil, "", errNilPage
+		}
+
+		balances := make([]TokenBalance, len(resp.JSON200.Balances))
+		for i, balance := range resp.JSON200.Balances {
+			if balances[i], err = newTokenBalance(balance); err != nil {
+				return nil, "", err
+			}
+		}
+		return balances, stringValue(resp.JSON200.NextPageToken), nil
+	}, opts)
+}
+
+// optionalPageSize returns a pointer to size, or nil if size is zero.
+func optionalPageSize(size int) *openapi.PageSize {
+	if size == 0 {
+		return nil
+	}
+	return &size
+}
+
+// optionalString returns a pointer to s, or nil if s is empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// stringValue returns the string s points to, or an empty string if s is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
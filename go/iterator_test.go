@@ -0,0 +1,230 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPagedAccountServer serves total EVM accounts in pages of the requested size,
+// using the index of the first item as the page token. It fails requests for the
+// page starting at failAt, if set.
+func newPagedAccountServer(t *testing.T, total int, failAt *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if failAt != nil && int32(start) == failAt.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		page := map[string]interface{}{"accounts": []map[string]string{}}
+		var accounts []map[string]string
+		for i := start; i < min(start+size, total); i++ {
+			accounts = append(accounts, map[string]string{"address": fmt.Sprintf("0x%040x", i)})
+		}
+		page["accounts"] = accounts
+		if start+size < total {
+			page["nextPageToken"] = strconv.Itoa(start + size)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func collectAddresses(t *testing.T, it *Iterator[*EvmAccount], limit int) []string {
+	t.Helper()
+	var addresses []string
+	for len(addresses) < limit {
+		account, ok, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next returned an error: %v", err)
+		}
+		if !ok {
+			break
+		}
+		addresses = append(addresses, account.Address)
+	}
+	return addresses
+}
+
+func TestListEvmAccountsIteratesAllPages(t *testing.T) {
+	server := newPagedAccountServer(t, 7, nil)
+	client := newTestClient(t, server.URL)
+
+	addresses := collectAddresses(t, ListEvmAccounts(client, ListOptions{PageSize: 3}), 100)
+	if len(addresses) != 7 {
+		t.Fatalf("got %d accounts, want 7", len(addresses))
+	}
+	for i, address := range addresses {
+		if address != fmt.Sprintf("0x%040x", i) {
+			t.Errorf("addresses[%d] = %s", i, address)
+		}
+	}
+}
+
+func TestListEvmAccountsResumesFromCursor(t *testing.T) {
+	server := newPagedAccountServer(t, 7, nil)
+	client := newTestClient(t, server.URL)
+
+	for _, stopAfter := range []int{0, 2, 3, 4, 6, 7} {
+		t.Run(strconv.Itoa(stopAfter), func(t *testing.T) {
+			first := ListEvmAccounts(client, ListOptions{PageSize: 3})
+			head := collectAddresses(t, first, stopAfter)
+
+			resumed := ListEvmAccounts(client, ListOptions{PageSize: 3, Cursor: first.Cursor()})
+			tail := collectAddresses(t, resumed, 100)
+
+			all := append(head, tail...)
+			if len(all) != 7 {
+				t.Fatalf("got %d accounts after resuming, want 7: %v", len(all), all)
+			}
+			for i, address := range all {
+				if address != fmt.Sprintf("0x%040x", i) {
+					t.Errorf("accounts[%d] = %s", i, address)
+				}
+			}
+		})
+	}
+}
+
+func TestIteratorCursorSurvivesPageErrors(t *testing.T) {
+	setFastTimers(t)
+	var failAt atomic.Int32
+	failAt.Store(3)
+	server := newPagedAccountServer(t, 7, &failAt)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{MaxRetries: -1})
+
+	it := ListEvmAccounts(client, ListOptions{PageSize: 3})
+	head := collectAddresses(t, it, 3)
+
+	_, _, err := it.Next(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected the page error to propagate, got %v", err)
+	}
+
+	failAt.Store(-1)
+	tail := collectAddresses(t, ListEvmAccounts(client, ListOptions{PageSize: 3, Cursor: it.Cursor()}), 100)
+	if got := len(head) + len(tail); got != 7 {
+		t.Errorf("got %d accounts after resuming, want 7", got)
+	}
+}
+
+func TestIteratorCursorStoresPageSize(t *testing.T) {
+	server := newPagedAccountServer(t, 7, nil)
+	client := newTestClient(t, server.URL)
+
+	first := ListEvmAccounts(client, ListOptions{PageSize: 3})
+	head := collectAddresses(t, first, 4)
+
+	if _, _, err := ListEvmAccounts(client, ListOptions{PageSize: 5, Cursor: first.Cursor()}).Next(context.Background()); err == nil {
+		t.Error("expected an error resuming with another page size")
+	}
+
+	tail := collectAddresses(t, ListEvmAccounts(client, ListOptions{Cursor: first.Cursor()}), 100)
+	all := append(head, tail...)
+	if len(all) != 7 {
+		t.Fatalf("got %d accounts after resuming without a page size, want 7: %v", len(all), all)
+	}
+	for i, address := range all {
+		if address != fmt.Sprintf("0x%040x", i) {
+			t.Errorf("accounts[%d] = %s", i, address)
+		}
+	}
+}
+
+func TestIteratorRejectsInvalidCursor(t *testing.T) {
+	client := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+	if _, _, err := ListEvmAccounts(client, ListOptions{Cursor: "%%%"}).Next(context.Background()); err == nil {
+		t.Fatal("expected an error for an invalid cursor")
+	}
+}
+
+func TestIteratorStopsWhenContextCanceled(t *testing.T) {
+	server := newPagedAccountServer(t, 7, nil)
+	client := newTestClient(t, server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := ListEvmAccounts(client, ListOptions{PageSize: 3}).Next(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestIteratorAllRangesOverPages(t *testing.T) {
+	server := newPagedAccountServer(t, 7, nil)
+	client := newTestClient(t, server.URL)
+
+	var addresses []string
+	for account, err := range ListEvmAccounts(client, ListOptions{PageSize: 3}).All(context.Background()) {
+		if err != nil {
+			t.Fatalf("All yielded an error: %v", err)
+		}
+		addresses = append(addresses, account.Address)
+	}
+	if len(addresses) != 7 {
+		t.Fatalf("got %d accounts, want 7", len(addresses))
+	}
+}
+
+func TestIteratorAllYieldsPageErrors(t *testing.T) {
+	setFastTimers(t)
+	var failAt atomic.Int32
+	failAt.Store(3)
+	server := newPagedAccountServer(t, 7, &failAt)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{MaxRetries: -1})
+
+	count := 0
+	var lastErr error
+	for _, err := range ListEvmAccounts(client, ListOptions{PageSize: 3}).All(context.Background()) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		count++
+	}
+	var apiErr *APIError
+	if !errors.As(lastErr, &apiErr) {
+		t.Fatalf("expected the page error to be yielded, got %v", lastErr)
+	}
+	if count != 3 {
+		t.Errorf("got %d accounts before the error, want 3", count)
+	}
+}
+
+func TestIteratorStopsFetchingWhenCanceledMidIteration(t *testing.T) {
+	var requests atomic.Int32
+	pages := newPagedAccountServer(t, 7, nil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		pages.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	client := newTestClient(t, server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := ListEvmAccounts(client, ListOptions{PageSize: 3})
+	for range 3 {
+		if _, ok, err := it.Next(ctx); !ok || err != nil {
+			t.Fatalf("Next = %v, %v before canceling", ok, err)
+		}
+	}
+	cancel()
+
+	if _, _, err := it.Next(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected 1 page request, got %d", n)
+	}
+}
@@ -0,0 +1,165 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// feeMargin multiplies the base fee and, on OP Stack networks, the L1 data fee
+// in fee estimates, to cover increases before the transaction is included.
+const feeMargin = 2
+
+// gasPriceOracle is the OP Stack predeploy that prices the L1 data fee of a
+// transaction.
+const gasPriceOracle = "0x420000000000000000000000000000000000000f"
+
+// MaxSendable returns the largest amount of token, in the token's smallest unit,
+// that the account can transfer with Transfer, and whether its native balance
+// covers the estimated fee of that transfer. For the network's native token this
+// is the balance minus the fee; for an ERC-20 token it is the full token balance,
+// since fees are paid in the native token. If the native balance doesn't cover
+// the fee, the amount is zero and ok is false.
+//
+// The fee is the transfer's gas limit, padded as Transfer pads it, at a max fee
+// per gas of twice the current base fee plus the priority fee, and on OP Stack
+// networks such as Base twice the current L1 data fee on top. The amount
+// therefore leaves a little native token behind, and the transfer isn't stuck if
+// fees rise.
+func (a *NetworkScopedEvmAccount) MaxSendable(ctx context.Context, token string) (amount *big.Int, ok bool, err error) {
+	nativeBalance, err := a.client.balanceOf(ctx, a.Network, a.Address, nativeSymbol(a.Network))
+	if err != nil {
+		return nil, false, err
+	}
+
+	native := strings.EqualFold(token, nativeSymbol(a.Network))
+	amount = nativeBalance
+	if !native {
+		if amount, err = a.client.balanceOf(ctx, a.Network, a.Address, token); err != nil {
+			return nil, false, err
+		}
+	}
+	tx, err := transferTransaction(a.Network, a.Address, amount, token)
+	if err != nil {
+		return nil, false, err
+	}
+	gas := big.NewInt(transferGas)
+	if !native {
+		if gas, err = a.client.estimateGas(ctx, a.Network, a.Address, tx); err != nil {
+			return nil, false, err
+		}
+	}
+	if !a.client.options.DisableGasLimitEstimation {
+		gas = padGasLimit(gas, a.client.options.GasLimitMultiplier)
+	}
+	tx.Gas = gas.Uint64()
+
+	fee, err := a.client.estimateFee(ctx, a.Network, tx)
+	if err != nil {
+		return nil, false, err
+	}
+	if nativeBalance.Cmp(fee) < 0 || (native && nativeBalance.Cmp(fee) == 0) {
+		return new(big.Int), false, nil
+	}
+	if native {
+		amount.Sub(amount, fee)
+	}
+	return amount, true, nil
+}
+
+// estimateGasFees returns the fees per gas to send a transaction on network
+// with: a priority fee as suggested by the node, and a max fee of feeMargin times
+// the latest block's base fee plus the priority fee.
+func (c *Client) estimateGasFees(ctx context.Context, network string) (maxFeePerGas, maxPriorityFeePerGas *big.Int, err error) {
+	var block *struct {
+		BaseFeePerGas string `json:"baseFeePerGas"`
+	}
+	if err := c.rpcCall(ctx, network, &block, "eth_getBlockByNumber", "latest", false); err != nil {
+		return nil, nil, fmt.Errorf("failed to get base fee: %w", err)
+	}
+	if block == nil || block.BaseFeePerGas == "" {
+		return nil, nil, fmt.Errorf("latest block on %s has no base fee", network)
+	}
+	baseFee, err := ParseAmount(block.BaseFeePerGas)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid base fee %q: %w", block.BaseFeePerGas, err)
+	}
+
+	var priorityFee string
+	if err := c.rpcCall(ctx, network, &priorityFee, "eth_maxPriorityFeePerGas"); err != nil {
+		return nil, nil, fmt.Errorf("failed to get priority fee: %w", err)
+	}
+	if maxPriorityFeePerGas, err = ParseAmount(priorityFee); err != nil {
+		return nil, nil, fmt.Errorf("invalid priority fee %q: %w", priorityFee, err)
+	}
+
+	maxFeePerGas = baseFee.Mul(baseFee, big.NewInt(feeMargin))
+	return maxFeePerGas.Add(maxFeePerGas, maxPriorityFeePerGas), maxPriorityFeePerGas, nil
+}
+
+// estimateFee returns the most, in wei, that tx can cost to include on network:
+// its gas limit at its max fee per gas, or at the one estimateGasFees returns if
+// it has none, plus feeMargin times its L1 data fee on OP Stack networks.
+func (c *Client) estimateFee(ctx context.Context, network string, tx TransactionRequest) (*big.Int, error) {
+	if tx.MaxFeePerGas == nil {
+		var err error
+		if tx.MaxFeePerGas, tx.MaxPriorityFeePerGas, err = c.estimateGasFees(ctx, network); err != nil {
+			return nil, err
+		}
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas), tx.MaxFeePerGas)
+	if !evmNetworks[network].opStack {
+		return fee, nil
+	}
+
+	l1Fee, err := c.l1Fee(ctx, network, tx)
+	if err != nil {
+		return nil, err
+	}
+	return fee.Add(fee, l1Fee.Mul(l1Fee, big.NewInt(feeMargin))), nil
+}
+
+// l1Fee returns the L1 data fee, in wei, of tx on an OP Stack network, as priced
+// by the gas price oracle.
+func (c *Client) l1Fee(ctx context.Context, network string, tx TransactionRequest) (*big.Int, error) {
+	serialized, err := SerializeTransaction(network, tx)
+	if err != nil {
+		return nil, err
+	}
+	unsigned, _ := decodeHexData(serialized)
+	selector := FunctionSelector("getL1Fee(bytes)")
+	data := append(selector[:], uintWord(big.NewInt(abiWordSize))...)
+	data = append(data, bytesTail(unsigned)...)
+
+	var result string
+	call := map[string]string{"to": gasPriceOracle, "data": "0x" + hex.EncodeToString(data)}
+	if err := c.rpcCall(ctx, network, &result, "eth_call", call, "latest"); err != nil {
+		return nil, fmt.Errorf("failed to get L1 fee: %w", err)
+	}
+	fee, err := ParseAmount(result)
+	if err != nil {
+		return nil, fmt.Errorf("invalid L1 fee %q: %w", result, err)
+	}
+	return fee, nil
+}
+
+// estimateGas returns the gas tx would use if sent from from on network.
+func (c *Client) estimateGas(ctx context.Context, network, from string, tx TransactionRequest) (*big.Int, error) {
+	call := map[string]string{"from": from}
+	if tx.Data != "" && tx.Data != "0x" {
+		call["data"] = tx.Data
+	}
+	if tx.To != "" {
+		call["to"] = tx.To
+	}
+	if tx.Value != nil {
+		call["value"] = fmt.Sprintf("0x%x", tx.Value)
+	}
+	var gas string
+	if err := c.rpcCall(ctx, network, &gas, "eth_estimateGas", call); err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	return ParseAmount(gas)
+}
@@ -0,0 +1,94 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// testL1Fee is the L1 data fee the test RPC servers price transactions at.
+const testL1Fee int64 = 1e10
+
+// isL1FeeCall reports whether the eth_call params are a call to the gas price
+// oracle.
+func isL1FeeCall(call json.RawMessage) bool {
+	var params struct{ To string }
+	_ = json.Unmarshal(call, &params)
+	return strings.EqualFold(params.To, gasPriceOracle)
+}
+
+// newBalanceRPCServer serves the balances and fee estimates of an account holding
+// nativeBalance wei and tokenBalance of every ERC-20 token, at a base fee of
+// 1 gwei, no priority fee and an L1 data fee of testL1Fee, with ERC-20 transfers
+// using 65000 gas.
+func newBalanceRPCServer(t *testing.T, nativeBalance, tokenBalance int64) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method == "eth_getBlockByNumber" {
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"baseFeePerGas":"0x3b9aca00"}}`)
+			return
+		}
+		var result string
+		switch req.Method {
+		case "eth_getBalance":
+			result = fmt.Sprintf("0x%x", nativeBalance)
+		case "eth_call":
+			result = fmt.Sprintf("0x%064x", tokenBalance)
+			if isL1FeeCall(req.Params[0]) {
+				result = fmt.Sprintf("0x%064x", testL1Fee)
+			}
+		case "eth_maxPriorityFeePerGas":
+			result = "0x0"
+		case "eth_estimateGas":
+			result = "0xfde8"
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMaxSendable(t *testing.T) {
+	// Twice the base fee, plus twice the L1 data fee on base-sepolia.
+	const transferFee = 2*21000*1e9 + 2*testL1Fee
+	tests := []struct {
+		name                        string
+		token                       string
+		nativeBalance, tokenBalance int64
+		want                        int64
+		wantOK                      bool
+	}{
+		{"native", "eth", 1e18, 0, 1e18 - transferFee, true},
+		{"native covering only the fee", "eth", transferFee, 0, 0, false},
+		{"native below the fee", "eth", 1, 0, 0, false},
+		{"token", "usdc", 1e18, 1000, 1000, true},
+		{"token without native balance for fees", "usdc", 2*65000*1e9 + 2*testL1Fee - 1, 1000, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := newBalanceRPCServer(t, tt.nativeBalance, tt.tokenBalance)
+			client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+			account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+			amount, ok, err := account.MaxSendable(context.Background(), tt.token)
+			if err != nil {
+				t.Fatalf("MaxSendable returned an error: %v", err)
+			}
+			if amount.Int64() != tt.want || ok != tt.wantOK {
+				t.Errorf("MaxSendable = %s, %v, want %d, %v", amount, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNameNotFound is returned by ResolveName when a name is not registered or has
+// no address set.
+var ErrNameNotFound = errors.New("name not found")
+
+// ensRegistry is the address of the ENS registry on Ethereum and its testnets.
+const ensRegistry = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+
+// nameRegistries maps networks to the ENS-compatible registry their names are
+// resolved with: ENS on Ethereum, and the Basenames registry on Base.
+var nameRegistries = map[string]string{
+	"ethereum":         ensRegistry,
+	"ethereum-sepolia": ensRegistry,
+	"base":             "0xB94704422c2a1E396835A571837Aa5AE53285a95",
+	"base-sepolia":     "0x1493b2567056c2181630115660963E13A8E32735",
+}
+
+// ResolveName returns the address that name, an ENS name (e.g. "vitalik.eth") or
+// basename (e.g. "jesse.base.eth"), resolves to on network, or on
+// ClientOptions.DefaultNetwork if network is empty. A valid address is returned
+// unchanged, so recipient input can be passed through ResolveName whether it is
+// an address or a name.
+//
+// Names are resolved on-chain through the network's registry and the resolver it
+// names, using the client's RPC endpoint for network: ENS on "ethereum" and
+// "ethereum-sepolia", Basenames on "base" and "base-sepolia"; other networks are
+// an error. Basenames are resolved on Base itself, since resolving them through
+// ENS on Ethereum requires offchain lookups, which are not supported. Names are
+// lowercased but not otherwise normalized. ResolveName returns an error wrapping
+// ErrNameNotFound if the name has no resolver or no address.
+func ResolveName(ctx context.Context, client *Client, network, name string) (string, error) {
+	network = client.networkOrDefault(network)
+	if _, err := addressWord(name); err == nil {
+		return name, nil
+	}
+	if !strings.Contains(name, ".") || strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+		return "", fmt.Errorf("%q is neither an address nor a name", name)
+	}
+	registry, ok := nameRegistries[network]
+	if !ok {
+		return "", fmt.Errorf("resolving names is not supported on %s", network)
+	}
+
+	node := namehash(name)
+	resolver, err := client.nameLookup(ctx, network, registry, "resolver(bytes32)", node)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up the resolver of %s on %s: %w", name, network, err)
+	}
+	if resolver == "" {
+		return "", fmt.Errorf("%w: %s has no resolver on %s", ErrNameNotFound, name, network)
+	}
+	address, err := client.nameLookup(ctx, network, resolver, "addr(bytes32)", node)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s on %s: %w", name, network, err)
+	}
+	if address == "" {
+		return "", fmt.Errorf("%w: %s has no address on %s", ErrNameNotFound, name, network)
+	}
+	return address, nil
+}
+
+// nameLookup calls the function with the given signature, which takes a node and
+// returns an address, on contract, and returns the address, or "" if it is zero.
+func (c *Client) nameLookup(ctx context.Context, network, contract, signature string, node []byte) (string, error) {
+	selector := FunctionSelector(signature)
+	result, err := c.ethCall(ctx, network, contract, append(selector[:], node...))
+	if err != nil {
+		return "", err
+	}
+	if len(result) == 0 {
+		return "", nil
+	}
+	if len(result) < abiWordSize {
+		return "", fmt.Errorf("unexpected %s result 0x%x", signature, result)
+	}
+	address := result[abiWordSize-20 : abiWordSize]
+	if bytes.Equal(address, make([]byte, 20)) {
+		return "", nil
+	}
+	return fmt.Sprintf("0x%x", address), nil
+}
+
+// namehash returns the ENS node of name, as defined by EIP-137.
+func namehash(name string) []byte {
+	node := make([]byte, abiWordSize)
+	labels := strings.Split(strings.ToLower(name), ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = keccak256(node, keccak256([]byte(labels[i])))
+	}
+	return node
+}
@@ -0,0 +1,134 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// testNameResolver is the resolver the test name registry names for registered
+// names.
+const testNameResolver = "0x000000000000000000000000000000000000a11c"
+
+// newNameRPCServer serves a name registry on base-sepolia in which each name in
+// names resolves to its address, and counts the calls made to it.
+func newNameRPCServer(t *testing.T, names map[string]string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	nodes := map[string]string{}
+	for name, address := range names {
+		nodes[hex.EncodeToString(namehash(name))] = address
+	}
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var req struct {
+			Method string
+			Params []json.RawMessage
+		}
+		var call struct{ To, Data string }
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_call" || json.Unmarshal(req.Params[0], &call) != nil {
+			t.Errorf("unexpected RPC request %+v", req)
+			return
+		}
+
+		data := strings.TrimPrefix(call.Data, "0x")
+		selector, node := data[:8], data[8:]
+		result := ""
+		switch {
+		case strings.EqualFold(call.To, nameRegistries["base-sepolia"]) && selector == "0178b8bf": // resolver(bytes32)
+			if _, ok := nodes[node]; ok {
+				result = testNameResolver
+			}
+		case strings.EqualFold(call.To, testNameResolver) && selector == "3b3b57de": // addr(bytes32)
+			result = nodes[node]
+		default:
+			t.Errorf("unexpected call to %s with %s", call.To, call.Data)
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%064s"}`, strings.TrimPrefix(result, "0x"))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestResolveName(t *testing.T) {
+	rpc, calls := newNameRPCServer(t, map[string]string{"alice.base.eth": testRecipient})
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+	ctx := context.Background()
+
+	address, err := ResolveName(ctx, client, "base-sepolia", "Alice.base.eth")
+	if err != nil || !strings.EqualFold(address, testRecipient) {
+		t.Errorf("ResolveName returned %q, %v, want %s", address, err, testRecipient)
+	}
+
+	if _, err := ResolveName(ctx, client, "base-sepolia", "bob.base.eth"); !errors.Is(err, ErrNameNotFound) {
+		t.Errorf("expected ErrNameNotFound for an unregistered name, got %v", err)
+	}
+
+	calls.Store(0)
+	if address, err := ResolveName(ctx, client, "base-sepolia", testRecipient); err != nil || address != testRecipient {
+		t.Errorf("expected an address to be returned unchanged, got %q, %v", address, err)
+	}
+	for _, input := range []string{"not-an-address", "0x1234", ".eth", "alice..eth"} {
+		if _, err := ResolveName(ctx, client, "base-sepolia", input); err == nil || errors.Is(err, ErrNameNotFound) {
+			t.Errorf("expected %q to be rejected as neither an address nor a name, got %v", input, err)
+		}
+	}
+	if _, err := ResolveName(ctx, client, "polygon", "alice.base.eth"); err == nil {
+		t.Error("expected an error for a network without a name registry")
+	}
+	if n := calls.Load(); n != 0 {
+		t.Errorf("expected no RPC calls for invalid input, got %d", n)
+	}
+}
+
+func TestNamehash(t *testing.T) {
+	// Test vectors from EIP-137.
+	tests := map[string]string{
+		"eth":     "93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae",
+		"foo.eth": "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f",
+	}
+	for name, want := range tests {
+		if got := hex.EncodeToString(namehash(name)); got != want {
+			t.Errorf("namehash(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestTransferResolvesNames(t *testing.T) {
+	transactions := make(chan string, 1)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		transactions <- body.Transaction
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, testTxHash)
+	}))
+	defer api.Close()
+	rpc, _ := newNameRPCServer(t, map[string]string{"alice.base.eth": testRecipient})
+	client := newReceiptTestClient(t, api.URL, rpc.URL)
+	account := &EvmAccount{client: client, Address: testOwner}
+
+	if _, err := account.Transfer(context.Background(), "alice.base.eth", big.NewInt(1000), "eth", "base-sepolia"); err != nil {
+		t.Fatalf("Transfer returned %v", err)
+	}
+	want, err := SerializeTransaction("base-sepolia", TransactionRequest{To: testRecipient, Value: big.NewInt(1000), Data: "0x"})
+	if err != nil {
+		t.Fatalf("failed to serialize the expected transaction: %v", err)
+	}
+	if got := <-transactions; !strings.EqualFold(got, want) {
+		t.Errorf("sent %s, want %s", got, want)
+	}
+
+	if _, err := account.UseNetwork("base-sepolia").Transfer(context.Background(), "bob.base.eth", big.NewInt(1000), "eth"); !errors.Is(err, ErrNameNotFound) {
+		t.Errorf("expected ErrNameNotFound for an unregistered recipient, got %v", err)
+	}
+}
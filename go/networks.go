@@ -0,0 +1,75 @@
+package cdp
+
+import "time"
+
+// evmNetwork describes an EVM network supported by CDP.
+type evmNetwork struct {
+	// chainID is the EIP-155 chain ID of the network.
+	chainID int64
+	// nativeSymbol is the symbol of the network's native gas token.
+	nativeSymbol string
+	// rpcURL is a public JSON-RPC endpoint for the network, if one is known.
+	rpcURL string
+	// blockTime is the network's typical time between blocks.
+	blockTime time.Duration
+	// opStack reports whether the network is an OP Stack rollup, whose
+	// transactions also pay an L1 data fee.
+	opStack bool
+}
+
+// evmNetworks lists the EVM networks known to the SDK, keyed by CDP network name.
+//
+// The RPC URLs are rate-limited public endpoints; production deployments should
+// configure their own node via ClientOptions.RPCURLs.
+var evmNetworks = map[string]evmNetwork{
+	"arbitrum":         {chainID: 42161, nativeSymbol: "ETH", rpcURL: "https://arb1.arbitrum.io/rpc", blockTime: 250 * time.Millisecond},
+	"arbitrum-sepolia": {chainID: 421614, nativeSymbol: "ETH", rpcURL: "https://sepolia-rollup.arbitrum.io/rpc", blockTime: 250 * time.Millisecond},
+	"avalanche":        {chainID: 43114, nativeSymbol: "AVAX", rpcURL: "https://api.avax.network/ext/bc/C/rpc", blockTime: 2 * time.Second},
+	"base":             {chainID: 8453, nativeSymbol: "ETH", rpcURL: "https://mainnet.base.org", blockTime: 2 * time.Second, opStack: true},
+	"base-sepolia":     {chainID: 84532, nativeSymbol: "ETH", rpcURL: "https://sepolia.base.org", blockTime: 2 * time.Second, opStack: true},
+	"bnb":              {chainID: 56, nativeSymbol: "BNB", blockTime: time.Second},
+	"ethereum":         {chainID: 1, nativeSymbol: "ETH", rpcURL: "https://eth.merkle.io", blockTime: 12 * time.Second},
+	"ethereum-hoodi":   {chainID: 560048, nativeSymbol: "ETH", blockTime: 12 * time.Second},
+	"ethereum-sepolia": {chainID: 11155111, nativeSymbol: "ETH", rpcURL: "https://sepolia.drpc.org", blockTime: 12 * time.Second},
+	"optimism":         {chainID: 10, nativeSymbol: "ETH", rpcURL: "https://mainnet.optimism.io", blockTime: 2 * time.Second, opStack: true},
+	"polygon":          {chainID: 137, nativeSymbol: "POL", rpcURL: "https://polygon-rpc.com", blockTime: 2 * time.Second},
+	"world":            {chainID: 480, nativeSymbol: "ETH", blockTime: 2 * time.Second, opStack: true},
+	"world-sepolia":    {chainID: 4801, nativeSymbol: "ETH", blockTime: 2 * time.Second, opStack: true},
+	"zora":             {chainID: 7777777, nativeSymbol: "ETH", blockTime: 2 * time.Second, opStack: true},
+}
+
+// nativeSymbol returns the symbol of the native token on the given network,
+// defaulting to "ETH" for networks the SDK does not know about.
+func nativeSymbol(network string) string {
+	if n, ok := evmNetworks[network]; ok {
+		return n.nativeSymbol
+	}
+	return "ETH"
+}
+
+// networkOrDefault returns network, or ClientOptions.DefaultNetwork if network is
+// empty.
+func (c *Client) networkOrDefault(network string) string {
+	if network == "" {
+		return c.options.DefaultNetwork
+	}
+	return network
+}
+
+// confirmationBlocks is the number of block intervals EstimatedConfirmationTime
+// allows for a transaction to be included: the wait for the next block plus
+// margin for propagation and busy blocks.
+const confirmationBlocks = 3
+
+// EstimatedConfirmationTime returns a rough estimate of how long a transaction
+// submitted on network takes to be included in a block, based on the network's
+// typical block time. It is an estimate for setting timeouts and showing progress,
+// not a guarantee: congestion or a low fee can delay inclusion well beyond it.
+// Networks the SDK does not know use Ethereum's block time.
+func EstimatedConfirmationTime(network string) time.Duration {
+	blockTime := evmNetworks["ethereum"].blockTime
+	if n, ok := evmNetworks[network]; ok {
+		blockTime = n.blockTime
+	}
+	return confirmationBlocks * blockTime
+}
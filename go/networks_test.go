@@ -0,0 +1,71 @@
+package cdp
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDefaultNetwork(t *testing.T) {
+	client := newTestClientWithOptions(t, "", ClientOptions{
+		DefaultNetwork: "base-sepolia",
+		RPCURLs: map[string]string{
+			"base-sepolia": newCodeRPCServer(t, "0x6080").URL,
+			"base":         newCodeRPCServer(t, "0x").URL,
+		},
+	})
+	account := NewSmartAccount(client, testOwner, testOtherOwner)
+
+	if deployed, err := account.IsDeployed(context.Background(), ""); err != nil || !deployed {
+		t.Errorf("IsDeployed on the default network = %v, %v; want true", deployed, err)
+	}
+	if deployed, err := account.IsDeployed(context.Background(), "base"); err != nil || deployed {
+		t.Errorf("IsDeployed on an explicit network = %v, %v; want false", deployed, err)
+	}
+
+	if got := account.OnNetwork("").Network; got != "base-sepolia" {
+		t.Errorf("OnNetwork(\"\").Network = %q, want base-sepolia", got)
+	}
+	if got := (&EvmAccount{client: client}).UseNetwork("base").Network; got != "base" {
+		t.Errorf("UseNetwork(\"base\").Network = %q, want base", got)
+	}
+}
+
+func TestDefaultNetworkValidation(t *testing.T) {
+	if _, err := NewClient(ClientOptions{DefaultNetwork: "not-a-network"}); err == nil {
+		t.Error("expected an error for an unsupported default network")
+	}
+
+	client := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+	if _, err := NewSmartAccount(client, testOwner, testOtherOwner).IsDeployed(context.Background(), ""); err == nil {
+		t.Error("expected an error without a network or default network")
+	}
+}
+
+func TestEstimatedConfirmationTime(t *testing.T) {
+	tests := []struct {
+		network  string
+		min, max time.Duration
+	}{
+		{"base", 2 * time.Second, 15 * time.Second},
+		{"base-sepolia", 2 * time.Second, 15 * time.Second},
+		{"ethereum", 12 * time.Second, 2 * time.Minute},
+		{"unknown", 12 * time.Second, 2 * time.Minute},
+	}
+	for _, tt := range tests {
+		got := EstimatedConfirmationTime(tt.network)
+		if got < tt.min || got > tt.max {
+			t.Errorf("EstimatedConfirmationTime(%q) = %s, want between %s and %s", tt.network, got, tt.min, tt.max)
+		}
+	}
+	if EstimatedConfirmationTime("base") >= EstimatedConfirmationTime("ethereum") {
+		t.Error("expected Base to confirm faster than Ethereum")
+	}
+
+	if got := defaultReceiptTimeout("arbitrum"); got != time.Minute {
+		t.Errorf("defaultReceiptTimeout(arbitrum) = %s, want the 1 minute minimum", got)
+	}
+	if got := defaultReceiptTimeout("ethereum"); got != 12*time.Minute {
+		t.Errorf("defaultReceiptTimeout(ethereum) = %s, want 12m", got)
+	}
+}
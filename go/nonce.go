@@ -0,0 +1,113 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// NonceManager assigns nonces to transactions sent by server accounts, so that
+// several transactions can be sent from one account without waiting for each to
+// be mined. Nonces are read from the network's pending transaction count the
+// first time an account is used on a network and counted up locally after that.
+//
+// Enable it with ClientOptions.ManageNonces. When a send is rejected because its
+// nonce is too low, e.g. because a transaction was sent out-of-band, the manager
+// re-syncs the account from chain state and SendTransaction retries once with
+// the corrected nonce.
+type NonceManager struct {
+	client *Client
+
+	mu   sync.Mutex
+	next map[accountKey]uint64
+}
+
+// accountKey identifies an account on a network.
+type accountKey struct {
+	network string
+	address string
+}
+
+// newNonceManager returns a nonce manager that syncs from client's RPC endpoints.
+func newNonceManager(client *Client) *NonceManager {
+	return &NonceManager{client: client, next: map[accountKey]uint64{}}
+}
+
+// NonceManager returns the client's nonce manager, or nil if
+// ClientOptions.ManageNonces is not set.
+func (c *Client) NonceManager() *NonceManager {
+	return c.nonces
+}
+
+// Next reserves and returns the next nonce of address on network, syncing it from
+// chain state if the account has not been used on network yet.
+func (m *NonceManager) Next(ctx context.Context, network, address string) (uint64, error) {
+	key := accountKey{network: network, address: strings.ToLower(address)}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nonce, ok := m.next[key]
+	if !ok {
+		var err error
+		if nonce, err = m.client.transactionCount(ctx, network, address); err != nil {
+			return 0, err
+		}
+	}
+	m.next[key] = nonce + 1
+	return nonce, nil
+}
+
+// Sync re-reads the pending transaction count of address on network and returns
+// it as the next nonce, replacing any locally counted value.
+func (m *NonceManager) Sync(ctx context.Context, network, address string) (uint64, error) {
+	key := accountKey{network: network, address: strings.ToLower(address)}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nonce, err := m.client.transactionCount(ctx, network, address)
+	if err != nil {
+		delete(m.next, key)
+		return 0, err
+	}
+	m.next[key] = nonce
+	return nonce, nil
+}
+
+// Reset forgets the locally counted nonce of address on network, so that the
+// next call to Next syncs it from chain state.
+func (m *NonceManager) Reset(network, address string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.next, accountKey{network: network, address: strings.ToLower(address)})
+}
+
+// transactionCount returns the number of transactions sent from address on
+// network, including pending ones.
+func (c *Client) transactionCount(ctx context.Context, network, address string) (uint64, error) {
+	var count string
+	if err := c.rpcCall(ctx, network, &count, "eth_getTransactionCount", address, "pending"); err != nil {
+		return 0, fmt.Errorf("failed to get transaction count: %w", err)
+	}
+	return hexToBigInt(count).Uint64(), nil
+}
+
+// isNonceTooLow reports whether err is a rejection of a transaction whose nonce
+// has already been used.
+func isNonceTooLow(err error) bool {
+	var message string
+	var apiErr *APIError
+	var rpcErr *RPCError
+	switch {
+	case errors.As(err, &apiErr):
+		message = apiErr.message()
+	case errors.As(err, &rpcErr):
+		message = rpcErr.Message
+	default:
+		return false
+	}
+	return strings.Contains(strings.ToLower(message), "nonce too low")
+}
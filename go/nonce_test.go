@@ -0,0 +1,150 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// newNonceRPCServer serves eth_getTransactionCount with the value of count.
+func newNonceRPCServer(t *testing.T, count *atomic.Uint64) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "eth_getTransactionCount" || len(req.Params) != 2 || req.Params[1] != "pending" {
+			t.Errorf("unexpected RPC call %s %v", req.Method, req.Params)
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, count.Load())
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSendTransactionRecoversFromStaleNonce(t *testing.T) {
+	var chainCount atomic.Uint64
+	chainCount.Store(3)
+	rpc := newNonceRPCServer(t, &chainCount)
+
+	tx := TransactionRequest{To: testRecipient, Value: big.NewInt(1)}
+	serializedWithNonce := func(nonce uint64) string {
+		t.Helper()
+		tx := tx
+		tx.Nonce = nonce
+		serialized, err := SerializeTransaction("base-sepolia", tx)
+		if err != nil {
+			t.Fatalf("failed to serialize transaction: %v", err)
+		}
+		return serialized
+	}
+
+	var sent []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Transaction string `json:"transaction"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		sent = append(sent, body.Transaction)
+		w.Header().Set("Content-Type", "application/json")
+		if len(sent) == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorType":"invalid_request","errorMessage":"nonce too low: next nonce 5, tx nonce 4"}`))
+			return
+		}
+		fmt.Fprintf(w, `{"transactionHash":"0x%064x"}`, len(sent))
+	}))
+	defer api.Close()
+
+	client := newTestClientWithOptions(t, api.URL, ClientOptions{
+		RPCURLs:      map[string]string{"base-sepolia": rpc.URL},
+		ManageNonces: true,
+	})
+	account := &EvmAccount{client: client, Address: testOwner}
+
+	if _, err := account.SendTransaction(context.Background(), "base-sepolia", tx); err != nil {
+		t.Fatalf("first send failed: %v", err)
+	}
+
+	// Two transactions are sent out-of-band, so the locally counted nonce 4 is
+	// stale.
+	chainCount.Store(5)
+	hash, err := account.SendTransaction(context.Background(), "base-sepolia", tx)
+	if err != nil {
+		t.Fatalf("expected the send to recover from the stale nonce, got %v", err)
+	}
+	if want := fmt.Sprintf("0x%064x", 3); hash != want {
+		t.Errorf("expected hash %s, got %s", want, hash)
+	}
+
+	want := []string{serializedWithNonce(3), serializedWithNonce(4), serializedWithNonce(5)}
+	if len(sent) != len(want) {
+		t.Fatalf("expected %d sends, got %d", len(want), len(sent))
+	}
+	for i := range want {
+		if sent[i] != want[i] {
+			t.Errorf("send %d: expected %s, got %s", i, want[i], sent[i])
+		}
+	}
+
+	if next, err := client.NonceManager().Next(context.Background(), "base-sepolia", testOwner); err != nil || next != 6 {
+		t.Errorf("expected next nonce 6, got %d (%v)", next, err)
+	}
+}
+
+func TestSendTransactionRetriesStaleNonceOnce(t *testing.T) {
+	var chainCount atomic.Uint64
+	rpc := newNonceRPCServer(t, &chainCount)
+
+	var sends atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sends.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errorType":"invalid_request","errorMessage":"Nonce too low"}`))
+	}))
+	defer api.Close()
+
+	client := newTestClientWithOptions(t, api.URL, ClientOptions{
+		RPCURLs:      map[string]string{"base-sepolia": rpc.URL},
+		ManageNonces: true,
+	})
+	account := &EvmAccount{client: client, Address: testOwner}
+
+	_, err := account.SendTransaction(context.Background(), "base-sepolia", TransactionRequest{To: testRecipient})
+	if !isNonceTooLow(err) {
+		t.Fatalf("expected a nonce too low error, got %v", err)
+	}
+	if got := sends.Load(); got != 2 {
+		t.Errorf("expected 2 sends, got %d", got)
+	}
+}
+
+func TestIsNonceTooLow(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"api error", unexpectedStatusError("send transaction", 400, []byte(`{"errorType":"invalid_request","errorMessage":"nonce too low"}`)), true},
+		{"unparsed api error", unexpectedStatusError("send transaction", 400, []byte(`nonce too low`)), true},
+		{"rpc error", fmt.Errorf("failed: %w", &RPCError{Code: -32000, Message: "Nonce too low"}), true},
+		{"other api error", unexpectedStatusError("send transaction", 400, []byte(`{"errorMessage":"insufficient funds"}`)), false},
+		{"plain error", fmt.Errorf("nonce too low"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNonceTooLow(tt.err); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
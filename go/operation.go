@@ -0,0 +1,34 @@
+package cdp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// operationIDKey is the context key for the operation ID set by operationIDFn.
+type operationIDKey struct{}
+
+// OperationID returns the OpenAPI operation ID (e.g. "CreateEvmSmartAccount") of
+// the API request whose context is ctx, for labeling logs and metrics.
+//
+// The operation ID is attached to the request context before any other request
+// editor runs, so request editors and HTTP transports can read it with
+// OperationID(req.Context()). Note that request editors are also passed the
+// caller's original context, which does not carry the operation ID.
+func OperationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(operationIDKey{}).(string)
+	return id, ok
+}
+
+// operationIDFn attaches the ID of the request's OpenAPI operation to the request
+// context. It must be the first request editor.
+func operationIDFn() openapi.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		if operation, ok := openapi.LookupOperation(req.Method, req.URL.Path); ok {
+			*req = *req.WithContext(context.WithValue(req.Context(), operationIDKey{}, operation.ID))
+		}
+		return nil
+	}
+}
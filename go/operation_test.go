@@ -0,0 +1,61 @@
+package cdp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// operationRecorder is an HTTP transport that records the operation ID of each
+// request it sees.
+type operationRecorder struct {
+	next http.RoundTripper
+	ids  chan string
+}
+
+func (r *operationRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	id, _ := OperationID(req.Context())
+	r.ids <- id
+	return r.next.RoundTrip(req)
+}
+
+func TestOperationIDInRequestContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	var fromEditor string
+	editor := func(_ context.Context, req *http.Request) error {
+		fromEditor, _ = OperationID(req.Context())
+		return nil
+	}
+	if _, err := client.GetEvmSmartAccountByNameWithResponse(context.Background(), "treasury", editor); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if fromEditor != "GetEvmSmartAccountByName" {
+		t.Errorf("operation ID seen by editor = %q, want %q", fromEditor, "GetEvmSmartAccountByName")
+	}
+
+	// The operation ID also reaches the HTTP transport.
+	recorder := &operationRecorder{next: client.httpClient.Transport, ids: make(chan string, 1)}
+	client.httpClient.Transport = recorder
+	body := openapi.CreateEvmSmartAccountJSONRequestBody{Owners: []string{testOwner}}
+	if _, err := client.CreateEvmSmartAccountWithResponse(context.Background(), nil, body); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if id := <-recorder.ids; id != "CreateEvmSmartAccount" {
+		t.Errorf("operation ID seen by transport = %q, want %q", id, "CreateEvmSmartAccount")
+	}
+}
+
+func TestOperationIDMissingOutsideRequests(t *testing.T) {
+	if _, ok := OperationID(context.Background()); ok {
+		t.Error("expected no operation ID on a plain context")
+	}
+}
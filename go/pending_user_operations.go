@@ -0,0 +1,94 @@
+package cdp
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// maxTrackedUserOperations bounds the number of user operations the client
+// remembers per smart account and network for PendingUserOperations; the oldest
+// are forgotten first.
+const maxTrackedUserOperations = 1024
+
+// PendingUserOperations returns the hashes of the smart account's user operations
+// on network that have not completed, or whose status could not be fetched,
+// oldest first, for an operator to act on in an emergency.
+//
+// The API has no endpoint to list an account's user operations, so only those
+// sent with SendUserOperation by this client instance are considered; operations
+// sent by other clients or processes are not reported. Their statuses are fetched
+// as with GetUserOperationStatuses, and finished operations are forgotten. It
+// returns ctx.Err() if ctx is done before all statuses are fetched.
+func (s *SmartAccount) PendingUserOperations(ctx context.Context, network string) ([]string, error) {
+	network = s.client.networkOrDefault(network)
+
+	hashes := s.client.userOps.list(network, s.Address)
+	statuses := s.client.GetUserOperationStatuses(ctx, s.Address, hashes)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for _, hash := range hashes {
+		switch result := statuses[hash]; result.Status {
+		case UserOperationComplete, UserOperationFailed, UserOperationDropped:
+			s.client.userOps.forget(s.Address, hash)
+		default:
+			pending = append(pending, hash)
+		}
+	}
+	return pending, nil
+}
+
+// sentUserOperations records the user operations sent by a client, per smart
+// account and network, until they are known to have finished.
+type sentUserOperations struct {
+	mu     sync.Mutex
+	hashes map[accountKey][]string
+}
+
+// add records the user operation with the given hash sent by address on network.
+func (t *sentUserOperations) add(network, address, hash string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.hashes == nil {
+		t.hashes = map[accountKey][]string{}
+	}
+	key := accountKey{network: network, address: strings.ToLower(address)}
+	hashes := append(t.hashes[key], hash)
+	if len(hashes) > maxTrackedUserOperations {
+		hashes = hashes[len(hashes)-maxTrackedUserOperations:]
+	}
+	t.hashes[key] = hashes
+}
+
+// list returns the recorded user operations sent by address on network, oldest
+// first.
+func (t *sentUserOperations) list(network, address string) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := accountKey{network: network, address: strings.ToLower(address)}
+	return append([]string(nil), t.hashes[key]...)
+}
+
+// forget removes the user operation with the given hash sent by address.
+func (t *sentUserOperations) forget(address, hash string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	address = strings.ToLower(address)
+	for key, hashes := range t.hashes {
+		if key.address != address {
+			continue
+		}
+		for i, h := range hashes {
+			if h == hash {
+				t.hashes[key] = append(hashes[:i:i], hashes[i+1:]...)
+				break
+			}
+		}
+		if len(t.hashes[key]) == 0 {
+			delete(t.hashes, key)
+		}
+	}
+}
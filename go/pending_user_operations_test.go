@@ -0,0 +1,101 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// newPendingUserOperationServer sends user operations with hashes 0x1, 0x2, ...
+// and reports each with the status set in statuses, defaulting to pending.
+func newPendingUserOperationServer(t *testing.T) (*httptest.Server, func(hash string, status UserOperationStatus)) {
+	t.Helper()
+	var mu sync.Mutex
+	statuses := map[string]UserOperationStatus{}
+	sent := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+
+		hash := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if r.Method == http.MethodPost {
+			sent++
+			hash = fmt.Sprintf("0x%x", sent)
+		}
+		status, ok := statuses[hash]
+		if !ok {
+			status = UserOperationPending
+		}
+		fmt.Fprintf(w, `{"network":"base-sepolia","calls":[],"status":%q,"userOpHash":%q}`, status, hash)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func(hash string, status UserOperationStatus) {
+		mu.Lock()
+		defer mu.Unlock()
+		statuses[hash] = status
+	}
+}
+
+func TestPendingUserOperations(t *testing.T) {
+	server, setStatus := newPendingUserOperationServer(t)
+	client := newTestClient(t, server.URL)
+	account := &SmartAccount{client: client, Address: testOwner}
+	ctx := context.Background()
+
+	for range 4 {
+		if _, err := account.SendUserOperation(ctx, []openapi.EvmCall{{To: testRecipient, Value: "1", Data: "0x"}}, "base-sepolia", UserOperationOptions{}); err != nil {
+			t.Fatalf("failed to send user operation: %v", err)
+		}
+	}
+	setStatus("0x1", UserOperationComplete)
+	setStatus("0x3", UserOperationBroadcast)
+	setStatus("0x4", UserOperationFailed)
+
+	pending, err := account.PendingUserOperations(ctx, "base-sepolia")
+	if err != nil {
+		t.Fatalf("PendingUserOperations returned an error: %v", err)
+	}
+	if want := []string{"0x2", "0x3"}; !slices.Equal(pending, want) {
+		t.Errorf("expected pending operations %v, got %v", want, pending)
+	}
+
+	// Operations on other networks are not considered.
+	if pending, err := account.PendingUserOperations(ctx, "base"); err != nil || len(pending) != 0 {
+		t.Errorf("expected no pending operations on base, got %v, %v", pending, err)
+	}
+
+	setStatus("0x2", UserOperationComplete)
+	setStatus("0x3", UserOperationDropped)
+	if pending, err := account.PendingUserOperations(ctx, "base-sepolia"); err != nil || len(pending) != 0 {
+		t.Errorf("expected no pending operations once all finished, got %v, %v", pending, err)
+	}
+	if hashes := client.userOps.list("base-sepolia", testOwner); len(hashes) != 0 {
+		t.Errorf("expected finished operations to be forgotten, got %v", hashes)
+	}
+}
+
+func TestPendingUserOperationsRespectsCancellation(t *testing.T) {
+	server, _ := newPendingUserOperationServer(t)
+	client := newTestClient(t, server.URL)
+	account := &SmartAccount{client: client, Address: testOwner}
+	if _, err := account.SendUserOperation(context.Background(), []openapi.EvmCall{{To: testRecipient, Value: "1", Data: "0x"}}, "base-sepolia", UserOperationOptions{}); err != nil {
+		t.Fatalf("failed to send user operation: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := account.PendingUserOperations(ctx, "base-sepolia"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,248 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// PolicyDefinition describes an account-level policy to apply with
+// EvmAccount.ApplyPolicy.
+type PolicyDefinition struct {
+	// ID is the ID of an existing account-level policy to update. If empty, a new
+	// policy is created.
+	ID string
+	// Description is an optional description of the policy.
+	Description string
+	// Rules are the rules of the policy, evaluated in order.
+	Rules []openapi.Rule
+}
+
+// PolicyApplyError is returned by ApplyPolicy when the policy could not be attached
+// to the account after it was created or updated.
+type PolicyApplyError struct {
+	// PolicyID is the ID of the policy that was created or updated.
+	PolicyID string
+	// Err is the error that occurred while attaching the policy.
+	Err error
+	// RolledBack reports whether the policy change was undone: a created policy
+	// was deleted, or an updated policy was restored to its previous rules.
+	RolledBack bool
+	// RollbackErr is the error that occurred while rolling back, if any.
+	RollbackErr error
+}
+
+// Error implements the error interface.
+func (e *PolicyApplyError) Error() string {
+	if e.RolledBack {
+		return fmt.Sprintf("failed to attach policy %s, policy change was rolled back: %v", e.PolicyID, e.Err)
+	}
+	return fmt.Sprintf("failed to attach policy %s, rollback failed (%v): %v", e.PolicyID, e.RollbackErr, e.Err)
+}
+
+// Unwrap returns the error that occurred while attaching the policy.
+func (e *PolicyApplyError) Unwrap() error {
+	return e.Err
+}
+
+// ApplyPolicy creates or updates an account-level policy from def and attaches it
+// to the account, returning the policy ID.
+//
+// The API has no transactional endpoint for this, so ApplyPolicy is atomic only on
+// a best-effort basis: if attaching fails, a newly created policy is deleted and an
+// updated policy is restored to its previous description and rules, and a
+// *PolicyApplyError reports whether the rollback succeeded. The account's
+// previously attached policy is left in place in that case. Other requests made
+// concurrently against the same policy may observe the intermediate state.
+func (a *EvmAccount) ApplyPolicy(ctx context.Context, def PolicyDefinition) (string, error) {
+	if err := a.client.checkWritable(); err != nil {
+		return "", err
+	}
+
+	var (
+		policyID string
+		rollback func(context.Context) error
+	)
+
+	if def.ID == "" {
+		policy, err := a.client.createAccountPolicy(ctx, def)
+		if err != nil {
+			return "", err
+		}
+		policyID = policy.Id
+		rollback = func(ctx context.Context) error { return a.client.deletePolicy(ctx, policyID) }
+	} else {
+		previous, err := a.client.getPolicy(ctx, def.ID)
+		if err != nil {
+			return "", err
+		}
+		if previous.Scope != openapi.PolicyScopeAccount {
+			return "", fmt.Errorf("policy %s is a %s-level policy and cannot be attached to an account", def.ID, previous.Scope)
+		}
+		var description *string
+		if def.Description != "" {
+			description = &def.Description
+		}
+		if err := a.client.updatePolicy(ctx, def.ID, description, def.Rules); err != nil {
+			return "", err
+		}
+		policyID = def.ID
+		rollback = func(ctx context.Context) error {
+			// An omitted description is left as it is, so a policy that had none is
+			// restored with an explicitly empty one.
+			description := previous.Description
+			if description == nil {
+				description = new(string)
+			}
+			return a.client.updatePolicy(ctx, def.ID, description, previous.Rules)
+		}
+	}
+
+	if err := a.attachPolicy(ctx, policyID); err != nil {
+		// Roll back even if ctx was canceled, so the policy isn't left half-applied.
+		rollbackErr := rollback(context.WithoutCancel(ctx))
+		return "", &PolicyApplyError{PolicyID: policyID, Err: err, RolledBack: rollbackErr == nil, RollbackErr: rollbackErr}
+	}
+
+	return policyID, nil
+}
+
+// attachPolicy sets the account's account-level policy.
+func (a *EvmAccount) attachPolicy(ctx context.Context, policyID string) error {
+	resp, err := a.client.UpdateEvmAccountWithResponse(ctx, a.Address, nil, openapi.UpdateEvmAccountJSONRequestBody{
+		AccountPolicy: &policyID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update EVM account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return unexpectedStatusError("update EVM account", resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+// createAccountPolicy creates an account-level policy from def.
+func (c *Client) createAccountPolicy(ctx context.Context, def PolicyDefinition) (*openapi.Policy, error) {
+	body := openapi.CreatePolicyJSONRequestBody{
+		Scope: openapi.CreatePolicyJSONBodyScopeAccount,
+		Rules: def.Rules,
+	}
+	if def.Description != "" {
+		body.Description = &def.Description
+	}
+
+	resp, err := c.CreatePolicyWithResponse(ctx, nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated || resp.JSON201 == nil {
+		return nil, unexpectedStatusError("create policy", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON201, nil
+}
+
+// getPolicy returns the policy with the given ID.
+func (c *Client) getPolicy(ctx context.Context, policyID string) (*openapi.Policy, error) {
+	resp, err := c.GetPolicyByIdWithResponse(ctx, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, unexpectedStatusError("get policy", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// getProjectPolicy returns the project-level policy, or nil if the project has
+// none.
+func (c *Client) getProjectPolicy(ctx context.Context) (*openapi.Policy, error) {
+	scope := openapi.ListPoliciesParamsScopeProject
+	resp, err := c.ListPoliciesWithResponse(ctx, &openapi.ListPoliciesParams{Scope: &scope})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, unexpectedStatusError("list policies", resp.StatusCode(), resp.Body)
+	}
+	if len(resp.JSON200.Policies) == 0 {
+		return nil, nil
+	}
+	return &resp.JSON200.Policies[0], nil
+}
+
+// updatePolicy replaces the rules of the policy with the given ID, and its
+// description unless description is nil.
+func (c *Client) updatePolicy(ctx context.Context, policyID string, description *string, rules []openapi.Rule) error {
+	body := openapi.UpdatePolicyJSONRequestBody{Rules: rules, Description: description}
+
+	resp, err := c.UpdatePolicyWithResponse(ctx, policyID, nil, body)
+	if err != nil {
+		return fmt.Errorf("failed to update policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return unexpectedStatusError("update policy", resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+// deletePolicy deletes the policy with the given ID.
+func (c *Client) deletePolicy(ctx context.Context, policyID string) error {
+	resp, err := c.DeletePolicyWithResponse(ctx, policyID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return unexpectedStatusError("delete policy", resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+// PolicyAccounts lists the accounts a policy applies to.
+type PolicyAccounts struct {
+	// EvmAccounts are the EVM accounts the policy applies to.
+	EvmAccounts []*EvmAccount
+	// SmartAccounts are the EVM smart accounts the policy applies to.
+	SmartAccounts []*SmartAccount
+}
+
+// ListAccountsByPolicy returns the EVM accounts and smart accounts that policyID
+// applies to, for example to review a policy's reach before changing it. A
+// project-level policy applies to every account. The API has no server-side
+// filter, so this pages through all of the project's accounts. If no account
+// matches, both lists are empty.
+func (c *Client) ListAccountsByPolicy(ctx context.Context, policyID string) (*PolicyAccounts, error) {
+	result := &PolicyAccounts{EvmAccounts: []*EvmAccount{}, SmartAccounts: []*SmartAccount{}}
+
+	accounts := ListEvmAccounts(c, ListOptions{})
+	for {
+		account, ok, err := accounts.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if slices.Contains(account.Policies, policyID) {
+			result.EvmAccounts = append(result.EvmAccounts, account)
+		}
+	}
+
+	smartAccounts := ListSmartAccounts(c, ListOptions{})
+	for {
+		account, ok, err := smartAccounts.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if slices.Contains(account.Policies, policyID) {
+			result.SmartAccounts = append(result.SmartAccounts, account)
+		}
+	}
+
+	return result, nil
+}
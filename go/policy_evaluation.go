@@ -0,0 +1,241 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// PolicyOperation is an operation to evaluate against an account's policies with
+// EvmAccount.EvaluateAgainstPolicy.
+type PolicyOperation struct {
+	// Operation is the policy operation: "signEvmTransaction" or
+	// "sendEvmTransaction".
+	Operation string
+	// Network is the network a sendEvmTransaction operation sends on. If empty,
+	// ClientOptions.DefaultNetwork is used. It is ignored for signEvmTransaction.
+	Network string
+	// Transaction is the transaction to sign or send.
+	Transaction TransactionRequest
+}
+
+// PolicyEvaluation is the outcome of EvmAccount.EvaluateAgainstPolicy.
+type PolicyEvaluation struct {
+	// Allowed reports whether the policies would accept the operation.
+	Allowed bool
+	// PolicyID is the ID of the policy containing the rule that decided the
+	// outcome: the rejecting rule if the operation is rejected, and the last
+	// accepting rule otherwise. It is empty if no rule matched, in which case the
+	// operation is rejected, or if no policies apply, in which case it is
+	// allowed.
+	PolicyID string
+	// RuleIndex is the index of the matched rule within the policy's rules, or -1
+	// if no rule matched.
+	RuleIndex int
+	// Rule is the matched rule, or nil if no rule matched.
+	Rule *openapi.Rule
+}
+
+// EvaluateAgainstPolicy reports whether the policies that apply to the account
+// would allow op, and which rule decides it, without signing or sending anything.
+// This lets an app warn that an operation would be blocked before submitting it.
+//
+// The API has no dry-run endpoint, so the project-level policy and the account's
+// current policies are fetched and evaluated client-side the way the policy
+// engine does: the project-level policy first and then the account-level policy,
+// each rule in order, applying the action of the first rule whose operation
+// matches and whose criteria are all met. The operation must not be rejected by
+// either policy: a rejecting rule decides the outcome at once, while an accepting
+// rule moves on to the next policy. The operation is allowed if a rule accepted
+// it and none rejected it, and if no policies apply; if policies apply but no
+// rule matches, it is rejected. Only the ethValue, evmAddress and evmNetwork
+// criteria can be evaluated; a rule for op's operation with any other criterion
+// is an error rather than a guess. The server remains authoritative, and
+// policies changed after the call may decide differently.
+func (a *EvmAccount) EvaluateAgainstPolicy(ctx context.Context, op PolicyOperation) (*PolicyEvaluation, error) {
+	if op.Operation != string(openapi.SignEvmTransaction) && op.Operation != string(openapi.SendEvmTransaction) {
+		return nil, fmt.Errorf("evaluating %q operations is not supported", op.Operation)
+	}
+	network := a.client.networkOrDefault(op.Network)
+
+	resp, err := a.client.GetEvmAccountWithResponse(ctx, a.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EVM account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, unexpectedResponseError("get EVM account", resp.HTTPResponse, resp.Body)
+	}
+	var policyIDs []string
+	if resp.JSON200.Policies != nil {
+		policyIDs = *resp.JSON200.Policies
+	}
+
+	// The project-level policy applies to every account, whether or not the
+	// account lists it, so it is fetched on its own.
+	project, err := a.client.getProjectPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies := make([]*openapi.Policy, 0, len(policyIDs)+1)
+	if project != nil {
+		policies = append(policies, project)
+	}
+	for _, id := range policyIDs {
+		if project != nil && id == project.Id {
+			continue
+		}
+		policy, err := a.client.getPolicy(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	// The project-level policy is evaluated before the account-level one.
+	slices.SortStableFunc(policies, func(x, y *openapi.Policy) int {
+		return policyScopeOrder(x.Scope) - policyScopeOrder(y.Scope)
+	})
+
+	if len(policies) == 0 {
+		return &PolicyEvaluation{Allowed: true, RuleIndex: -1}, nil
+	}
+	// accepted is the last accepting rule found, if any.
+	var accepted *PolicyEvaluation
+	for _, policy := range policies {
+		for i := range policy.Rules {
+			matched, accept, err := evaluateRule(policy.Rules[i], op, network)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate rule %d of policy %s: %w", i, policy.Id, err)
+			}
+			if !matched {
+				continue
+			}
+			evaluation := &PolicyEvaluation{Allowed: accept, PolicyID: policy.Id, RuleIndex: i, Rule: &policy.Rules[i]}
+			if !accept {
+				return evaluation, nil
+			}
+			accepted = evaluation
+			break
+		}
+	}
+	if accepted != nil {
+		return accepted, nil
+	}
+	return &PolicyEvaluation{RuleIndex: -1}, nil
+}
+
+// policyScopeOrder returns the position of a policy with the given scope in
+// evaluation order.
+func policyScopeOrder(scope openapi.PolicyScope) int {
+	if scope == openapi.PolicyScopeProject {
+		return 0
+	}
+	return 1
+}
+
+// evaluateRule reports whether rule applies to op on network and, if so, whether
+// it accepts it.
+func evaluateRule(rule openapi.Rule, op PolicyOperation, network string) (matched, accept bool, err error) {
+	raw, err := rule.MarshalJSON()
+	if err != nil {
+		return false, false, err
+	}
+	var r struct {
+		Action    string            `json:"action"`
+		Operation string            `json:"operation"`
+		Criteria  []json.RawMessage `json:"criteria"`
+	}
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return false, false, err
+	}
+	if r.Operation != op.Operation {
+		return false, false, nil
+	}
+
+	for _, criterion := range r.Criteria {
+		met, err := evaluateCriterion(criterion, op.Transaction, network)
+		if err != nil {
+			return false, false, err
+		}
+		if !met {
+			return false, false, nil
+		}
+	}
+	return true, r.Action == string(openapi.SignEvmTransactionRuleActionAccept), nil
+}
+
+// evaluateCriterion reports whether tx, sent on network, meets criterion.
+func evaluateCriterion(criterion json.RawMessage, tx TransactionRequest, network string) (bool, error) {
+	var typed struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(criterion, &typed); err != nil {
+		return false, err
+	}
+
+	switch typed.Type {
+	case "ethValue":
+		var c openapi.EthValueCriterion
+		if err := json.Unmarshal(criterion, &c); err != nil {
+			return false, err
+		}
+		limit, ok := new(big.Int).SetString(c.EthValue, 10)
+		if !ok {
+			return false, fmt.Errorf("invalid ethValue %q", c.EthValue)
+		}
+		value := tx.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		cmp := value.Cmp(limit)
+		switch c.Operator {
+		case openapi.EthValueCriterionOperatorEqualEqual:
+			return cmp == 0, nil
+		case openapi.EthValueCriterionOperatorEmpty:
+			return cmp > 0, nil
+		case openapi.EthValueCriterionOperatorN1:
+			return cmp >= 0, nil
+		case openapi.EthValueCriterionOperatorN2:
+			return cmp < 0, nil
+		case openapi.EthValueCriterionOperatorN3:
+			return cmp <= 0, nil
+		}
+		return false, fmt.Errorf("unknown ethValue operator %q", c.Operator)
+
+	case "evmAddress":
+		var c openapi.EvmAddressCriterion
+		if err := json.Unmarshal(criterion, &c); err != nil {
+			return false, err
+		}
+		in := tx.To != "" && slices.ContainsFunc(c.Addresses, func(address string) bool {
+			return strings.EqualFold(address, tx.To)
+		})
+		return matchesListOperator(string(c.Operator), in)
+
+	case "evmNetwork":
+		var c openapi.EvmNetworkCriterion
+		if err := json.Unmarshal(criterion, &c); err != nil {
+			return false, err
+		}
+		in := slices.Contains(c.Networks, openapi.EvmNetworkCriterionNetworks(network))
+		return matchesListOperator(string(c.Operator), in)
+	}
+	return false, fmt.Errorf("%s criteria cannot be evaluated client-side", typed.Type)
+}
+
+// matchesListOperator reports whether an "in" or "not in" criterion is met, given
+// whether the value is in the criterion's list.
+func matchesListOperator(operator string, in bool) (bool, error) {
+	switch operator {
+	case "in":
+		return in, nil
+	case "not in":
+		return !in, nil
+	}
+	return false, fmt.Errorf("unknown operator %q", operator)
+}
@@ -0,0 +1,157 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func parseRules(t *testing.T, raw string) []openapi.Rule {
+	t.Helper()
+	var rules []openapi.Rule
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		t.Fatalf("failed to parse rules: %v", err)
+	}
+	return rules
+}
+
+// newPolicyEvaluationAccount returns an account whose account-level policy
+// rejects transactions worth more than 1 ETH and accepts those sent to
+// testRecipient and sends on base, and whose project-level policy accepts sends
+// on base and base-sepolia.
+func newPolicyEvaluationAccount(t *testing.T) *EvmAccount {
+	t.Helper()
+	f, account := newFakePolicyServer(t)
+	_, err := account.ApplyPolicy(context.Background(), PolicyDefinition{Rules: parseRules(t, `[
+		{"action":"reject","operation":"signEvmTransaction","criteria":[{"type":"ethValue","ethValue":"1000000000000000000","operator":">"}]},
+		{"action":"accept","operation":"signEvmTransaction","criteria":[{"type":"evmAddress","addresses":["0x`+strings.ToUpper(testRecipient[2:])+`"],"operator":"in"}]},
+		{"action":"reject","operation":"sendEvmTransaction","criteria":[{"type":"evmNetwork","networks":["base"],"operator":"in"}]}
+	]`)})
+	if err != nil {
+		t.Fatalf("failed to apply policy: %v", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.project = "project-policy"
+	f.policies[f.project] = map[string]interface{}{
+		"id": f.project, "scope": "project", "createdAt": "2025-01-01T00:00:00Z", "updatedAt": "2025-01-01T00:00:00Z",
+		"rules": []interface{}{map[string]interface{}{
+			"action": "accept", "operation": "sendEvmTransaction",
+			"criteria": []interface{}{map[string]interface{}{"type": "evmNetwork", "networks": []string{"base", "base-sepolia"}, "operator": "in"}},
+		}},
+	}
+	return account
+}
+
+func TestEvaluateAgainstPolicy(t *testing.T) {
+	account := newPolicyEvaluationAccount(t)
+	oneEth := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	tests := []struct {
+		name      string
+		op        PolicyOperation
+		allowed   bool
+		policyID  string
+		ruleIndex int
+	}{
+		{
+			name:      "accepted by an address rule",
+			op:        PolicyOperation{Operation: "signEvmTransaction", Transaction: TransactionRequest{To: testRecipient, Value: oneEth}},
+			allowed:   true,
+			policyID:  "policy-1",
+			ruleIndex: 1,
+		},
+		{
+			name:      "rejected by a value rule",
+			op:        PolicyOperation{Operation: "signEvmTransaction", Transaction: TransactionRequest{To: testRecipient, Value: new(big.Int).Add(oneEth, big.NewInt(1))}},
+			policyID:  "policy-1",
+			ruleIndex: 0,
+		},
+		{
+			name:      "rejected when no rule matches",
+			op:        PolicyOperation{Operation: "signEvmTransaction", Transaction: TransactionRequest{To: testOwner}},
+			ruleIndex: -1,
+		},
+		{
+			name:      "accepted by the project policy and rejected by the account policy",
+			op:        PolicyOperation{Operation: "sendEvmTransaction", Network: "base", Transaction: TransactionRequest{To: testRecipient}},
+			policyID:  "policy-1",
+			ruleIndex: 2,
+		},
+		{
+			name:      "accepted by the project policy only",
+			op:        PolicyOperation{Operation: "sendEvmTransaction", Network: "base-sepolia", Transaction: TransactionRequest{To: testRecipient}},
+			allowed:   true,
+			policyID:  "project-policy",
+			ruleIndex: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluation, err := account.EvaluateAgainstPolicy(context.Background(), tt.op)
+			if err != nil {
+				t.Fatalf("EvaluateAgainstPolicy failed: %v", err)
+			}
+			if evaluation.Allowed != tt.allowed || evaluation.PolicyID != tt.policyID || evaluation.RuleIndex != tt.ruleIndex {
+				t.Errorf("got allowed=%v by rule %d of %q, want allowed=%v by rule %d of %q",
+					evaluation.Allowed, evaluation.RuleIndex, evaluation.PolicyID, tt.allowed, tt.ruleIndex, tt.policyID)
+			}
+			if (evaluation.Rule != nil) != (tt.ruleIndex >= 0) {
+				t.Errorf("got rule %v for rule index %d", evaluation.Rule, tt.ruleIndex)
+			}
+		})
+	}
+}
+
+func TestEvaluateAgainstPolicyWithoutPolicies(t *testing.T) {
+	_, account := newFakePolicyServer(t)
+
+	evaluation, err := account.EvaluateAgainstPolicy(context.Background(), PolicyOperation{Operation: "signEvmTransaction", Transaction: TransactionRequest{To: testOwner}})
+	if err != nil {
+		t.Fatalf("EvaluateAgainstPolicy failed: %v", err)
+	}
+	if !evaluation.Allowed || evaluation.PolicyID != "" || evaluation.RuleIndex != -1 || evaluation.Rule != nil {
+		t.Errorf("expected an account without policies to allow everything, got %+v", evaluation)
+	}
+}
+
+func TestEvaluateAgainstPolicyUnlistedProjectPolicy(t *testing.T) {
+	f, account := newFakePolicyServer(t)
+	// The account does not list the project-level policy.
+	f.policies["project-policy"] = map[string]interface{}{
+		"id": "project-policy", "scope": "project", "createdAt": "2025-01-01T00:00:00Z", "updatedAt": "2025-01-01T00:00:00Z",
+		"rules": []interface{}{map[string]interface{}{
+			"action": "reject", "operation": "signEvmTransaction",
+			"criteria": []interface{}{map[string]interface{}{"type": "evmAddress", "addresses": []string{testOwner}, "operator": "in"}},
+		}},
+	}
+
+	evaluation, err := account.EvaluateAgainstPolicy(context.Background(), PolicyOperation{Operation: "signEvmTransaction", Transaction: TransactionRequest{To: testOwner}})
+	if err != nil {
+		t.Fatalf("EvaluateAgainstPolicy failed: %v", err)
+	}
+	if evaluation.Allowed || evaluation.PolicyID != "project-policy" || evaluation.RuleIndex != 0 {
+		t.Errorf("expected the project policy to reject the operation, got %+v", evaluation)
+	}
+}
+
+func TestEvaluateAgainstPolicyRejectsUnsupportedCriteria(t *testing.T) {
+	_, account := newFakePolicyServer(t)
+	_, err := account.ApplyPolicy(context.Background(), PolicyDefinition{Rules: parseRules(t, `[
+		{"action":"accept","operation":"signEvmTransaction","criteria":[{"type":"evmData","abi":"erc20","conditions":[{"function":"transfer"}]}]}
+	]`)})
+	if err != nil {
+		t.Fatalf("failed to apply policy: %v", err)
+	}
+
+	_, err = account.EvaluateAgainstPolicy(context.Background(), PolicyOperation{Operation: "signEvmTransaction", Transaction: TransactionRequest{To: testRecipient}})
+	if err == nil || !strings.Contains(err.Error(), "evmData criteria cannot be evaluated") {
+		t.Errorf("expected an unsupported criterion error, got %v", err)
+	}
+}
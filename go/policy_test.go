@@ -0,0 +1,238 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// fakePolicyServer stores policies in memory and optionally fails to attach them
+// to accounts. Accounts are served with the project policy, if set, and the
+// attached policy.
+type fakePolicyServer struct {
+	mu         sync.Mutex
+	policies   map[string]map[string]interface{}
+	project    string
+	attached   string
+	failAttach bool
+}
+
+func newFakePolicyServer(t *testing.T) (*fakePolicyServer, *EvmAccount) {
+	t.Helper()
+	// Failed attaches are 500s, which are retried.
+	setFastTimers(t)
+	f := &fakePolicyServer{policies: map[string]map[string]interface{}{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/policy-engine/policies", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			policies := []map[string]interface{}{}
+			for _, policy := range f.policies {
+				if scope := r.URL.Query().Get("scope"); scope == "" || policy["scope"] == scope {
+					policies = append(policies, policy)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"policies": policies})
+			return
+		}
+		var policy map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&policy)
+		id := fmt.Sprintf("policy-%d", len(f.policies)+1)
+		policy["id"], policy["createdAt"], policy["updatedAt"] = id, "2025-01-01T00:00:00Z", "2025-01-01T00:00:00Z"
+		f.policies[id] = policy
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(policy)
+	})
+	mux.HandleFunc("/v2/policy-engine/policies/", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		id := strings.TrimPrefix(r.URL.Path, "/v2/policy-engine/policies/")
+		policy, ok := f.policies[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodDelete:
+			delete(f.policies, id)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var update map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&update)
+			policy["rules"] = update["rules"]
+			if description, ok := update["description"]; ok {
+				policy["description"] = description
+			}
+			_ = json.NewEncoder(w).Encode(policy)
+		default:
+			_ = json.NewEncoder(w).Encode(policy)
+		}
+	})
+	mux.HandleFunc("/v2/evm/accounts/", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if r.Method == http.MethodGet {
+			var policies []string
+			for _, id := range []string{f.project, f.attached} {
+				if id != "" {
+					policies = append(policies, id)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"address": testOwner, "policies": policies})
+			return
+		}
+		if f.failAttach {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"errorType":"internal_server_error","errorMessage":"boom"}`)
+			return
+		}
+		var body struct{ AccountPolicy string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.attached = body.AccountPolicy
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q,"policies":[%q]}`, testOwner, body.AccountPolicy)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return f, &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+}
+
+func testRules(t *testing.T, maxValue string) []openapi.Rule {
+	t.Helper()
+	var rule openapi.Rule
+	raw := fmt.Sprintf(`{"action":"reject","operation":"signEvmTransaction","criteria":[{"type":"ethValue","ethValue":%q,"operator":">"}]}`, maxValue)
+	if err := json.Unmarshal([]byte(raw), &rule); err != nil {
+		t.Fatalf("failed to build rule: %v", err)
+	}
+	return []openapi.Rule{rule}
+}
+
+func TestApplyPolicyCreatesAndAttaches(t *testing.T) {
+	f, account := newFakePolicyServer(t)
+
+	id, err := account.ApplyPolicy(context.Background(), PolicyDefinition{Description: "limits", Rules: testRules(t, "1000")})
+	if err != nil {
+		t.Fatalf("ApplyPolicy returned an error: %v", err)
+	}
+	if f.attached != id || f.policies[id]["scope"] != "account" {
+		t.Errorf("policy %s not attached as an account policy: attached=%s policies=%v", id, f.attached, f.policies)
+	}
+}
+
+func TestApplyPolicyRollsBackCreatedPolicy(t *testing.T) {
+	f, account := newFakePolicyServer(t)
+	f.failAttach = true
+
+	_, err := account.ApplyPolicy(context.Background(), PolicyDefinition{Rules: testRules(t, "1000")})
+
+	var applyErr *PolicyApplyError
+	if !errors.As(err, &applyErr) || !applyErr.RolledBack {
+		t.Fatalf("expected a rolled back *PolicyApplyError, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected the attach error to be wrapped, got %v", err)
+	}
+	if len(f.policies) != 0 {
+		t.Errorf("expected the created policy to be deleted, got %v", f.policies)
+	}
+}
+
+func TestApplyPolicyRestoresUpdatedPolicy(t *testing.T) {
+	f, account := newFakePolicyServer(t)
+	id, err := account.ApplyPolicy(context.Background(), PolicyDefinition{Description: "v1", Rules: testRules(t, "1000")})
+	if err != nil {
+		t.Fatalf("ApplyPolicy returned an error: %v", err)
+	}
+
+	f.failAttach = true
+	_, err = account.ApplyPolicy(context.Background(), PolicyDefinition{ID: id, Description: "v2", Rules: testRules(t, "5")})
+
+	var applyErr *PolicyApplyError
+	if !errors.As(err, &applyErr) || !applyErr.RolledBack || applyErr.PolicyID != id {
+		t.Fatalf("expected a rolled back *PolicyApplyError for %s, got %v", id, err)
+	}
+	if f.policies[id]["description"] != "v1" || !strings.Contains(fmt.Sprint(f.policies[id]["rules"]), "1000") {
+		t.Errorf("expected the policy to be restored, got %v", f.policies[id])
+	}
+}
+
+func TestApplyPolicyRestoresMissingDescription(t *testing.T) {
+	f, account := newFakePolicyServer(t)
+	id, err := account.ApplyPolicy(context.Background(), PolicyDefinition{Rules: testRules(t, "1000")})
+	if err != nil {
+		t.Fatalf("ApplyPolicy returned an error: %v", err)
+	}
+
+	f.failAttach = true
+	_, err = account.ApplyPolicy(context.Background(), PolicyDefinition{ID: id, Description: "v2", Rules: testRules(t, "5")})
+
+	var applyErr *PolicyApplyError
+	if !errors.As(err, &applyErr) || !applyErr.RolledBack {
+		t.Fatalf("expected a rolled back *PolicyApplyError, got %v", err)
+	}
+	if description := f.policies[id]["description"]; description != "" {
+		t.Errorf("expected the description to be cleared, got %v", description)
+	}
+}
+
+func TestListAccountsByPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v2/evm/accounts" && r.URL.Query().Get("pageToken") == "":
+			fmt.Fprint(w, `{"accounts":[
+				{"address":"0x01","policies":["project","policy-a"]},
+				{"address":"0x02","policies":["project"]}
+			],"nextPageToken":"p2"}`)
+		case r.URL.Path == "/v2/evm/accounts":
+			fmt.Fprint(w, `{"accounts":[{"address":"0x03","policies":["policy-a"]},{"address":"0x04"}]}`)
+		case r.URL.Path == "/v2/evm/smart-accounts":
+			fmt.Fprint(w, `{"accounts":[
+				{"address":"0x05","owners":["0x01"],"policies":["policy-a"]},
+				{"address":"0x06","owners":["0x02"],"policies":["project"]}
+			]}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	got, err := client.ListAccountsByPolicy(context.Background(), "policy-a")
+	if err != nil {
+		t.Fatalf("ListAccountsByPolicy returned an error: %v", err)
+	}
+	var addresses []string
+	for _, account := range got.EvmAccounts {
+		addresses = append(addresses, account.Address)
+	}
+	for _, account := range got.SmartAccounts {
+		addresses = append(addresses, account.Address)
+	}
+	if fmt.Sprint(addresses) != "[0x01 0x03 0x05]" {
+		t.Errorf("got accounts %v, want [0x01 0x03 0x05]", addresses)
+	}
+
+	none, err := client.ListAccountsByPolicy(context.Background(), "unused")
+	if err != nil {
+		t.Fatalf("ListAccountsByPolicy returned an error: %v", err)
+	}
+	if none.EvmAccounts == nil || none.SmartAccounts == nil || len(none.EvmAccounts)+len(none.SmartAccounts) != 0 {
+		t.Errorf("got %+v, want empty lists", none)
+	}
+}
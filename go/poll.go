@@ -0,0 +1,87 @@
+package cdp
+
+import (
+	"math"
+	"time"
+)
+
+// PollStrategy decides how long to wait between the polls of an operation that
+// completes asynchronously, such as WaitForUserOperation.
+type PollStrategy interface {
+	// Next returns how long to wait after poll number attempt, starting at 1,
+	// before polling again.
+	Next(attempt int) time.Duration
+}
+
+// Defaults for zero-valued poll strategy fields, so that a strategy never polls
+// in a tight loop.
+const (
+	defaultPollMin = 250 * time.Millisecond
+	defaultPollMax = 5 * time.Second
+)
+
+// FixedPollStrategy polls at a fixed interval.
+type FixedPollStrategy struct {
+	// Interval is the delay between polls. Zero or negative values default to
+	// 250ms.
+	Interval time.Duration
+}
+
+// Next implements PollStrategy.
+func (s FixedPollStrategy) Next(int) time.Duration {
+	if s.Interval <= 0 {
+		return defaultPollMin
+	}
+	return s.Interval
+}
+
+// AdaptivePollStrategy polls quickly at first, to notice fast operations with
+// little latency, then backs off exponentially to limit the load that slow
+// operations put on the API.
+type AdaptivePollStrategy struct {
+	// Min is the delay after the first poll. Zero or negative values default to
+	// 250ms.
+	Min time.Duration
+	// Max caps the delay between polls. Zero or negative values default to 5
+	// seconds.
+	Max time.Duration
+	// Multiplier is the factor the delay grows by after each poll. Values below 1
+	// default to 1.5.
+	Multiplier float64
+}
+
+// Next implements PollStrategy.
+func (s AdaptivePollStrategy) Next(attempt int) time.Duration {
+	multiplier := s.Multiplier
+	if multiplier < 1 {
+		multiplier = 1.5
+	}
+	minDelay, maxDelay := s.Min, s.Max
+	if minDelay <= 0 {
+		minDelay = defaultPollMin
+	}
+	if maxDelay <= 0 {
+		maxDelay = defaultPollMax
+	}
+	delay := float64(minDelay) * math.Pow(multiplier, float64(max(attempt-1, 0)))
+	if delay >= float64(maxDelay) {
+		return maxDelay
+	}
+	return time.Duration(delay)
+}
+
+// defaultUserOperationPollStrategy is used when
+// ClientOptions.UserOperationPollStrategy is nil. User operations usually complete
+// within a few blocks, so it starts below the block time of fast networks.
+var defaultUserOperationPollStrategy PollStrategy = AdaptivePollStrategy{
+	Min: defaultPollMin,
+	Max: defaultPollMax,
+}
+
+// userOperationPollStrategy returns the strategy WaitForUserOperation polls with.
+func (c *Client) userOperationPollStrategy() PollStrategy {
+	if c.options.UserOperationPollStrategy != nil {
+		return c.options.UserOperationPollStrategy
+	}
+	return defaultUserOperationPollStrategy
+}
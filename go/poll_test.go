@@ -0,0 +1,107 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFixedPollStrategy(t *testing.T) {
+	strategy := FixedPollStrategy{Interval: time.Second}
+	for attempt := 1; attempt <= 5; attempt++ {
+		if got := strategy.Next(attempt); got != time.Second {
+			t.Errorf("Next(%d) = %s, want 1s", attempt, got)
+		}
+	}
+}
+
+func TestFixedPollStrategyDefaultsZeroInterval(t *testing.T) {
+	if got := (FixedPollStrategy{}).Next(1); got != 250*time.Millisecond {
+		t.Errorf("Next(1) = %s, want 250ms", got)
+	}
+}
+
+func TestAdaptivePollStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy AdaptivePollStrategy
+		want     []time.Duration
+	}{
+		{
+			"default multiplier",
+			AdaptivePollStrategy{Min: 100 * time.Millisecond, Max: time.Second},
+			[]time.Duration{100 * time.Millisecond, 150 * time.Millisecond, 225 * time.Millisecond, 337500 * time.Microsecond, 506250 * time.Microsecond, 759375 * time.Microsecond, time.Second, time.Second},
+		},
+		{
+			"doubling",
+			AdaptivePollStrategy{Min: 250 * time.Millisecond, Max: 2 * time.Second, Multiplier: 2},
+			[]time.Duration{250 * time.Millisecond, 500 * time.Millisecond, time.Second, 2 * time.Second, 2 * time.Second},
+		},
+		{
+			"min above max",
+			AdaptivePollStrategy{Min: time.Second, Max: 500 * time.Millisecond},
+			[]time.Duration{500 * time.Millisecond, 500 * time.Millisecond},
+		},
+		{
+			"zero value",
+			AdaptivePollStrategy{},
+			[]time.Duration{250 * time.Millisecond, 375 * time.Millisecond},
+		},
+		{
+			"zero max",
+			AdaptivePollStrategy{Min: 4 * time.Second},
+			[]time.Duration{4 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, want := range tt.want {
+				if got := tt.strategy.Next(i + 1); got != want {
+					t.Errorf("Next(%d) = %s, want %s", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+// recordingPollStrategy records the attempts it is asked about.
+type recordingPollStrategy struct {
+	mu       sync.Mutex
+	attempts []int
+}
+
+func (s *recordingPollStrategy) Next(attempt int) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts = append(s.attempts, attempt)
+	return time.Millisecond
+}
+
+func TestWaitForUserOperationUsesPollStrategy(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := "broadcast"
+		if polls.Add(1) >= 4 {
+			status = "complete"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"network":"base-sepolia","calls":[],"status":%q,"userOpHash":"0xop"}`, status)
+	}))
+	defer server.Close()
+
+	strategy := &recordingPollStrategy{}
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{UserOperationPollStrategy: strategy})
+	account := &SmartAccount{client: client, Address: testOwner}
+	if _, err := account.WaitForUserOperation(context.Background(), "0xop"); err != nil {
+		t.Fatalf("WaitForUserOperation returned an error: %v", err)
+	}
+	if fmt.Sprint(strategy.attempts) != "[1 2 3]" {
+		t.Errorf("strategy asked about attempts %v, want [1 2 3]", strategy.attempts)
+	}
+}
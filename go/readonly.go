@@ -0,0 +1,56 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrReadOnly is returned by operations that create, change or sign something
+// when the client was created with ClientOptions.ReadOnly.
+var ErrReadOnly = errors.New("client is read-only")
+
+// readOnlyPostOperations are the POST operations that only read, and are allowed
+// on read-only clients.
+var readOnlyPostOperations = map[string]bool{
+	"RunSQLQuery":                true,
+	"ValidateEndUserAccessToken": true,
+	"CreateEvmSwapQuote":         true,
+	"GetOnrampUserLimits":        true,
+	"PostX402DiscoveryMcp":       true,
+	"ValidateX402Resource":       true,
+	"VerifyX402Payment":          true,
+}
+
+// checkWritable returns ErrReadOnly if the client is read-only. Write operations
+// that do other work before calling the API, such as reading chain state, call
+// it first, so that they fail before doing anything.
+func (c *Client) checkWritable() error {
+	if c.options.ReadOnly {
+		return ErrReadOnly
+	}
+	return nil
+}
+
+// readOnlyFn rejects API requests that may write with ErrReadOnly, so that they
+// are never sent. Requests other than GET, HEAD and OPTIONS may write, apart from
+// the operations in readOnlyPostOperations. It must run after operationIDFn.
+func readOnlyFn() openapi.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return nil
+		}
+		operation, ok := OperationID(req.Context())
+		if ok && req.Method == http.MethodPost && readOnlyPostOperations[operation] {
+			return nil
+		}
+		if !ok {
+			operation = req.URL.Path
+		}
+		return fmt.Errorf("%w: refusing %s %s", ErrReadOnly, req.Method, operation)
+	}
+}
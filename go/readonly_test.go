@@ -0,0 +1,83 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestReadOnlyClient(t *testing.T) {
+	var writes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet:
+			fmt.Fprintf(w, `{"address":%q}`, testOwner)
+		case r.URL.Path == "/v2/data/query/run":
+			fmt.Fprint(w, `{"result":[]}`)
+		default:
+			writes.Add(1)
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{ReadOnly: true})
+	account := &EvmAccount{client: client, Address: testOwner}
+	smartAccount := &SmartAccount{client: client, Address: testOwner}
+	ctx := context.Background()
+
+	writeTests := []struct {
+		name string
+		call func() error
+	}{
+		{"create account", func() error {
+			_, err := client.CreateEvmAccountWithResponse(ctx, nil, openapi.CreateEvmAccountJSONRequestBody{})
+			return err
+		}},
+		{"delete policy", func() error {
+			_, err := client.DeletePolicyWithResponse(ctx, "policy", nil)
+			return err
+		}},
+		{"sign message", func() error {
+			_, err := account.SignMessage(ctx, []byte("hello"))
+			return err
+		}},
+		{"transfer", func() error {
+			_, err := account.UseNetwork("base-sepolia").Transfer(ctx, testRecipient, big.NewInt(1), "eth")
+			return err
+		}},
+		{"user operation", func() error {
+			_, err := smartAccount.SendUserOperation(ctx, []openapi.EvmCall{{To: testRecipient, Value: "1", Data: "0x"}}, "base-sepolia", UserOperationOptions{})
+			return err
+		}},
+		{"faucet", func() error {
+			_, err := client.RequestFaucet(ctx, FaucetRequest{Address: testOwner, Network: "base-sepolia", Token: "eth"})
+			return err
+		}},
+	}
+	for _, tt := range writeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrReadOnly) {
+				t.Errorf("expected ErrReadOnly, got %v", err)
+			}
+		})
+	}
+	if n := writes.Load(); n != 0 {
+		t.Errorf("expected no write requests to be sent, got %d", n)
+	}
+
+	if _, err := client.GetEvmAccount(ctx, testOwner); err != nil {
+		t.Errorf("expected reads to be allowed, got %v", err)
+	}
+	resp, err := client.RunSQLQueryWithResponse(ctx, openapi.RunSQLQueryJSONRequestBody{Sql: "SELECT 1"})
+	if err != nil || resp.StatusCode() != http.StatusOK {
+		t.Errorf("expected read-only POST operations to be allowed, got %v", err)
+	}
+}
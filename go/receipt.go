@@ -0,0 +1,383 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrTransactionReverted is returned when a transaction is included in a block
+// but its execution reverted.
+var ErrTransactionReverted = errors.New("transaction reverted")
+
+// ErrTransactionDropped is returned when a pending transaction disappears from
+// the network without being included in a block.
+var ErrTransactionDropped = errors.New("transaction dropped")
+
+// ErrTransactionReplaced is returned when a pending transaction's nonce is used by
+// another transaction from the same sender, such as a speed-up or a cancellation,
+// so it can no longer be included.
+var ErrTransactionReplaced = errors.New("transaction replaced")
+
+// receiptPollInterval is how often WaitForTransactionReceipt polls for a receipt
+// by default.
+var receiptPollInterval = time.Second
+
+// receiptDroppedPolls is the number of consecutive polls a transaction that was
+// seen pending must be missing from the RPC node for WaitForTransactionReceipt to
+// report it dropped, so that a load-balanced node that has not seen it yet is not
+// mistaken for a drop. The same number of consecutive polls must find its nonce
+// used for it to be reported replaced, so that a node briefly ahead of, or on a
+// different fork from, the one that returned the transaction is not mistaken for
+// a replacement.
+const receiptDroppedPolls = 3
+
+// TransactionReceipt is the receipt of a transaction included in a block.
+type TransactionReceipt struct {
+	// TransactionHash is the hash of the transaction.
+	TransactionHash string
+	// BlockHash is the hash of the block the transaction was included in.
+	BlockHash string
+	// BlockNumber is the number of the block the transaction was included in.
+	BlockNumber uint64
+	// Status is 1 if the transaction succeeded and 0 if it reverted.
+	Status uint64
+	// From is the address of the sender.
+	From string
+	// To is the address of the recipient; empty for contract deployments.
+	To string
+	// ContractAddress is the address of the deployed contract, for deployments.
+	ContractAddress string
+	// GasUsed is the amount of gas used by the transaction.
+	GasUsed *big.Int
+	// EffectiveGasPrice is the price per gas paid by the transaction, in wei.
+	EffectiveGasPrice *big.Int
+	// Logs are the logs emitted by the transaction.
+	Logs []ReceiptLog
+	// Confirmations is the number of blocks the transaction was included in,
+	// counting its own block, when WaitForTransactionReceipt returned it. It is 1
+	// when waiting for inclusion only, and zero for receipts returned by
+	// GetTransactionReceipt.
+	Confirmations uint64
+}
+
+// ReceiptLog is a log emitted by a transaction.
+type ReceiptLog struct {
+	// Address is the address of the contract that emitted the log.
+	Address string
+	// Topics are the indexed topics of the log.
+	Topics []string
+	// Data is the 0x-prefixed non-indexed data of the log.
+	Data string
+}
+
+// TransactionRevertedError is returned when a transaction reverted. It matches
+// ErrTransactionReverted with errors.Is.
+type TransactionRevertedError struct {
+	// Receipt is the receipt of the reverted transaction.
+	Receipt *TransactionReceipt
+}
+
+// Error implements the error interface.
+func (e *TransactionRevertedError) Error() string {
+	return fmt.Sprintf("transaction %s reverted in block %d", e.Receipt.TransactionHash, e.Receipt.BlockNumber)
+}
+
+// Is reports whether target is ErrTransactionReverted.
+func (e *TransactionRevertedError) Is(target error) bool {
+	return target == ErrTransactionReverted
+}
+
+// ReceiptTimeoutError is returned when WaitForTransactionReceipt times out before
+// the transaction is confirmed. It matches context.DeadlineExceeded with
+// errors.Is.
+type ReceiptTimeoutError struct {
+	// TransactionHash is the hash of the transaction.
+	TransactionHash string
+	// Timeout is how long the wait lasted.
+	Timeout time.Duration
+	// Receipt is the receipt of the transaction if it was included but not yet
+	// confirmed enough times, with its Confirmations as last observed, and nil if
+	// it was not included.
+	Receipt *TransactionReceipt
+}
+
+// Error implements the error interface.
+func (e *ReceiptTimeoutError) Error() string {
+	if e.Receipt != nil {
+		return fmt.Sprintf("transaction %s has %d confirmations after %s", e.TransactionHash, e.Receipt.Confirmations, e.Timeout)
+	}
+	return fmt.Sprintf("transaction %s was not included after %s", e.TransactionHash, e.Timeout)
+}
+
+// Is reports whether target is context.DeadlineExceeded.
+func (e *ReceiptTimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// rpcReceipt is the JSON-RPC encoding of a transaction receipt.
+type rpcReceipt struct {
+	TransactionHash   string  `json:"transactionHash"`
+	BlockHash         string  `json:"blockHash"`
+	BlockNumber       string  `json:"blockNumber"`
+	Status            string  `json:"status"`
+	From              string  `json:"from"`
+	To                *string `json:"to"`
+	ContractAddress   *string `json:"contractAddress"`
+	GasUsed           string  `json:"gasUsed"`
+	EffectiveGasPrice string  `json:"effectiveGasPrice"`
+	Logs              []struct {
+		Address string   `json:"address"`
+		Topics  []string `json:"topics"`
+		Data    string   `json:"data"`
+	} `json:"logs"`
+}
+
+// GetTransactionReceipt returns the receipt of the transaction with the given hash
+// on network, or nil if the transaction has not been included in a block yet.
+func (c *Client) GetTransactionReceipt(ctx context.Context, network, txHash string) (*TransactionReceipt, error) {
+	network = c.networkOrDefault(network)
+	var raw *rpcReceipt
+	if err := c.rpcCall(ctx, network, &raw, "eth_getTransactionReceipt", txHash); err != nil {
+		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	// A missing or malformed status must not read as a revert, so the fields the
+	// receipt is judged by are parsed strictly. The gas fields are informational,
+	// and some chains omit the effective gas price.
+	blockNumber, err := parseHexBigInt("blockNumber", raw.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transaction receipt: %w", err)
+	}
+	status, err := parseHexBigInt("status", raw.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transaction receipt: %w", err)
+	}
+
+	receipt := &TransactionReceipt{
+		TransactionHash:   raw.TransactionHash,
+		BlockHash:         raw.BlockHash,
+		BlockNumber:       blockNumber.Uint64(),
+		Status:            status.Uint64(),
+		From:              raw.From,
+		GasUsed:           hexToBigInt(raw.GasUsed),
+		EffectiveGasPrice: hexToBigInt(raw.EffectiveGasPrice),
+	}
+	if raw.To != nil {
+		receipt.To = *raw.To
+	}
+	if raw.ContractAddress != nil {
+		receipt.ContractAddress = *raw.ContractAddress
+	}
+	for _, log := range raw.Logs {
+		receipt.Logs = append(receipt.Logs, ReceiptLog{Address: log.Address, Topics: log.Topics, Data: log.Data})
+	}
+	return receipt, nil
+}
+
+// defaultReceiptTimeout returns how long WaitForTransactionReceipt waits on network
+// when its context has no deadline: 20 times EstimatedConfirmationTime, and at
+// least a minute.
+func defaultReceiptTimeout(network string) time.Duration {
+	return max(20*EstimatedConfirmationTime(network), time.Minute)
+}
+
+// ReceiptOptions configures WaitForTransactionReceipt.
+type ReceiptOptions struct {
+	// Confirmations is the number of blocks the transaction must be included in,
+	// counting its own block, before the receipt is returned. Zero and one return
+	// as soon as the transaction is included.
+	Confirmations int
+	// PollInterval is how often to poll for the receipt. Defaults to a second.
+	PollInterval time.Duration
+	// Timeout is how long to wait. Defaults to a timeout based on the network's
+	// EstimatedConfirmationTime if ctx has no deadline.
+	Timeout time.Duration
+}
+
+// WaitForTransactionReceipt polls until the transaction with the given hash is
+// included in a block on network, opts.Confirmations blocks deep, and returns its
+// receipt with the confirmations observed. The receipt is fetched again on every
+// poll, so if a reorg drops the transaction or moves it to another block, the
+// wait continues until it is deep enough in the new chain. If the transaction
+// reverted, the receipt is returned along with a *TransactionRevertedError as soon
+// as it is included.
+//
+// A pending transaction is watched until it is included: if it disappears from
+// the network, the wait fails with ErrTransactionDropped, and if another
+// transaction from its sender uses its nonce, with ErrTransactionReplaced.
+//
+// It returns ctx.Err() if ctx is done first. If opts.Timeout passes first, or,
+// when opts.Timeout is zero and ctx has no deadline, a timeout based on the
+// network's EstimatedConfirmationTime (2 minutes on Base, 12 minutes on
+// Ethereum), it returns a *ReceiptTimeoutError, which matches
+// context.DeadlineExceeded; waiting for many confirmations needs a longer
+// timeout.
+func (c *Client) WaitForTransactionReceipt(ctx context.Context, network, txHash string, opts ReceiptOptions) (*TransactionReceipt, error) {
+	network = c.networkOrDefault(network)
+	timeout := opts.Timeout
+	if _, ok := ctx.Deadline(); !ok && timeout <= 0 {
+		timeout = defaultReceiptTimeout(network)
+	}
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = receiptPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var pending pendingTransaction
+	var last *TransactionReceipt
+	for {
+		receipt, err := c.waitPoll(waitCtx, network, txHash, opts, &pending)
+		if err != nil {
+			if ctx.Err() == nil && waitCtx.Err() != nil {
+				return nil, &ReceiptTimeoutError{TransactionHash: txHash, Timeout: timeout, Receipt: last}
+			}
+			return nil, err
+		}
+		if receipt != nil {
+			if receipt.Status == 0 {
+				return receipt, &TransactionRevertedError{Receipt: receipt}
+			}
+			if receipt.Confirmations >= uint64(max(opts.Confirmations, 1)) {
+				return receipt, nil
+			}
+		}
+		last = receipt
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, &ReceiptTimeoutError{TransactionHash: txHash, Timeout: timeout, Receipt: last}
+		case <-ticker.C:
+		}
+	}
+}
+
+// pendingTransaction is what WaitForTransactionReceipt knows about a transaction
+// that has not been included yet.
+type pendingTransaction struct {
+	// seen reports whether the RPC node has returned the transaction, with its
+	// sender and nonce.
+	seen  bool
+	from  string
+	nonce uint64
+	// missing counts the consecutive polls the transaction was not found in after
+	// it was seen, and replaced those of them that found its nonce used.
+	missing  int
+	replaced int
+}
+
+// waitPoll polls the transaction with the given hash once for
+// WaitForTransactionReceipt. It returns the receipt with its confirmations set, or
+// nil if the transaction is not included yet.
+func (c *Client) waitPoll(ctx context.Context, network, txHash string, opts ReceiptOptions, pending *pendingTransaction) (*TransactionReceipt, error) {
+	receipt, err := c.GetTransactionReceipt(ctx, network, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt != nil {
+		pending.missing, pending.replaced = 0, 0
+		receipt.Confirmations = 1
+		if receipt.Status == 0 || opts.Confirmations <= 1 {
+			return receipt, nil
+		}
+		head, err := c.blockNumber(ctx, network)
+		if err != nil {
+			return nil, err
+		}
+		if head >= receipt.BlockNumber {
+			receipt.Confirmations = head - receipt.BlockNumber + 1
+		}
+		return receipt, nil
+	}
+
+	var tx *struct {
+		From  string `json:"from"`
+		Nonce string `json:"nonce"`
+	}
+	if err := c.rpcCall(ctx, network, &tx, "eth_getTransactionByHash", txHash); err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if tx != nil {
+		*pending = pendingTransaction{seen: true, from: tx.From, nonce: hexToBigInt(tx.Nonce).Uint64()}
+		return nil, nil
+	}
+	if !pending.seen {
+		// The transaction may not have reached this node yet.
+		return nil, nil
+	}
+
+	var count string
+	if err := c.rpcCall(ctx, network, &count, "eth_getTransactionCount", pending.from, "latest"); err != nil {
+		return nil, fmt.Errorf("failed to get transaction count: %w", err)
+	}
+	pending.missing++
+	if hexToBigInt(count).Uint64() > pending.nonce {
+		pending.replaced++
+	} else {
+		pending.replaced = 0
+	}
+	switch {
+	case pending.replaced >= receiptDroppedPolls:
+		// The nonce is used. Check that the transaction was not included since the
+		// receipt was fetched.
+		receipt, err := c.GetTransactionReceipt(ctx, network, txHash)
+		if err != nil || receipt != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: nonce %d of %s was used by another transaction than %s", ErrTransactionReplaced, pending.nonce, pending.from, txHash)
+	case pending.replaced == 0 && pending.missing >= receiptDroppedPolls:
+		return nil, fmt.Errorf("%w: %s is no longer known to the network", ErrTransactionDropped, txHash)
+	}
+	return nil, nil
+}
+
+// blockNumber returns the number of the latest block on network.
+func (c *Client) blockNumber(ctx context.Context, network string) (uint64, error) {
+	var number string
+	if err := c.rpcCall(ctx, network, &number, "eth_blockNumber"); err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+	n, err := parseHexBigInt("block number", number)
+	if err != nil {
+		return 0, err
+	}
+	return n.Uint64(), nil
+}
+
+// parseHexBigInt parses the 0x-prefixed hex quantity s, returning an error naming
+// the field if it is empty, malformed, or does not fit in a uint64.
+func parseHexBigInt(field, s string) (*big.Int, error) {
+	digits, ok := strings.CutPrefix(s, "0x")
+	n, valid := new(big.Int).SetString(digits, 16)
+	if !ok || !valid || n.Sign() < 0 || !n.IsUint64() {
+		return nil, fmt.Errorf("invalid %s %q", field, s)
+	}
+	return n, nil
+}
+
+// hexToBigInt parses a 0x-prefixed hex quantity, returning zero if it is empty or
+// malformed. Use parseHexBigInt where a malformed value must not read as zero.
+func hexToBigInt(s string) *big.Int {
+	n, ok := new(big.Int).SetString(strings.TrimPrefix(s, "0x"), 16)
+	if !ok {
+		return new(big.Int)
+	}
+	return n
+}
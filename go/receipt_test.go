@@ -0,0 +1,276 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testTxHash = "0x5e1d0b4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c"
+
+// pendingTransactionResult is the eth_getTransactionByHash result for a pending
+// transaction.
+var pendingTransactionResult = fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"hash":%q,"from":%q,"nonce":"0x7","blockNumber":null}}`, testTxHash, testOwner)
+
+// newReceiptRPCServer serves eth_getTransactionReceipt, returning null for the
+// first pending polls and then a receipt with the given status. The transaction
+// is pending until then.
+func newReceiptRPCServer(t *testing.T, pending int32, status string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method == "eth_getTransactionByHash" {
+			fmt.Fprint(w, pendingTransactionResult)
+			return
+		}
+		if req.Method != "eth_getTransactionReceipt" {
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+
+		if polls.Add(1) <= pending {
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":null}`)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{
+			"transactionHash":%q,
+			"blockHash":"0xb10c",
+			"blockNumber":"0x1b4",
+			"status":%q,
+			"from":%q,
+			"to":%q,
+			"contractAddress":null,
+			"gasUsed":"0x5208",
+			"effectiveGasPrice":"0x3b9aca00",
+			"logs":[{"address":%q,"topics":["0xddf2"],"data":"0x"}]
+		}}`, req.Params[0], status, testOwner, testRecipient, testRecipient)
+	}))
+	t.Cleanup(server.Close)
+	return server, &polls
+}
+
+func TestWaitForTransactionReceipt(t *testing.T) {
+	setFastTimers(t)
+	rpc, polls := newReceiptRPCServer(t, 2, "0x1")
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+	receipt, err := client.WaitForTransactionReceipt(context.Background(), "base-sepolia", testTxHash, ReceiptOptions{})
+	if err != nil {
+		t.Fatalf("WaitForTransactionReceipt returned an error: %v", err)
+	}
+	if polls.Load() != 3 {
+		t.Errorf("polled %d times, want 3", polls.Load())
+	}
+	if receipt.TransactionHash != testTxHash || receipt.BlockNumber != 436 || receipt.Status != 1 {
+		t.Errorf("unexpected receipt %+v", receipt)
+	}
+	if receipt.GasUsed.Int64() != 21000 || receipt.EffectiveGasPrice.Int64() != 1e9 {
+		t.Errorf("unexpected gas fields %s, %s", receipt.GasUsed, receipt.EffectiveGasPrice)
+	}
+	if receipt.ContractAddress != "" || len(receipt.Logs) != 1 || receipt.Logs[0].Topics[0] != "0xddf2" {
+		t.Errorf("unexpected receipt %+v", receipt)
+	}
+}
+
+func TestWaitForTransactionReceiptReverted(t *testing.T) {
+	setFastTimers(t)
+	rpc, _ := newReceiptRPCServer(t, 0, "0x0")
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+	receipt, err := client.WaitForTransactionReceipt(context.Background(), "base-sepolia", testTxHash, ReceiptOptions{})
+
+	var revertErr *TransactionRevertedError
+	if !errors.As(err, &revertErr) || !errors.Is(err, ErrTransactionReverted) {
+		t.Fatalf("expected *TransactionRevertedError, got %v", err)
+	}
+	if receipt == nil || revertErr.Receipt != receipt {
+		t.Error("expected the receipt to be returned with the error")
+	}
+}
+
+func TestGetTransactionReceiptInvalidStatus(t *testing.T) {
+	for _, status := range []string{"", "0xzz", "1"} {
+		t.Run(status, func(t *testing.T) {
+			rpc, _ := newReceiptRPCServer(t, 0, status)
+			client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+			receipt, err := client.GetTransactionReceipt(context.Background(), "base-sepolia", testTxHash)
+			if err == nil || errors.Is(err, ErrTransactionReverted) {
+				t.Errorf("expected a parse error for status %q, got %+v, %v", status, receipt, err)
+			}
+		})
+	}
+}
+
+// newChainRPCServer simulates a chain whose head advances by one block on every
+// eth_blockNumber call, starting at block 10. blocks lists the block the
+// transaction is in on each receipt poll, with 0 for not included; the last entry
+// repeats.
+func newChainRPCServer(t *testing.T, blocks []uint64) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var head, polls atomic.Int32
+	head.Store(9)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch req.Method {
+		case "eth_blockNumber":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, head.Add(1))
+		case "eth_getTransactionReceipt":
+			block := blocks[min(int(polls.Add(1))-1, len(blocks)-1)]
+			if block == 0 {
+				fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":null}`)
+				return
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":%q,"blockHash":"0x%x","blockNumber":"0x%x","status":"0x1","from":%q,"to":%q,"gasUsed":"0x5208","effectiveGasPrice":"0x1","logs":[]}}`,
+				testTxHash, block, block, testOwner, testRecipient)
+		case "eth_getTransactionByHash":
+			fmt.Fprint(w, pendingTransactionResult)
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &polls
+}
+
+func TestWaitForTransactionReceiptConfirmations(t *testing.T) {
+	setFastTimers(t)
+
+	tests := []struct {
+		name          string
+		blocks        []uint64
+		confirmations int
+		wantBlock     uint64
+		wantPolls     int32
+	}{
+		// The head is 10, 11, 12 on the polls after inclusion.
+		{"waits for depth", []uint64{0, 10}, 3, 10, 4},
+		{"one confirmation", []uint64{0, 10}, 1, 10, 2},
+		// Reorged out on the third poll and re-included in block 12; the head
+		// reaches 14 on the sixth poll.
+		{"reorg", []uint64{10, 10, 0, 12}, 3, 12, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc, polls := newChainRPCServer(t, tt.blocks)
+			client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+			receipt, err := client.WaitForTransactionReceipt(context.Background(), "base-sepolia", testTxHash, ReceiptOptions{Confirmations: tt.confirmations})
+			if err != nil {
+				t.Fatalf("WaitForTransactionReceipt returned an error: %v", err)
+			}
+			if receipt.BlockNumber != tt.wantBlock {
+				t.Errorf("BlockNumber = %d, want %d", receipt.BlockNumber, tt.wantBlock)
+			}
+			if receipt.Confirmations < uint64(max(tt.confirmations, 1)) {
+				t.Errorf("Confirmations = %d, want at least %d", receipt.Confirmations, tt.confirmations)
+			}
+			if polls.Load() != tt.wantPolls {
+				t.Errorf("polled %d times, want %d", polls.Load(), tt.wantPolls)
+			}
+		})
+	}
+}
+
+// newDisappearingRPCServer serves a transaction that is never included and is
+// pending on the first eth_getTransactionByHash call only. The sender's
+// transaction count is counts[i] on the i-th eth_getTransactionCount call, and
+// the last of counts after that.
+func newDisappearingRPCServer(t *testing.T, counts ...string) *httptest.Server {
+	t.Helper()
+	var lookups, countCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch req.Method {
+		case "eth_getTransactionReceipt":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":null}`)
+		case "eth_getTransactionByHash":
+			if lookups.Add(1) == 1 {
+				fmt.Fprint(w, pendingTransactionResult)
+				return
+			}
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":null}`)
+		case "eth_getTransactionCount":
+			if req.Params[0] != testOwner || req.Params[1] != "latest" {
+				t.Errorf("unexpected eth_getTransactionCount params %v", req.Params)
+			}
+			i := min(int(countCalls.Add(1)), len(counts)) - 1
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, counts[i])
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWaitForTransactionReceiptDroppedOrReplaced(t *testing.T) {
+	tests := []struct {
+		name    string
+		counts  []string
+		wantErr error
+	}{
+		// The pending transaction has nonce 7.
+		{"dropped", []string{"0x7"}, ErrTransactionDropped},
+		{"replaced", []string{"0x8"}, ErrTransactionReplaced},
+		{"nonce used on one poll only", []string{"0x8", "0x7"}, ErrTransactionDropped},
+		{"nonce used after a lagging poll", []string{"0x7", "0x8"}, ErrTransactionReplaced},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := newDisappearingRPCServer(t, tt.counts...)
+			client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+			_, err := client.WaitForTransactionReceipt(context.Background(), "base-sepolia", testTxHash, ReceiptOptions{PollInterval: time.Millisecond})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWaitForTransactionReceiptTimeout(t *testing.T) {
+	rpc, _ := newChainRPCServer(t, []uint64{0, 10})
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+	receipt, err := client.WaitForTransactionReceipt(context.Background(), "base-sepolia", testTxHash, ReceiptOptions{
+		Confirmations: 1000,
+		PollInterval:  time.Millisecond,
+		Timeout:       50 * time.Millisecond,
+	})
+	var timeoutErr *ReceiptTimeoutError
+	if !errors.As(err, &timeoutErr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected *ReceiptTimeoutError, got %v", err)
+	}
+	if receipt != nil || timeoutErr.TransactionHash != testTxHash || timeoutErr.Timeout != 50*time.Millisecond {
+		t.Errorf("unexpected timeout error %+v", timeoutErr)
+	}
+	if timeoutErr.Receipt == nil || timeoutErr.Receipt.BlockNumber != 10 || timeoutErr.Receipt.Confirmations < 2 {
+		t.Errorf("expected the last receipt with its confirmations, got %+v", timeoutErr.Receipt)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.WaitForTransactionReceipt(ctx, "base-sepolia", testTxHash, ReceiptOptions{Timeout: time.Minute}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled when the caller cancels, got %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package cdp
+
+import (
+	"errors"
+	"net/http"
+)
+
+// maxRedirects is the number of redirects followed per request, as by Go's
+// default redirect policy.
+const maxRedirects = 10
+
+// checkRedirectFn returns the client's redirect policy. Redirects to another host
+// are not followed unless options.AllowCrossHostRedirects is set, in which case
+// the request's credentials are dropped before following them.
+func checkRedirectFn(options ClientOptions) func(*http.Request, []*http.Request) error {
+	walletHeader := walletAuthHeaderName(options)
+	return func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return errors.New("stopped after 10 redirects")
+		}
+		if req.URL.Host == via[0].URL.Host {
+			return nil
+		}
+		if !options.AllowCrossHostRedirects {
+			return http.ErrUseLastResponse
+		}
+		req.Header.Del("Authorization")
+		req.Header.Del(walletHeader)
+		return nil
+	}
+}
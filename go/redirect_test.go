@@ -0,0 +1,68 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// newRedirectServers returns a server that redirects account lookups to other,
+// which serves them, and the number of requests other received with credentials.
+func newRedirectServers(t *testing.T) (redirecting, other *httptest.Server, hits, credentialed *atomic.Int32) {
+	t.Helper()
+	hits, credentialed = new(atomic.Int32), new(atomic.Int32)
+	other = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.Header.Get("Authorization") != "" || r.Header.Get("X-Wallet-Auth") != "" {
+			credentialed.Add(1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}))
+	t.Cleanup(other.Close)
+
+	redirecting = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("moved") == "" {
+			http.Redirect(w, r, r.URL.Path+"?moved=1", http.StatusTemporaryRedirect)
+			return
+		}
+		http.Redirect(w, r, other.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	t.Cleanup(redirecting.Close)
+	return redirecting, other, hits, credentialed
+}
+
+func TestCrossHostRedirectsAreNotFollowed(t *testing.T) {
+	redirecting, _, hits, _ := newRedirectServers(t)
+	client := newTestClient(t, redirecting.URL)
+
+	resp, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusTemporaryRedirect {
+		t.Errorf("expected the cross-host redirect response, got %d", resp.StatusCode())
+	}
+	if n := hits.Load(); n != 0 {
+		t.Errorf("expected the other host not to be contacted, got %d requests", n)
+	}
+}
+
+func TestCrossHostRedirectsCanBeAllowed(t *testing.T) {
+	redirecting, _, hits, credentialed := newRedirectServers(t)
+	client := newTestClientWithOptions(t, redirecting.URL, ClientOptions{
+		AllowCrossHostRedirects: true,
+		WalletSecret:            generateTestWalletSecret(t),
+	})
+
+	resp, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if err != nil || resp.StatusCode() != http.StatusOK {
+		t.Fatalf("expected the redirect to be followed, got %v", err)
+	}
+	if hits.Load() != 1 || credentialed.Load() != 0 {
+		t.Errorf("expected one request without credentials, got %d requests, %d with credentials", hits.Load(), credentialed.Load())
+	}
+}
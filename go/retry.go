@@ -0,0 +1,312 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+)
+
+// defaultMaxRetries is the number of times a request is retried when neither
+// RetryOptions.MaxRetries nor ClientOptions.MaxRetries is set.
+const defaultMaxRetries = 3
+
+// retryBaseDelay is the delay before the first retry; it doubles on each attempt
+// up to retryMaxDelay. They apply when RetryOptions.BaseDelay and
+// RetryOptions.MaxDelay are zero.
+var (
+	retryBaseDelay = 250 * time.Millisecond
+	retryMaxDelay  = 5 * time.Second
+)
+
+// RetryOptions configures how the client retries requests that fail with a
+// transient error.
+type RetryOptions struct {
+	// MaxRetries is the maximum number of times a request is retried. Zero uses
+	// ClientOptions.MaxRetries, or the default of 3 if that is zero too; a
+	// negative value disables retries.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry. It doubles with each retry
+	// up to MaxDelay, and each delay is randomized by up to half its length so
+	// that clients failing together don't retry together. Defaults to 250ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts. Defaults to 5 seconds. A
+	// Retry-After header on the response takes precedence over the backoff if it
+	// is no longer than MaxDelay; if it is longer, the response is returned
+	// instead of being retried.
+	MaxDelay time.Duration
+	// RetryableStatus reports whether a response with the given status code is
+	// retried. Nil uses DefaultRetryableStatus. It is not consulted if
+	// ClientOptions.RetryPredicate is set.
+	RetryableStatus func(statusCode int) bool
+	// RetryNonIdempotent also retries POST and PATCH requests that carry no
+	// X-Idempotency-Key header. By default they are not retried, since the
+	// server may have acted on a request whose response was lost, and resending
+	// it could, for example, send a transaction twice.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryableStatus reports whether a response status is transient: 429 Too
+// Many Requests, 500 Internal Server Error, and the 502, 503 and 504 gateway
+// errors.
+func DefaultRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// DefaultRetryPredicate is the retry policy used when ClientOptions.RetryPredicate
+// is nil and RetryOptions.RetryableStatus is not set. It retries network errors
+// other than cancellation, and the statuses accepted by DefaultRetryableStatus.
+//
+// Custom predicates can build on it, for example to also retry a specific error
+// that is known to be transient, or to never retry a particular operation.
+func DefaultRetryPredicate(resp *http.Response, err error) bool {
+	if err != nil {
+		return isRetryableError(err)
+	}
+	return DefaultRetryableStatus(resp.StatusCode)
+}
+
+// isRetryableError reports whether a transport error may succeed on retry, which
+// is the case for all but cancellation and client shutdown.
+func isRetryableError(err error) bool {
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, ErrClientClosed)
+}
+
+// retryPredicate returns the predicate the client retries requests with.
+func retryPredicate(options ClientOptions) func(*http.Response, error) bool {
+	if options.RetryPredicate != nil {
+		return options.RetryPredicate
+	}
+	retryable := options.RetryOptions.RetryableStatus
+	if retryable == nil {
+		return DefaultRetryPredicate
+	}
+	return func(resp *http.Response, err error) bool {
+		if err != nil {
+			return isRetryableError(err)
+		}
+		return retryable(resp.StatusCode)
+	}
+}
+
+// idempotentRequestKey marks a request context as carrying an idempotent request
+// regardless of its method, such as a JSON-RPC read.
+type idempotentRequestKey struct{}
+
+// withIdempotentRequest marks requests made with ctx as safe to retry.
+func withIdempotentRequest(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentRequestKey{}, true)
+}
+
+// isIdempotentRequest reports whether req can be sent more than once without
+// changing its effect.
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	if req.Header.Get("X-Idempotency-Key") != "" {
+		return true
+	}
+	idempotent, _ := req.Context().Value(idempotentRequestKey{}).(bool)
+	return idempotent
+}
+
+// retryAfter returns the delay requested by resp's Retry-After header, or zero if
+// there is none.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	return parseRetryAfter(resp.Header.Get("Retry-After"))
+}
+
+// jitter returns a random delay between half of d and d.
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryTransport retries requests for which predicate returns true, with
+// exponential backoff between attempts.
+type retryTransport struct {
+	next       http.RoundTripper
+	predicate  func(*http.Response, error) bool
+	maxRetries int
+	// baseDelay and maxDelay bound the backoff; zero uses retryBaseDelay and
+	// retryMaxDelay.
+	baseDelay time.Duration
+	maxDelay  time.Duration
+	// retryNonIdempotent retries requests that isIdempotentRequest rejects.
+	retryNonIdempotent bool
+	// refreshAuth regenerates the request's auth headers before each retry, since
+	// request editors only run once per request.
+	refreshAuth func(*http.Request) error
+	// stats records bytes sent and retries if ClientOptions.TrackStats is set.
+	stats *usageStats
+}
+
+// newRetryTransport returns a retryTransport configured from options.
+func newRetryTransport(next http.RoundTripper, options ClientOptions) *retryTransport {
+	t := &retryTransport{
+		next:               next,
+		predicate:          retryPredicate(options),
+		maxRetries:         options.RetryOptions.MaxRetries,
+		baseDelay:          options.RetryOptions.BaseDelay,
+		maxDelay:           options.RetryOptions.MaxDelay,
+		retryNonIdempotent: options.RetryOptions.RetryNonIdempotent,
+		refreshAuth:        refreshAuthFn(options),
+	}
+	if t.maxRetries == 0 {
+		t.maxRetries = options.MaxRetries
+	}
+	if t.maxRetries == 0 {
+		t.maxRetries = defaultMaxRetries
+	}
+	return t
+}
+
+// delays returns the delay before the first retry and the maximum delay.
+func (t *retryTransport) delays() (time.Duration, time.Duration) {
+	base, maxDelay := t.baseDelay, t.maxDelay
+	if base == 0 {
+		base = retryBaseDelay
+	}
+	if maxDelay == 0 {
+		maxDelay = retryMaxDelay
+	}
+	return min(base, maxDelay), maxDelay
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	delay, maxDelay := t.delays()
+	idempotent := t.retryNonIdempotent || isIdempotentRequest(req)
+	var counters *operationCounters
+	if t.stats != nil {
+		counters = t.stats.counters(req)
+	}
+	for attempt := 0; ; attempt++ {
+		if counters != nil && req.ContentLength > 0 {
+			counters.bytesSent.Add(req.ContentLength)
+		}
+		resp, err := t.next.RoundTrip(req)
+
+		// Requests whose body cannot be replayed are never retried.
+		canReplay := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		retry := canReplay && idempotent && t.predicate(resp, err)
+		if attempt >= t.maxRetries || !retry {
+			if err != nil {
+				err = newRequestError(req, err, start, attempt+1, retry)
+			}
+			return resp, err
+		}
+
+		wait := retryAfter(resp)
+		if wait > maxDelay {
+			// The server asked for a longer wait than the caller allows.
+			return resp, nil
+		}
+		if wait == 0 {
+			wait = jitter(delay)
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, newRequestError(req, req.Context().Err(), start, attempt+1, false)
+		case <-timer.C:
+		}
+		delay = min(2*delay, maxDelay)
+
+		req = req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		if err := t.refreshAuth(req); err != nil {
+			return nil, err
+		}
+		if counters != nil {
+			counters.retries.Add(1)
+		}
+	}
+}
+
+// refreshAuthFn returns a function that regenerates the auth headers already
+// present on a request. Each attempt of a retried request then carries fresh JWTs,
+// so wallet JWTs get a new jti (replay protection rejects a reused one) and
+// neither JWT expires during backoff. Headers are only regenerated if the
+// original attempt had them, so requests to other hosts (e.g. JSON-RPC nodes)
+// never receive CDP credentials.
+func refreshAuthFn(options ClientOptions) func(*http.Request) error {
+	apiKeyAuth, walletAuth := apiKeyHeaderFn(options), walletHeaderFn(options)
+	walletHeader := walletAuthHeaderName(options)
+	return func(req *http.Request) error {
+		if req.Header.Get("Authorization") != "" {
+			if err := apiKeyAuth(req.Context(), req); err != nil {
+				return err
+			}
+		}
+		if req.Header.Get(walletHeader) != "" {
+			if err := walletAuth(req.Context(), req); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// jwtGenerationAttempts is the number of times JWT generation is attempted when
+// reading randomness fails.
+const jwtGenerationAttempts = 3
+
+// authGenerateJWT and authGenerateWalletJWT generate JWTs; tests replace them to
+// inject failures.
+var (
+	authGenerateJWT       = auth.GenerateJWT
+	authGenerateWalletJWT = auth.GenerateWalletJWT
+)
+
+// generateJWT generates an API key JWT, retrying transient randomness failures.
+func generateJWT(options auth.JwtOptions) (string, error) {
+	return retryJWTGeneration(func() (string, error) { return authGenerateJWT(options) })
+}
+
+// generateWalletJWT generates a wallet JWT, retrying transient randomness failures.
+func generateWalletJWT(options auth.WalletJwtOptions) (string, error) {
+	return retryJWTGeneration(func() (string, error) { return authGenerateWalletJWT(options) })
+}
+
+// retryJWTGeneration calls generate up to jwtGenerationAttempts times while it
+// fails with auth.ErrEntropy. Other errors, such as invalid keys, are returned
+// immediately.
+func retryJWTGeneration(generate func() (string, error)) (string, error) {
+	var err error
+	for range jwtGenerationAttempts {
+		var token string
+		if token, err = generate(); !errors.Is(err, auth.ErrEntropy) {
+			return token, err
+		}
+	}
+	return "", err
+}
@@ -0,0 +1,436 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/coinbase/cdp-sdk/go/openapi"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// newFlakyServer fails the first failures requests with the given status and
+// body, then succeeds.
+func newFlakyServer(t *testing.T, failures int32, status int, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, _ := io.ReadAll(r.Body); r.Method == http.MethodPost && len(got) == 0 {
+			t.Error("retried request was sent without its body")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) <= failures {
+			w.WriteHeader(status)
+			fmt.Fprint(w, body)
+			return
+		}
+		fmt.Fprint(w, `{"transactionHash":"0xabc"}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func requestFaucet(client *Client) (*openapi.RequestEvmFaucetResponse, error) {
+	return client.RequestEvmFaucetWithResponse(context.Background(), openapi.RequestEvmFaucetJSONRequestBody{
+		Address: testOwner,
+		Network: "base-sepolia",
+		Token:   "eth",
+	})
+}
+
+func TestDefaultRetryPredicateRetriesServiceUnavailable(t *testing.T) {
+	setFastTimers(t)
+	server, requests := newFlakyServer(t, 2, http.StatusServiceUnavailable, `{}`)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{RetryOptions: RetryOptions{RetryNonIdempotent: true}})
+
+	resp, err := requestFaucet(client)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Errorf("StatusCode = %d, want 200", resp.StatusCode())
+	}
+	if n := requests.Load(); n != 3 {
+		t.Errorf("expected 3 attempts, got %d", n)
+	}
+}
+
+func TestDefaultRetryPredicateDoesNotRetryClientErrors(t *testing.T) {
+	setFastTimers(t)
+	server, requests := newFlakyServer(t, 1, http.StatusBadRequest, `{"errorType":"invalid_request","errorMessage":"bad"}`)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+
+	resp, err := requestFaucet(client)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusBadRequest || requests.Load() != 1 {
+		t.Errorf("expected a single 400 response, got %d after %d attempts", resp.StatusCode(), requests.Load())
+	}
+}
+
+func TestCustomRetryPredicate(t *testing.T) {
+	setFastTimers(t)
+	server, requests := newFlakyServer(t, 1, http.StatusBadRequest, `{"errorType":"network_not_tradable","errorMessage":"try again"}`)
+
+	var consulted atomic.Int32
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		RetryOptions: RetryOptions{RetryNonIdempotent: true},
+		RetryPredicate: func(resp *http.Response, err error) bool {
+			consulted.Add(1)
+			if err == nil && resp.StatusCode == http.StatusBadRequest {
+				return true
+			}
+			return DefaultRetryPredicate(resp, err)
+		},
+	})
+
+	resp, err := requestFaucet(client)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK || requests.Load() != 2 {
+		t.Errorf("expected success on the second attempt, got %d after %d attempts", resp.StatusCode(), requests.Load())
+	}
+	if consulted.Load() != 2 {
+		t.Errorf("predicate consulted %d times, want once per attempt", consulted.Load())
+	}
+}
+
+func TestRetriesCanBeDisabled(t *testing.T) {
+	setFastTimers(t)
+	server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, `{}`)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{MaxRetries: -1})
+
+	resp, err := requestFaucet(client)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusServiceUnavailable || requests.Load() != 1 {
+		t.Errorf("expected a single 503 response, got %d after %d attempts", resp.StatusCode(), requests.Load())
+	}
+}
+
+func TestDefaultRetryPredicateDoesNotRetryCancellation(t *testing.T) {
+	if DefaultRetryPredicate(nil, context.Canceled) {
+		t.Error("expected context.Canceled not to be retried")
+	}
+	if DefaultRetryPredicate(nil, ErrClientClosed) {
+		t.Error("expected ErrClientClosed not to be retried")
+	}
+	if !DefaultRetryPredicate(nil, errors.New("connection reset")) {
+		t.Error("expected network errors to be retried")
+	}
+}
+
+func TestRetriesRefreshWalletJWT(t *testing.T) {
+	setFastTimers(t)
+
+	type walletClaims struct{ jti, reqHash string }
+	attempts := make(chan walletClaims, 2)
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := jwt.MapClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(r.Header.Get("X-Wallet-Auth"), claims); err != nil {
+			t.Errorf("failed to parse wallet JWT: %v", err)
+		}
+		reqHash, _ := claims["reqHash"].(string)
+		jti, _ := claims["jti"].(string)
+		attempts <- walletClaims{jti: jti, reqHash: reqHash}
+
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}))
+	defer server.Close()
+
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{WalletSecret: generateTestWalletSecret(t), RetryOptions: RetryOptions{RetryNonIdempotent: true}})
+	name := "retried"
+	if _, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{Name: &name}); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	first, second := <-attempts, <-attempts
+	if first.jti == "" || first.jti == second.jti {
+		t.Errorf("expected distinct jti values, got %q and %q", first.jti, second.jti)
+	}
+	if first.reqHash == "" || first.reqHash != second.reqHash {
+		t.Errorf("expected the same reqHash on both attempts, got %q and %q", first.reqHash, second.reqHash)
+	}
+}
+
+func TestJWTGenerationRetriesEntropyFailures(t *testing.T) {
+	var headers atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			headers.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	// Each subtest must sign a new token rather than reuse the last one.
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{DisableTokenCache: true})
+
+	tests := []struct {
+		name         string
+		failures     int32
+		failure      error
+		wantErr      bool
+		wantAttempts int32
+	}{
+		{"recovers from transient failures", 2, auth.ErrEntropy, false, 3},
+		{"gives up after bounded attempts", 5, auth.ErrEntropy, true, 3},
+		{"does not retry other errors", 5, errors.New("invalid key"), true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			authGenerateJWT = func(options auth.JwtOptions) (string, error) {
+				if attempts.Add(1) <= tt.failures {
+					return "", fmt.Errorf("failed to generate nonce: %w", tt.failure)
+				}
+				return auth.GenerateJWT(options)
+			}
+			t.Cleanup(func() { authGenerateJWT = auth.GenerateJWT })
+			headers.Store(0)
+
+			_, err := client.ListEvmAccountsWithResponse(context.Background(), nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("generated JWT %d times, want %d", got, tt.wantAttempts)
+			}
+			if !tt.wantErr && headers.Load() != 1 {
+				t.Error("request was not sent with an Authorization header")
+			}
+		})
+	}
+}
+
+// newSlowServer returns a server that waits for delay before responding.
+func newSlowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRequestErrorContextDeadline(t *testing.T) {
+	server := newSlowServer(t, 200*time.Millisecond)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.RequestEvmFaucetWithResponse(ctx, openapi.RequestEvmFaucetJSONRequestBody{Address: testOwner, Network: "base-sepolia", Token: "eth"})
+
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) || !errors.Is(err, ErrContextDeadline) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected ErrContextDeadline, got %v", err)
+	}
+	if reqErr.Attempts != 1 || reqErr.Elapsed < 10*time.Millisecond {
+		t.Errorf("unexpected diagnostics: %d attempts in %s", reqErr.Attempts, reqErr.Elapsed)
+	}
+}
+
+func TestRequestErrorClientTimeout(t *testing.T) {
+	server := newSlowServer(t, 200*time.Millisecond)
+	transport := &retryTransport{
+		next:       &http.Transport{ResponseHeaderTimeout: 10 * time.Millisecond},
+		predicate:  DefaultRetryPredicate,
+		maxRetries: -1,
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := transport.RoundTrip(req)
+
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) || reqErr.Reason != ErrClientTimeout {
+		t.Fatalf("expected ErrClientTimeout, got %v", err)
+	}
+	if errors.Is(err, ErrContextDeadline) || errors.Is(err, ErrRetriesExhausted) {
+		t.Errorf("client timeout reported as another reason: %v", err)
+	}
+}
+
+func TestRequestErrorRetriesExhausted(t *testing.T) {
+	setFastTimers(t)
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := server.URL
+	server.Close()
+	client := newTestClientWithOptions(t, url, ClientOptions{MaxRetries: 2, RetryOptions: RetryOptions{RetryNonIdempotent: true}})
+
+	_, err := requestFaucet(client)
+
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) || !errors.Is(err, ErrRetriesExhausted) {
+		t.Fatalf("expected ErrRetriesExhausted, got %v", err)
+	}
+	if reqErr.Attempts != 3 {
+		t.Errorf("Attempts = %d, want 3", reqErr.Attempts)
+	}
+}
+
+func TestRequestErrorNotRetriedKeepsError(t *testing.T) {
+	server := newSlowServer(t, 200*time.Millisecond)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.RequestEvmFaucetWithResponse(ctx, openapi.RequestEvmFaucetJSONRequestBody{Address: testOwner, Network: "base-sepolia", Token: "eth"})
+	if !errors.Is(err, context.Canceled) || errors.Is(err, ErrContextDeadline) || errors.Is(err, ErrRetriesExhausted) {
+		t.Fatalf("expected a plain cancellation, got %v", err)
+	}
+}
+
+func TestRetryOptionsRetryIdempotentRequestsOnly(t *testing.T) {
+	setFastTimers(t)
+
+	t.Run("GET is retried on 500", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusInternalServerError, `{}`)
+		client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+		if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if n := requests.Load(); n != 2 {
+			t.Errorf("expected 2 attempts, got %d", n)
+		}
+	})
+
+	t.Run("POST is not retried", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, `{}`)
+		client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+		resp, err := requestFaucet(client)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode() != http.StatusServiceUnavailable || requests.Load() != 1 {
+			t.Errorf("expected a single 503 response, got %d after %d attempts", resp.StatusCode(), requests.Load())
+		}
+	})
+
+	t.Run("POST with an idempotency key is retried", func(t *testing.T) {
+		server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, `{}`)
+		client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+		key := "6f0a2a6e-7d4c-4a8a-9b1e-2f3c4d5e6f70"
+		if _, err := client.CreateEvmAccountWithResponse(context.Background(), &openapi.CreateEvmAccountParams{XIdempotencyKey: &key}, openapi.CreateEvmAccountJSONRequestBody{}); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if n := requests.Load(); n != 2 {
+			t.Errorf("expected 2 attempts, got %d", n)
+		}
+	})
+}
+
+func TestRetryOptionsRetryableStatus(t *testing.T) {
+	setFastTimers(t)
+	server, requests := newFlakyServer(t, 5, http.StatusConflict, `{}`)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		MaxRetries: 5,
+		RetryOptions: RetryOptions{
+			MaxRetries:      1,
+			RetryableStatus: func(status int) bool { return status == http.StatusConflict },
+		},
+	})
+
+	resp, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusConflict || requests.Load() != 2 {
+		t.Errorf("expected RetryOptions.MaxRetries to allow 2 attempts, got %d after %d attempts", resp.StatusCode(), requests.Load())
+	}
+}
+
+func TestRetryOptionsHonorRetryAfter(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		RetryOptions: RetryOptions{BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second},
+	})
+
+	start := time.Now()
+	if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for Retry-After, waited %s", elapsed)
+	}
+}
+
+func TestRetryOptionsDoNotWaitBeyondMaxDelay(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		RetryOptions: RetryOptions{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond},
+	})
+
+	start := time.Now()
+	resp, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode() != http.StatusTooManyRequests || requests.Load() != 1 {
+		t.Errorf("expected the 429 to be returned after 1 attempt, got %d after %d attempts", resp.StatusCode(), requests.Load())
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected no wait for a Retry-After beyond MaxDelay, waited %s", elapsed)
+	}
+}
+
+func TestRetryOptionsBackoffRespectsCancellation(t *testing.T) {
+	server, requests := newFlakyServer(t, 10, http.StatusServiceUnavailable, `{}`)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{
+		RetryOptions: RetryOptions{BaseDelay: time.Hour},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.GetEvmAccountWithResponse(ctx, testOwner)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the backoff to end with the context, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second || requests.Load() != 1 {
+		t.Errorf("expected 1 attempt before cancellation, got %d in %s", requests.Load(), elapsed)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	const d = 100 * time.Millisecond
+	for range 100 {
+		if got := jitter(d); got < d/2 || got > d {
+			t.Fatalf("jitter(%s) = %s, want between %s and %s", d, got, d/2, d)
+		}
+	}
+}
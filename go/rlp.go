@@ -0,0 +1,110 @@
+package cdp
+
+import (
+	"errors"
+	"math/big"
+)
+
+// rlpBytes RLP-encodes a byte string.
+func rlpBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(rlpHeader(0x80, len(b)), b...)
+}
+
+// rlpUint RLP-encodes an unsigned integer as a minimal big-endian byte string.
+func rlpUint(n uint64) []byte {
+	return rlpBigInt(new(big.Int).SetUint64(n))
+}
+
+// rlpBigInt RLP-encodes a non-negative integer as a minimal big-endian byte string.
+// A nil value encodes as zero.
+func rlpBigInt(n *big.Int) []byte {
+	if n == nil {
+		return rlpBytes(nil)
+	}
+	return rlpBytes(n.Bytes())
+}
+
+// rlpList RLP-encodes a list of already-encoded items.
+func rlpList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	return append(rlpHeader(0xc0, len(payload)), payload...)
+}
+
+// rlpHeader returns the RLP prefix for a string (offset 0x80) or list (offset 0xc0)
+// whose payload is size bytes long.
+func rlpHeader(offset byte, size int) []byte {
+	if size < 56 {
+		return []byte{offset + byte(size)}
+	}
+	sizeBytes := new(big.Int).SetInt64(int64(size)).Bytes()
+	return append([]byte{offset + 55 + byte(len(sizeBytes))}, sizeBytes...)
+}
+
+// rlpSplit decodes the RLP item at the start of b and returns its payload, whether
+// it is a list, and the bytes following it.
+func rlpSplit(b []byte) (payload []byte, isList bool, rest []byte, err error) {
+	if len(b) == 0 {
+		return nil, false, nil, errors.New("rlp: unexpected end of input")
+	}
+
+	prefix := b[0]
+	var offset, size int
+	switch {
+	case prefix < 0x80:
+		return b[:1], false, b[1:], nil
+	case prefix <= 0xb7:
+		offset, size = 1, int(prefix-0x80)
+	case prefix < 0xc0:
+		offset, size, err = rlpLongSize(b, int(prefix-0xb7))
+	case prefix <= 0xf7:
+		offset, size, isList = 1, int(prefix-0xc0), true
+	default:
+		offset, size, err = rlpLongSize(b, int(prefix-0xf7))
+		isList = true
+	}
+	if err != nil {
+		return nil, false, nil, err
+	}
+	if len(b) < offset+size {
+		return nil, false, nil, errors.New("rlp: item exceeds input length")
+	}
+	return b[offset : offset+size], isList, b[offset+size:], nil
+}
+
+// rlpLongSize reads the big-endian payload size of a long RLP item whose size is
+// encoded in sizeLen bytes after the prefix.
+func rlpLongSize(b []byte, sizeLen int) (offset, size int, err error) {
+	if sizeLen > 4 || len(b) < 1+sizeLen {
+		return 0, 0, errors.New("rlp: invalid length prefix")
+	}
+	for _, c := range b[1 : 1+sizeLen] {
+		size = size<<8 | int(c)
+	}
+	return 1 + sizeLen, size, nil
+}
+
+// rlpListItems decodes the RLP list b and returns the payloads of its items.
+func rlpListItems(b []byte) ([][]byte, error) {
+	payload, isList, _, err := rlpSplit(b)
+	if err != nil {
+		return nil, err
+	}
+	if !isList {
+		return nil, errors.New("rlp: expected a list")
+	}
+	var items [][]byte
+	for len(payload) > 0 {
+		var item []byte
+		if item, _, payload, err = rlpSplit(payload); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
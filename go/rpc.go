@@ -0,0 +1,127 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// RPCError is an error returned by a network's JSON-RPC endpoint.
+type RPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// Is reports whether target is ErrReplacementUnderpriced or ErrAlreadyKnown and e
+// is such a rejection.
+func (e *RPCError) Is(target error) bool {
+	return isSendError(target, e.Message)
+}
+
+// rpcRequestID numbers JSON-RPC requests.
+var rpcRequestID atomic.Uint64
+
+// rpcURL returns the JSON-RPC endpoint to use for the given network.
+func (c *Client) rpcURL(network string) (string, error) {
+	network = c.networkOrDefault(network)
+	if url, ok := c.options.RPCURLs[network]; ok && url != "" {
+		return url, nil
+	}
+	if n, ok := evmNetworks[network]; ok && n.rpcURL != "" {
+		return n.rpcURL, nil
+	}
+	if network == "" {
+		return "", errors.New("no network given and ClientOptions.DefaultNetwork is not set")
+	}
+	return "", fmt.Errorf("no RPC URL configured for network %q: set ClientOptions.RPCURLs", network)
+}
+
+// rpcCall invokes a JSON-RPC method on the given network and decodes its result
+// into result.
+func (c *Client) rpcCall(ctx context.Context, network string, result interface{}, method string, params ...interface{}) error {
+	url, err := c.rpcURL(network)
+	if err != nil {
+		return err
+	}
+
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      rpcRequestID.Add(1),
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s request: %w", method, err)
+	}
+
+	// The SDK only makes read calls, which are safe to retry.
+	req, err := http.NewRequestWithContext(withIdempotentRequest(ctx), http.MethodPost, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to build %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %w", method, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedStatusError("call "+method, resp.StatusCode, respBody)
+	}
+
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+		Error  *RPCError       `json:"error"`
+	}
+	if err := json.Unmarshal(respBody, &envelope); err != nil {
+		return fmt.Errorf("failed to parse %s response: %w", method, err)
+	}
+	if envelope.Error != nil {
+		return envelope.Error
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(envelope.Result, result); err != nil {
+		return fmt.Errorf("failed to parse %s result: %w", method, err)
+	}
+	return nil
+}
+
+// ethCall executes a read-only contract call against the latest block and returns
+// the raw return data.
+func (c *Client) ethCall(ctx context.Context, network, to string, data []byte) ([]byte, error) {
+	var result string
+	call := map[string]string{
+		"to":   to,
+		"data": "0x" + hex.EncodeToString(data),
+	}
+	if err := c.rpcCall(ctx, network, &result, "eth_call", call, "latest"); err != nil {
+		return nil, err
+	}
+	return decodeHexData(result)
+}
@@ -0,0 +1,88 @@
+package cdp
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+)
+
+// loadSecretFiles fills in options.APIKeySecret and options.WalletSecret from
+// APIKeySecretPath and WalletSecretPath. Inline secrets take precedence over
+// files; a warning is logged if both are set and Debugging is on.
+func loadSecretFiles(options *ClientOptions) error {
+	if options.APIKeySecretPath != "" {
+		if options.APIKeySecret != "" {
+			warnSecretOverride(*options, "APIKeySecret", "APIKeySecretPath", options.APIKeySecretPath)
+		} else {
+			secret, err := readSecretFile("API key secret", options.APIKeySecretPath)
+			if err != nil {
+				return err
+			}
+			if _, err := auth.KeyAlgorithm(secret); err != nil {
+				return fmt.Errorf("API key secret file %s is not a PEM-encoded EC or RSA key or a base64-encoded Ed25519 key", options.APIKeySecretPath)
+			}
+			options.APIKeySecret = secret
+		}
+	}
+
+	if options.WalletSecretPath != "" {
+		if options.WalletSecret != "" {
+			warnSecretOverride(*options, "WalletSecret", "WalletSecretPath", options.WalletSecretPath)
+		} else {
+			secret, err := readSecretFile("wallet secret", options.WalletSecretPath)
+			if err != nil {
+				return err
+			}
+			if err := validateWalletSecret(secret); err != nil {
+				return fmt.Errorf("wallet secret file %s is invalid: %w", options.WalletSecretPath, err)
+			}
+			options.WalletSecret = secret
+		}
+	}
+	return nil
+}
+
+// readSecretFile returns the contents of the secret file at path, without
+// surrounding whitespace.
+func readSecretFile(name, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s file: %w", name, err)
+	}
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", fmt.Errorf("%s file %s is empty", name, path)
+	}
+	return secret, nil
+}
+
+// warnSecretOverride logs that the inline secret field takes precedence over the
+// file at path, if debugging is enabled.
+func warnSecretOverride(options ClientOptions, field, pathField, path string) {
+	if options.Debugging {
+		clientLogger(options).Warn("both "+field+" and "+pathField+" are set; using "+field, "path", path)
+	}
+}
+
+// validateWalletSecret checks that secret is a base64-encoded PKCS #8 EC key, the
+// format of wallet secrets.
+func validateWalletSecret(secret string) error {
+	der, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return errors.New("not a base64-encoded key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return errors.New("not a PKCS #8 private key")
+	}
+	if _, ok := key.(*ecdsa.PrivateKey); !ok {
+		return errors.New("not an EC key")
+	}
+	return nil
+}
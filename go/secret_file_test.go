@@ -0,0 +1,115 @@
+package cdp
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeSecretFile writes contents to a file in a temporary directory and returns
+// its path.
+func writeSecretFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write secret file: %v", err)
+	}
+	return path
+}
+
+func TestNewClientLoadsSecretFiles(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		apiSecret string
+	}{
+		{"EC PEM", generateTestECKeyForCdpTest(t)},
+		{"base64 Ed25519", base64.StdEncoding.EncodeToString(edKey) + "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			walletSecret := generateTestWalletSecret(t)
+			client, err := NewClient(ClientOptions{
+				APIKeyID:         testAPIKeyID,
+				APIKeySecretPath: writeSecretFile(t, tt.apiSecret),
+				WalletSecretPath: writeSecretFile(t, walletSecret+"\n"),
+			})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			defer client.Close()
+
+			if want := strings.TrimSpace(tt.apiSecret); client.options.APIKeySecret != want {
+				t.Errorf("expected the API key secret to be read from the file")
+			}
+			if client.options.WalletSecret != walletSecret {
+				t.Errorf("expected the wallet secret to be read from the file")
+			}
+		})
+	}
+}
+
+func TestNewClientRejectsBadSecretFiles(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name    string
+		options ClientOptions
+		wantErr string
+	}{
+		{"missing API key file", ClientOptions{APIKeySecretPath: missing}, "failed to read API key secret file"},
+		{"missing wallet file", ClientOptions{WalletSecretPath: missing}, "failed to read wallet secret file"},
+		{"empty API key file", ClientOptions{APIKeySecretPath: writeSecretFile(t, "\n")}, "is empty"},
+		{"malformed API key", ClientOptions{APIKeySecretPath: writeSecretFile(t, "not a key")}, "is not a PEM-encoded EC or RSA key or a base64-encoded Ed25519 key"},
+		{"malformed wallet secret", ClientOptions{WalletSecretPath: writeSecretFile(t, "bm90IGEga2V5")}, "wallet secret file"},
+		{"API key as wallet secret", ClientOptions{WalletSecretPath: writeSecretFile(t, generateTestECKeyForCdpTest(t))}, "wallet secret file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(tt.options)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	_, err := NewClient(ClientOptions{APIKeySecretPath: missing})
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected the error to wrap fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestInlineSecretTakesPrecedenceOverFile(t *testing.T) {
+	var logs bytes.Buffer
+	inline := generateTestECKeyForCdpTest(t)
+	client, err := NewClient(ClientOptions{
+		APIKeyID:         testAPIKeyID,
+		APIKeySecret:     inline,
+		APIKeySecretPath: filepath.Join(t.TempDir(), "missing"),
+		Debugging:        true,
+		Logger:           slog.New(slog.NewTextHandler(&logs, nil)),
+	})
+	if err != nil {
+		t.Fatalf("expected the inline secret to be used without reading the file, got %v", err)
+	}
+	defer client.Close()
+
+	if client.options.APIKeySecret != inline {
+		t.Errorf("expected the inline API key secret to be used")
+	}
+	if !strings.Contains(logs.String(), "level=WARN") || !strings.Contains(logs.String(), "APIKeySecretPath") {
+		t.Errorf("expected a warning about the ignored secret file, got %q", logs.String())
+	}
+}
@@ -0,0 +1,88 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrTransactionSkipped is reported by SendTransactions for transactions that
+// were not sent because an earlier one failed.
+var ErrTransactionSkipped = errors.New("transaction skipped because an earlier transaction failed")
+
+// SendTransactionsOptions configures SendTransactions.
+type SendTransactionsOptions struct {
+	// WaitForReceipts waits for each transaction to be included in a block before
+	// sending the next. A transaction that reverts counts as failed.
+	WaitForReceipts bool
+	// ReceiptOptions configures the wait for each receipt if WaitForReceipts is
+	// set.
+	ReceiptOptions ReceiptOptions
+	// ContinueOnError sends the remaining transactions after one fails. By
+	// default they are skipped.
+	ContinueOnError bool
+}
+
+// SendTransactions sends txs from the account one after the other, in order, and
+// returns their hashes. Nonces are assigned in order by the client's
+// NonceManager, or by one created for the call if ClientOptions.ManageNonces is
+// not set, so the transactions' own Nonce fields are ignored. Every transaction's
+// recipient is checked against ClientOptions.RecipientAllowlist before any is
+// sent.
+//
+// If a transaction fails, the following ones are skipped unless
+// opts.ContinueOnError is set, and the returned error is a *BatchError with one
+// entry per transaction; skipped transactions report ErrTransactionSkipped. The
+// hashes of the transactions that were sent are returned either way, with empty
+// entries for the rest.
+//
+// Gas limits that are not set are estimated against the latest block, so unless
+// opts.WaitForReceipts is set, each transaction is estimated before the earlier
+// ones are included. A transaction that depends on an earlier one, such as a
+// transferFrom after an approve, then fails estimation; set WaitForReceipts, or
+// set the transaction's Gas, for such sequences.
+func (a *NetworkScopedEvmAccount) SendTransactions(ctx context.Context, txs []TransactionRequest, opts SendTransactionsOptions) ([]string, error) {
+	if err := a.client.checkWritable(); err != nil {
+		return nil, err
+	}
+	for i, tx := range txs {
+		if err := a.client.checkRecipient(tx.To, tx.Value, tx.Data); err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+
+	nonces := a.client.nonces
+	if nonces == nil {
+		nonces = newNonceManager(a.client)
+	}
+
+	hashes := make([]string, len(txs))
+	errs := make([]error, len(txs))
+	failed := false
+	for i, tx := range txs {
+		if failed && !opts.ContinueOnError {
+			errs[i] = ErrTransactionSkipped
+			continue
+		}
+
+		tx.Nonce = 0
+		hash := ""
+		tx, err := a.withGasLimit(ctx, a.Network, tx)
+		if err == nil {
+			hash, err = a.sendWithNonces(ctx, a.Network, tx, nonces)
+		}
+		if err == nil && opts.WaitForReceipts {
+			_, err = a.client.WaitForTransactionReceipt(ctx, a.Network, hash, opts.ReceiptOptions)
+		}
+		hashes[i] = hash
+		if err != nil {
+			errs[i] = err
+			failed = true
+		}
+	}
+
+	if failed {
+		return hashes, &BatchError{Errors: errs}
+	}
+	return hashes, nil
+}
@@ -0,0 +1,133 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+// newSequentialSendServers returns a client whose API sends transactions and
+// whose RPC endpoint counts them, starting at nonce 7. Transactions with a value
+// of failValue are rejected. It also returns the nonces of the transactions sent,
+// in order.
+func newSequentialSendServers(t *testing.T, failValue int64) (*Client, *[]uint64) {
+	t.Helper()
+	var count atomic.Uint64
+	count.Store(7)
+	rpc := newNonceRPCServer(t, &count)
+
+	var nonces []uint64
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		for nonce := count.Load(); nonce < count.Load()+4; nonce++ {
+			for value := int64(1); value <= 4; value++ {
+				want, _ := SerializeTransaction("base-sepolia", TransactionRequest{To: testRecipient, Value: big.NewInt(value), Nonce: nonce})
+				if body.Transaction != want {
+					continue
+				}
+				if value == failValue {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"errorType":"invalid_request","errorMessage":"rejected"}`)
+					return
+				}
+				nonces = append(nonces, nonce)
+				count.Add(1)
+				fmt.Fprintf(w, `{"transactionHash":"0x%064x"}`, value)
+				return
+			}
+		}
+		t.Errorf("unexpected transaction %s", body.Transaction)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(api.Close)
+
+	return newReceiptTestClient(t, api.URL, rpc.URL), &nonces
+}
+
+// valueTransfers returns transfers of 1, 2, ... n wei to testRecipient.
+func valueTransfers(n int) []TransactionRequest {
+	txs := make([]TransactionRequest, n)
+	for i := range txs {
+		txs[i] = TransactionRequest{To: testRecipient, Value: big.NewInt(int64(i + 1))}
+	}
+	return txs
+}
+
+func TestSendTransactionsSendsInOrder(t *testing.T) {
+	client, nonces := newSequentialSendServers(t, 0)
+	account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+	hashes, err := account.SendTransactions(context.Background(), valueTransfers(3), SendTransactionsOptions{})
+	if err != nil {
+		t.Fatalf("SendTransactions returned an error: %v", err)
+	}
+	want := []string{fmt.Sprintf("0x%064x", 1), fmt.Sprintf("0x%064x", 2), fmt.Sprintf("0x%064x", 3)}
+	if !slices.Equal(hashes, want) {
+		t.Errorf("expected hashes %v, got %v", want, hashes)
+	}
+	if want := []uint64{7, 8, 9}; !slices.Equal(*nonces, want) {
+		t.Errorf("expected nonces %v, got %v", want, *nonces)
+	}
+}
+
+func TestSendTransactionsStopsOnFailure(t *testing.T) {
+	client, nonces := newSequentialSendServers(t, 2)
+	account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+	hashes, err := account.SendTransactions(context.Background(), valueTransfers(3), SendTransactionsOptions{})
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected a *BatchError, got %v", err)
+	}
+	var apiErr *APIError
+	if batchErr.Errors[0] != nil || !errors.As(batchErr.Errors[1], &apiErr) || !errors.Is(batchErr.Errors[2], ErrTransactionSkipped) {
+		t.Errorf("unexpected errors %v", batchErr.Errors)
+	}
+	if hashes[0] == "" || hashes[1] != "" || hashes[2] != "" {
+		t.Errorf("expected only the first hash, got %v", hashes)
+	}
+	if want := []uint64{7}; !slices.Equal(*nonces, want) {
+		t.Errorf("expected nonces %v, got %v", want, *nonces)
+	}
+}
+
+func TestSendTransactionsContinueOnError(t *testing.T) {
+	client, nonces := newSequentialSendServers(t, 2)
+	account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+	hashes, err := account.SendTransactions(context.Background(), valueTransfers(3), SendTransactionsOptions{ContinueOnError: true})
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || batchErr.Errors[0] != nil || batchErr.Errors[1] == nil || batchErr.Errors[2] != nil {
+		t.Fatalf("expected only the second transaction to fail, got %v", err)
+	}
+	if hashes[2] == "" {
+		t.Errorf("expected the third transaction to be sent, got %v", hashes)
+	}
+	if want := []uint64{7, 8}; !slices.Equal(*nonces, want) {
+		t.Errorf("expected the failed nonce to be reused, got %v", *nonces)
+	}
+}
+
+func TestSendTransactionsChecksAllRecipientsFirst(t *testing.T) {
+	client, nonces := newSequentialSendServers(t, 0)
+	client.options.RecipientAllowlist = []string{testRecipient}
+	account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+	txs := append(valueTransfers(2), TransactionRequest{To: testNFT})
+	if _, err := account.SendTransactions(context.Background(), txs, SendTransactionsOptions{}); !errors.Is(err, ErrRecipientNotAllowed) {
+		t.Fatalf("expected ErrRecipientNotAllowed, got %v", err)
+	}
+	if len(*nonces) != 0 {
+		t.Errorf("expected nothing to be sent, got nonces %v", *nonces)
+	}
+}
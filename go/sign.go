@@ -0,0 +1,81 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// maxSignConcurrency bounds the number of signing requests SignMessages has in
+// flight at once.
+const maxSignConcurrency = 8
+
+// SignMessage signs message with the account according to EIP-191 and returns the
+// 0x-prefixed signature.
+func (a *EvmAccount) SignMessage(ctx context.Context, message []byte) (string, error) {
+	resp, err := a.client.SignEvmMessageWithResponse(ctx, a.Address, nil, openapi.SignEvmMessageJSONRequestBody{
+		Message: string(message),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign message: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return "", unexpectedStatusError("sign message", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.Signature, nil
+}
+
+// SignTypedData signs typedData with the account according to EIP-712 and returns
+// the 0x-prefixed signature. The signed digest is HashTypedData(typedData).
+func (a *EvmAccount) SignTypedData(ctx context.Context, typedData openapi.EIP712Message) (string, error) {
+	resp, err := a.client.SignEvmTypedDataWithResponse(ctx, a.Address, nil, typedData)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign typed data: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return "", unexpectedStatusError("sign typed data", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.Signature, nil
+}
+
+// SignMessages signs each of messages with the account according to EIP-191 and
+// returns the signatures in the same order as messages.
+//
+// The API has no batch signing endpoint, so SignMessages issues one request per
+// message, with at most 8 requests in flight at a time. Every message is attempted
+// even if some fail; in that case the returned error is a *BatchError holding the
+// error for each failed message, and the signatures of failed messages are empty.
+// Canceling ctx stops messages that have not been sent yet.
+func (a *EvmAccount) SignMessages(ctx context.Context, messages [][]byte) ([]string, error) {
+	signatures := make([]string, len(messages))
+	errs := make([]error, len(messages))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxSignConcurrency)
+	for i, message := range messages {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			signatures[i], errs[i] = a.SignMessage(ctx, message)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return signatures, &BatchError{Errors: errs}
+		}
+	}
+	return signatures, nil
+}
@@ -0,0 +1,71 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSignMessagesPreservesOrderAndBoundsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		var body struct{ Message string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Message == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorType":"invalid_request","errorMessage":"bad message"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"signature":"sig-%s"}`, body.Message)
+	}))
+	defer server.Close()
+
+	account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+
+	var messages [][]byte
+	for i := 0; i < 20; i++ {
+		messages = append(messages, []byte(fmt.Sprintf("m%d", i)))
+	}
+	messages[7] = []byte("bad")
+
+	signatures, err := account.SignMessages(context.Background(), messages)
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected *BatchError, got %v", err)
+	}
+	for i, msg := range messages {
+		if i == 7 {
+			if batchErr.Errors[i] == nil || signatures[i] != "" {
+				t.Errorf("expected message %d to fail", i)
+			}
+			continue
+		}
+		if batchErr.Errors[i] != nil {
+			t.Errorf("message %d failed: %v", i, batchErr.Errors[i])
+		}
+		if want := "sig-" + string(msg); signatures[i] != want {
+			t.Errorf("signatures[%d] = %q, want %q", i, signatures[i], want)
+		}
+	}
+	if m := maxInFlight.Load(); m > maxSignConcurrency {
+		t.Errorf("observed %d concurrent requests, limit is %d", m, maxSignConcurrency)
+	}
+}
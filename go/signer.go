@@ -0,0 +1,91 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// TransactionSignerFn signs a binary-encoded, unsigned EIP-2718 typed transaction
+// and returns the binary-encoded signed transaction.
+type TransactionSignerFn func(ctx context.Context, unsignedTx []byte) ([]byte, error)
+
+// AsTransactionSigner returns a signer that signs binary-encoded transactions for
+// network with the account via CDP, for plugging a CDP-managed account into
+// libraries that build their own transactions. For go-ethereum contract bindings,
+// use the bind.SignerFn returned by Signer in the
+// github.com/coinbase/cdp-sdk/go/geth module, which keeps go-ethereum out of
+// this module's dependencies.
+//
+// Only typed transactions (EIP-2930 and EIP-1559) are supported, and their chain
+// ID must match network. Like SignTransaction, the signer fails with
+// ErrRecipientNotAllowed if the transaction's recipient is not in
+// ClientOptions.RecipientAllowlist. Every signature is a round trip to the CDP API, so
+// signing is slower than with a local key, and each call counts towards the
+// API's rate limits.
+func (a *EvmAccount) AsTransactionSigner(network string) TransactionSignerFn {
+	network = a.client.networkOrDefault(network)
+	return func(ctx context.Context, unsignedTx []byte) ([]byte, error) {
+		chainID, err := ChainID(network)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := decodeTypedTransaction(unsignedTx)
+		if err != nil {
+			return nil, err
+		}
+		if tx.chainID.Cmp(big.NewInt(chainID)) != 0 {
+			return nil, fmt.Errorf("%w: chain ID %s is not %s (%d)", ErrChainIDMismatch, tx.chainID, network, chainID)
+		}
+		if err := a.client.checkRecipient(tx.to, tx.value, tx.data); err != nil {
+			return nil, err
+		}
+
+		resp, err := a.signRawTransaction(ctx, "0x"+hex.EncodeToString(unsignedTx))
+		if err != nil {
+			return nil, err
+		}
+		return decodeHexData(resp)
+	}
+}
+
+// typedTransaction holds the fields of a typed transaction that are checked
+// before signing it.
+type typedTransaction struct {
+	chainID *big.Int
+	// to is empty for contract deployments.
+	to    string
+	value *big.Int
+	data  string
+}
+
+// decodeTypedTransaction decodes a binary-encoded EIP-2930 or EIP-1559
+// transaction.
+func decodeTypedTransaction(tx []byte) (*typedTransaction, error) {
+	// toIndex is the index of the to field in the payload; chainID is always
+	// first, and value and data follow to.
+	var toIndex int
+	switch {
+	case len(tx) > 0 && tx[0] == eip2930TxType:
+		toIndex = 4
+	case len(tx) > 0 && tx[0] == eip1559TxType:
+		toIndex = 5
+	default:
+		return nil, errors.New("unsupported transaction: only typed (EIP-2930 and EIP-1559) transactions can be signed")
+	}
+	items, err := rlpListItems(tx[1:])
+	if err != nil {
+		return nil, fmt.Errorf("malformed transaction: %w", err)
+	}
+	if len(items) < toIndex+3 {
+		return nil, fmt.Errorf("malformed transaction: expected at least %d fields, got %d", toIndex+3, len(items))
+	}
+	return &typedTransaction{
+		chainID: new(big.Int).SetBytes(items[0]),
+		to:      optionalAddress(items[toIndex]),
+		value:   new(big.Int).SetBytes(items[toIndex+1]),
+		data:    "0x" + hex.EncodeToString(items[toIndex+2]),
+	}, nil
+}
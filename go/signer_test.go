@@ -0,0 +1,73 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAsTransactionSigner(t *testing.T) {
+	unsigned, err := SerializeTransaction("base-sepolia", TransactionRequest{To: testRecipient})
+	if err != nil {
+		t.Fatalf("SerializeTransaction returned an error: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Transaction != unsigned {
+			t.Errorf("transaction = %s, want %s", body.Transaction, unsigned)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"signedTransaction":"0x02f8aa"}`)
+	}))
+	defer server.Close()
+
+	account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+	raw, _ := decodeHexData(unsigned)
+
+	signed, err := account.AsTransactionSigner("base-sepolia")(context.Background(), raw)
+	if err != nil {
+		t.Fatalf("signer returned an error: %v", err)
+	}
+	if got := hex.EncodeToString(signed); got != "02f8aa" {
+		t.Errorf("signed = %s, want 02f8aa", got)
+	}
+
+	_, err = account.AsTransactionSigner("base")(context.Background(), raw)
+	if !errors.Is(err, ErrChainIDMismatch) {
+		t.Errorf("expected ErrChainIDMismatch signing for another network, got %v", err)
+	}
+}
+
+func TestDecodeTypedTransactionRejectsLegacyTransactions(t *testing.T) {
+	legacy := rlpList(rlpUint(0), rlpUint(1), rlpUint(21000))
+	if _, err := decodeTypedTransaction(legacy); err == nil {
+		t.Fatal("expected an error for a legacy transaction")
+	}
+}
+
+func TestAsTransactionSignerChecksRecipientAllowlist(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("a transaction to a recipient that is not allowlisted was signed")
+	}))
+	defer server.Close()
+
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{RecipientAllowlist: []string{testRecipient}})
+	account := &EvmAccount{client: client, Address: testOwner}
+	unsigned, err := SerializeTransaction("base-sepolia", TransactionRequest{To: testNFT, Value: big.NewInt(1)})
+	if err != nil {
+		t.Fatalf("SerializeTransaction returned an error: %v", err)
+	}
+	raw, _ := decodeHexData(unsigned)
+
+	if _, err := account.AsTransactionSigner("base-sepolia")(context.Background(), raw); !errors.Is(err, ErrRecipientNotAllowed) {
+		t.Errorf("expected ErrRecipientNotAllowed, got %v", err)
+	}
+}
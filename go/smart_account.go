@@ -0,0 +1,166 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// SmartAccount is a handle to a CDP-managed EVM smart account.
+type SmartAccount struct {
+	client *Client
+
+	// Address is the smart account's address.
+	Address string
+	// Owner is the address of the account that owns the smart account.
+	Owner string
+	// Name is the smart account's name, if it has one.
+	Name string
+	// Policies are the IDs of the policies that apply to the smart account,
+	// including the project-level policy, as of when the handle was fetched.
+	Policies []string
+
+	// paymasterURL is the default paymaster of the account's user operations.
+	paymasterURL string
+}
+
+// newSmartAccount converts an API smart account into a SmartAccount handle.
+func newSmartAccount(client *Client, account *openapi.EvmSmartAccount) *SmartAccount {
+	a := &SmartAccount{client: client, Address: account.Address}
+	if len(account.Owners) > 0 {
+		a.Owner = account.Owners[0]
+	}
+	if account.Name != nil {
+		a.Name = *account.Name
+	}
+	if account.Policies != nil {
+		a.Policies = *account.Policies
+	}
+	return a
+}
+
+// NewSmartAccount returns a handle to the smart account at address owned by owner,
+// such as one whose identity was persisted earlier, without making any API call.
+// The account's existence is not checked: an address that is not a smart account
+// owned by owner causes the account's operations to fail when they are performed.
+func NewSmartAccount(client *Client, address, owner string) *SmartAccount {
+	return &SmartAccount{client: client, Address: address, Owner: owner}
+}
+
+// GetOrCreateSmartAccount returns the smart account with the given name owned by
+// owner, creating it if it does not exist. An existing smart account is compatible
+// if owner is one of its owners; opts.OnNameCollision determines what happens when
+// the name is taken.
+//
+// Like GetOrCreateEvmAccount, it is safe to call concurrently for the same owner
+// and name: concurrent calls on the same client share a single lookup and
+// creation, and a smart account created by another client first is fetched and
+// checked for compatibility instead.
+func (c *Client) GetOrCreateSmartAccount(ctx context.Context, owner string, opts CreateOptions) (*SmartAccount, error) {
+	if opts.Name == "" || opts.OnNameCollision == NameCollisionFail {
+		return c.getOrCreateSmartAccount(ctx, owner, opts)
+	}
+	key := fmt.Sprintf("%s/%s/%d", strings.ToLower(owner), opts.Name, opts.OnNameCollision)
+	account, err := c.smartAccountFlights.do(ctx, key, func() (*SmartAccount, error) {
+		return c.getOrCreateSmartAccount(ctx, owner, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Give each caller its own handle.
+	handle := *account
+	return &handle, nil
+}
+
+// getOrCreateSmartAccount implements GetOrCreateSmartAccount for a single caller.
+func (c *Client) getOrCreateSmartAccount(ctx context.Context, owner string, opts CreateOptions) (*SmartAccount, error) {
+	names := []string{opts.Name}
+	if opts.OnNameCollision == NameCollisionSuffix {
+		for n := 2; n <= maxNameSuffix; n++ {
+			names = append(names, suffixedName(opts.Name, n))
+		}
+	}
+
+	for _, name := range names {
+		existing, err := c.getSmartAccountByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing == nil {
+			account, err := c.createSmartAccount(ctx, owner, name)
+			if !isConflict(err) {
+				return account, err
+			}
+			// Another client created a smart account with this name since it was
+			// looked up; treat it as any existing one.
+			if existing, err = c.getSmartAccountByName(ctx, name); err != nil {
+				return nil, err
+			}
+			if existing == nil {
+				return nil, fmt.Errorf("failed to create smart account: name %q is taken but the account was not found", name)
+			}
+		}
+
+		compatible := hasOwner(existing, owner)
+		switch {
+		case opts.OnNameCollision == NameCollisionFail:
+			return nil, fmt.Errorf("%w: a smart account named %q already exists", ErrNameCollision, name)
+		case compatible:
+			return newSmartAccount(c, existing), nil
+		case opts.OnNameCollision == NameCollisionReuse:
+			return nil, fmt.Errorf("%w: smart account named %q is not owned by %s", ErrNameCollision, name, owner)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no available name for smart account %q", ErrNameCollision, opts.Name)
+}
+
+// getSmartAccountByName returns the smart account with the given name, or nil if
+// none exists.
+func (c *Client) getSmartAccountByName(ctx context.Context, name string) (*openapi.EvmSmartAccount, error) {
+	resp, err := c.GetEvmSmartAccountByNameWithResponse(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get smart account: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, unexpectedResponseError("get smart account", resp.HTTPResponse, resp.Body)
+	}
+}
+
+// createSmartAccount creates a smart account owned by owner with the given name.
+func (c *Client) createSmartAccount(ctx context.Context, owner, name string) (*SmartAccount, error) {
+	body := openapi.CreateEvmSmartAccountJSONRequestBody{Owners: []string{owner}}
+	if name != "" {
+		body.Name = &name
+	}
+
+	resp, err := c.CreateEvmSmartAccountWithResponse(ctx, nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create smart account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated || resp.JSON201 == nil {
+		return nil, unexpectedStatusError("create smart account", resp.StatusCode(), resp.Body)
+	}
+
+	return newSmartAccount(c, resp.JSON201), nil
+}
+
+// hasOwner reports whether owner is one of the smart account's owners.
+func hasOwner(account *openapi.EvmSmartAccount, owner string) bool {
+	for _, o := range account.Owners {
+		if strings.EqualFold(o, owner) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package cdp
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Coinbase Smart Wallet v1.1 contracts that deploy CDP smart accounts. Both are at
+// the same address on every supported network.
+// TestPredictSmartAccountAddressesMatchFactory checks them against the deployed
+// factory.
+const (
+	smartWalletFactory        = "0xba5ed110efdba3d005bfc882d75358acbbb85842"
+	smartWalletImplementation = "0x000100abaad02f1cfc8bbf3ad2a4e6f4e6a4b1f8"
+)
+
+// PredictSmartAccountAddresses returns the counterfactual addresses of the smart
+// accounts the Coinbase Smart Wallet factory would deploy on network for each set
+// of owner addresses and salt, without making any API or RPC call. owners and salts
+// are aligned: the i-th address is that of the account owned by owners[i] with salt
+// salts[i]. Salts are uint256 nonces in decimal or 0x-prefixed hex; the factory
+// uses nonce 0 for an owner set's first account.
+//
+// The addresses are those the factory's getAddress returns; whether CDP deploys a
+// given account at its address is not checked.
+func PredictSmartAccountAddresses(owners [][]string, salts []string, network string) ([]string, error) {
+	if _, ok := evmNetworks[network]; !ok {
+		return nil, fmt.Errorf("unsupported network %q", network)
+	}
+	if len(owners) != len(salts) {
+		return nil, fmt.Errorf("got %d owner sets but %d salts", len(owners), len(salts))
+	}
+
+	factory, _ := decodeHexData(smartWalletFactory)
+	implementation, _ := decodeHexData(smartWalletImplementation)
+	initCodeHash := keccak256(erc1967ProxyInitCode(implementation))
+
+	addresses := make([]string, len(owners))
+	for i := range owners {
+		salt, err := smartWalletSalt(owners[i], salts[i])
+		if err != nil {
+			return nil, fmt.Errorf("account %d: %w", i, err)
+		}
+		addresses[i] = "0x" + hex.EncodeToString(create2Address(factory, salt, initCodeHash))
+	}
+	return addresses, nil
+}
+
+// smartWalletSalt returns the CREATE2 salt the factory derives from owners and
+// nonce: keccak256(abi.encode(bytes[] owners, uint256 nonce)), where each owner is
+// its ABI-encoded address.
+func smartWalletSalt(owners []string, nonce string) ([]byte, error) {
+	encoded, err := encodeSmartWalletOwners(owners, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(encoded), nil
+}
+
+// encodeSmartWalletOwners returns abi.encode(bytes[] owners, uint256 nonce), the
+// arguments of the factory's createAccount and getAddress.
+func encodeSmartWalletOwners(owners []string, nonce string) ([]byte, error) {
+	if len(owners) == 0 {
+		return nil, errors.New("at least one owner is required")
+	}
+	n, err := ParseAmount(nonce)
+	if err != nil || n.Cmp(maxUint256) > 0 {
+		return nil, fmt.Errorf("invalid salt %q: must be a uint256 in decimal or 0x-prefixed hex", nonce)
+	}
+
+	// Head: the offset of the owners array, then the nonce. Tail: the array's
+	// length, the offsets of its elements, then each element's length and data.
+	encoded := append(uintWord(big.NewInt(2*abiWordSize)), uintWord(n)...)
+	encoded = append(encoded, uintWord(big.NewInt(int64(len(owners))))...)
+	for i := range owners {
+		encoded = append(encoded, uintWord(big.NewInt(int64(len(owners)*abiWordSize+i*2*abiWordSize)))...)
+	}
+	for _, owner := range owners {
+		word, err := addressWord(owner)
+		if err != nil {
+			return nil, fmt.Errorf("invalid owner: %w", err)
+		}
+		encoded = append(encoded, bytesTail(word)...)
+	}
+	return encoded, nil
+}
+
+// erc1967ProxyInitCode returns the creation code of the minimal ERC-1967 proxy to
+// implementation that the factory deploys, as built by Solady's LibClone.
+func erc1967ProxyInitCode(implementation []byte) []byte {
+	prefix, _ := hex.DecodeString("603d3d8160223d3973")
+	suffix, _ := hex.DecodeString("60095155f3363d3d373d3d363d7f360894a13ba1a3210667c828492db98dca3e2076" +
+		"cc3735a920a3ca505d382bbc545af43d6000803e6038573d6000fd5b3d6000f3")
+	code := append(prefix, implementation...)
+	return append(code, suffix...)
+}
+
+// create2Address returns the address of a contract deployed by deployer with
+// CREATE2, as defined in EIP-1014.
+func create2Address(deployer, salt, initCodeHash []byte) []byte {
+	return keccak256([]byte{0xff}, deployer, salt, initCodeHash)[12:]
+}
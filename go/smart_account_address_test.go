@@ -0,0 +1,151 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCreate2Address(t *testing.T) {
+	// The examples from EIP-1014.
+	tests := []struct {
+		deployer, salt, initCode, want string
+	}{
+		{"0000000000000000000000000000000000000000", strings.Repeat("00", 32), "00", "4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38"},
+		{"deadbeef00000000000000000000000000000000", strings.Repeat("00", 32), "00", "b928f69bb1d91cd65274e3c79d8986362984fda3"},
+		{"deadbeef00000000000000000000000000000000", "000000000000000000000000feed000000000000000000000000000000000000", "00", "d04116cdd17bebe565eb2422f2497e06cc1c9833"},
+		{"0000000000000000000000000000000000000000", strings.Repeat("00", 32), "deadbeef", "70f2b2914a2a4b783faefb75f459a580616fcb5e"},
+		{"00000000000000000000000000000000deadbeef", "00000000000000000000000000000000000000000000000000000000cafebabe", "deadbeef", "60f3f640a8508fc6a86d45df051962668e1e8ac7"},
+		{"0000000000000000000000000000000000000000", strings.Repeat("00", 32), "", "e33c0c7f7df4809055c3eba6c09cfe4baf1bd9e0"},
+	}
+	for _, tc := range tests {
+		deployer, _ := hex.DecodeString(tc.deployer)
+		salt, _ := hex.DecodeString(tc.salt)
+		initCode, _ := hex.DecodeString(tc.initCode)
+		if got := hex.EncodeToString(create2Address(deployer, salt, keccak256(initCode))); got != tc.want {
+			t.Errorf("create2Address(%s, %s, keccak256(%s)) = %s, want %s", tc.deployer, tc.salt, tc.initCode, got, tc.want)
+		}
+	}
+}
+
+func TestSmartWalletSalt(t *testing.T) {
+	word := func(s string) string { return strings.Repeat("0", 64-len(s)) + s }
+	// abi.encode(bytes[] owners, uint256 nonce) with each owner ABI-encoded.
+	want := word("40") + word("7") + // offset of owners, nonce
+		word("2") + word("40") + word("80") + // owners length, element offsets
+		word("20") + word(testOwner[2:]) + // owners[0]
+		word("20") + word(testOtherOwner[2:]) // owners[1]
+	encoded, _ := hex.DecodeString(want)
+
+	salt, err := smartWalletSalt([]string{testOwner, testOtherOwner}, "0x7")
+	if err != nil {
+		t.Fatalf("smartWalletSalt returned an error: %v", err)
+	}
+	if got := hex.EncodeToString(salt); got != hex.EncodeToString(keccak256(encoded)) {
+		t.Errorf("smartWalletSalt = %s, want keccak256(%s)", got, want)
+	}
+}
+
+func TestPredictSmartAccountAddresses(t *testing.T) {
+	owners := [][]string{{testOwner}, {testOwner}, {testOwner}, {testOwner, testOtherOwner}}
+	salts := []string{"0", "1", "0x1", "0"}
+	addresses, err := PredictSmartAccountAddresses(owners, salts, "base")
+	if err != nil {
+		t.Fatalf("PredictSmartAccountAddresses returned an error: %v", err)
+	}
+	if len(addresses) != len(owners) {
+		t.Fatalf("got %d addresses, want %d", len(addresses), len(owners))
+	}
+	for _, address := range addresses {
+		if _, err := addressWord(address); err != nil {
+			t.Errorf("invalid address %q", address)
+		}
+	}
+	if addresses[1] != addresses[2] {
+		t.Errorf("decimal and hex salts gave different addresses %s and %s", addresses[1], addresses[2])
+	}
+	if addresses[0] == addresses[1] || addresses[0] == addresses[3] {
+		t.Errorf("different salts or owners gave the same address: %v", addresses)
+	}
+
+	// The factory is at the same address on every network.
+	single, err := PredictSmartAccountAddresses(owners[:1], salts[:1], "ethereum-sepolia")
+	if err != nil || single[0] != addresses[0] {
+		t.Errorf("PredictSmartAccountAddresses on ethereum-sepolia = %v, %v; want [%s]", single, err, addresses[0])
+	}
+}
+
+// testSmartAccountAddress is the predicted address of the smart account owned by
+// testOwner alone with nonce 0. It pins the prediction against regressions. It
+// was computed by PredictSmartAccountAddresses and has not been checked against
+// the deployed factory yet; TestPredictSmartAccountAddressesMatchFactory checks
+// the same owner and nonce, so running it confirms this value.
+const testSmartAccountAddress = "0x652ae673cacd9865a5da0b9f542b1c02d2850cdc"
+
+func TestPredictSmartAccountAddressVector(t *testing.T) {
+	addresses, err := PredictSmartAccountAddresses([][]string{{testOwner}}, []string{"0"}, "base-sepolia")
+	if err != nil || len(addresses) != 1 || addresses[0] != testSmartAccountAddress {
+		t.Errorf("PredictSmartAccountAddresses = %v, %v; want [%s]", addresses, err, testSmartAccountAddress)
+	}
+}
+
+// TestPredictSmartAccountAddressesMatchFactory checks predicted addresses, and so
+// smartWalletFactory and smartWalletImplementation, against the deployed
+// factory's getAddress. It needs network access, so it only runs if
+// CDP_TEST_BASE_SEPOLIA_RPC_URL is set.
+func TestPredictSmartAccountAddressesMatchFactory(t *testing.T) {
+	rpcURL := os.Getenv("CDP_TEST_BASE_SEPOLIA_RPC_URL")
+	if rpcURL == "" {
+		t.Skip("set CDP_TEST_BASE_SEPOLIA_RPC_URL to check against the deployed factory")
+	}
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpcURL)
+	selector := FunctionSelector("getAddress(bytes[],uint256)")
+
+	owners := [][]string{{testOwner}, {testOwner, testOtherOwner}}
+	salts := []string{"0", "7"}
+	predicted, err := PredictSmartAccountAddresses(owners, salts, "base-sepolia")
+	if err != nil {
+		t.Fatalf("PredictSmartAccountAddresses returned an error: %v", err)
+	}
+	for i := range owners {
+		args, err := encodeSmartWalletOwners(owners[i], salts[i])
+		if err != nil {
+			t.Fatalf("failed to encode getAddress arguments: %v", err)
+		}
+		var result string
+		call := map[string]string{"to": smartWalletFactory, "data": "0x" + hex.EncodeToString(append(selector[:], args...))}
+		if err := client.rpcCall(context.Background(), "base-sepolia", &result, "eth_call", call, "latest"); err != nil {
+			t.Fatalf("getAddress failed: %v", err)
+		}
+		if len(result) != 66 || "0x"+result[26:] != predicted[i] {
+			t.Errorf("account %d: factory returned %s, predicted %s", i, result, predicted[i])
+		}
+	}
+	if predicted[0] != testSmartAccountAddress {
+		t.Errorf("the factory's address for testOwner is %s, not testSmartAccountAddress %s", predicted[0], testSmartAccountAddress)
+	}
+}
+
+func TestPredictSmartAccountAddressesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		owners  [][]string
+		salts   []string
+		network string
+	}{
+		{"unknown network", [][]string{{testOwner}}, []string{"0"}, "solana"},
+		{"misaligned inputs", [][]string{{testOwner}}, []string{"0", "1"}, "base"},
+		{"no owners", [][]string{{}}, []string{"0"}, "base"},
+		{"invalid owner", [][]string{{"0x1234"}}, []string{"0"}, "base"},
+		{"invalid salt", [][]string{{testOwner}}, []string{"salt"}, "base"},
+		{"negative salt", [][]string{{testOwner}}, []string{"-1"}, "base"},
+		{"salt over uint256", [][]string{{testOwner}}, []string{"0x1" + strings.Repeat("0", 64)}, "base"},
+	}
+	for _, tt := range tests {
+		if _, err := PredictSmartAccountAddresses(tt.owners, tt.salts, tt.network); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// NetworkScopedSmartAccount is a SmartAccount bound to a single network, so that
+// network-specific helpers don't need it passed on every call.
+type NetworkScopedSmartAccount struct {
+	*SmartAccount
+
+	// Network is the network the account operates on (e.g. "base-sepolia").
+	Network string
+}
+
+// OnNetwork returns the smart account scoped to network, or to
+// ClientOptions.DefaultNetwork if network is empty.
+func (s *SmartAccount) OnNetwork(network string) *NetworkScopedSmartAccount {
+	network = s.client.networkOrDefault(network)
+	return &NetworkScopedSmartAccount{SmartAccount: s, Network: network}
+}
+
+// WithPaymaster returns a copy of the handle whose user operations are sponsored
+// by the paymaster at paymasterURL by default. See SmartAccount.WithPaymaster.
+func (s *NetworkScopedSmartAccount) WithPaymaster(paymasterURL string) *NetworkScopedSmartAccount {
+	return &NetworkScopedSmartAccount{SmartAccount: s.SmartAccount.WithPaymaster(paymasterURL), Network: s.Network}
+}
+
+// SendUserOperation sends a user operation making calls on the account's network.
+// See SmartAccount.SendUserOperation.
+func (s *NetworkScopedSmartAccount) SendUserOperation(ctx context.Context, calls []openapi.EvmCall, opts UserOperationOptions) (*UserOperation, error) {
+	return s.SmartAccount.SendUserOperation(ctx, calls, s.Network, opts)
+}
+
+// Transfer sends amount of token to the address to in a user operation, and
+// returns the submitted operation. The token and amount are interpreted as in
+// NetworkScopedEvmAccount.Transfer.
+func (s *NetworkScopedSmartAccount) Transfer(ctx context.Context, to string, amount *big.Int, token string, opts UserOperationOptions) (*UserOperation, error) {
+	tx, err := transferTransaction(s.Network, to, amount, token)
+	if err != nil {
+		return nil, err
+	}
+	call, err := s.client.NewEvmCall(s.Network, tx.To, tx.Value, tx.Data)
+	if err != nil {
+		return nil, err
+	}
+	return s.SendUserOperation(ctx, []openapi.EvmCall{call}, opts)
+}
+
+// Balance returns the account's balance of token, in the token's smallest unit.
+// The token is the network's native token symbol, the symbol of a token known to
+// the SDK, or an ERC-20 contract address.
+func (s *NetworkScopedSmartAccount) Balance(ctx context.Context, token string) (*big.Int, error) {
+	return s.client.balanceOf(ctx, s.Network, s.Address, token)
+}
+
+// IsDeployed reports whether the account's contract is deployed on the account's
+// network. See SmartAccount.IsDeployed.
+func (s *NetworkScopedSmartAccount) IsDeployed(ctx context.Context) (bool, error) {
+	return s.SmartAccount.IsDeployed(ctx, s.Network)
+}
+
+// Deploy deploys the account's contract on the account's network by sending an
+// empty user operation, and returns the submitted operation; wait for it with
+// WaitForUserOperation. It returns nil if the account is already deployed.
+func (s *NetworkScopedSmartAccount) Deploy(ctx context.Context, opts UserOperationOptions) (*UserOperation, error) {
+	deployed, err := s.IsDeployed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deployed {
+		return nil, nil
+	}
+	return s.SendUserOperation(ctx, []openapi.EvmCall{{To: s.Address, Value: "0", Data: "0x"}}, opts)
+}
+
+// balanceOf returns the balance of token held by address on network.
+func (c *Client) balanceOf(ctx context.Context, network, address, token string) (*big.Int, error) {
+	owner, err := addressWord(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(token, nativeSymbol(network)) {
+		var balance string
+		if err := c.rpcCall(ctx, network, &balance, "eth_getBalance", address, "latest"); err != nil {
+			return nil, fmt.Errorf("failed to get balance: %w", err)
+		}
+		return ParseAmount(balance)
+	}
+
+	contract := token
+	if known, ok := lookupTokenBySymbol(network, token); ok {
+		contract = known.Address
+	} else if _, err := addressWord(token); err != nil {
+		return nil, fmt.Errorf("unknown token %q on %s", token, network)
+	}
+
+	selector, _ := hex.DecodeString(erc20BalanceOfSelector)
+	result, err := c.ethCall(ctx, network, contract, append(selector, owner...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance of token %s: %w", contract, err)
+	}
+	if len(result) != abiWordSize {
+		return nil, fmt.Errorf("%w: %s on %s returned an invalid balance", ErrNotERC20, contract, network)
+	}
+	return wordToBigInt(result), nil
+}
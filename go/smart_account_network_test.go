@@ -0,0 +1,89 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// newScopedSmartAccountServers returns an API server that echoes prepared user
+// operations back and records their networks, and a JSON-RPC server where the
+// account has no code, 100 wei and 42 units of every token.
+func newScopedSmartAccountServers(t *testing.T) (api, rpc *httptest.Server, networks *[]string) {
+	t.Helper()
+	networks = new([]string)
+	api = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body openapi.PrepareAndSendUserOperationJSONRequestBody
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		*networks = append(*networks, string(body.Network))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(openapi.EvmUserOperation{
+			Calls:      body.Calls,
+			Network:    body.Network,
+			Status:     openapi.EvmUserOperationStatusBroadcast,
+			UserOpHash: "0xop",
+		})
+	}))
+	t.Cleanup(api.Close)
+
+	rpc = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Method string }
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		result := map[string]string{
+			"eth_getCode":    "0x",
+			"eth_getBalance": "0x64",
+			"eth_call":       fmt.Sprintf("0x%064x", 42),
+		}[req.Method]
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, result)
+	}))
+	t.Cleanup(rpc.Close)
+	return api, rpc, networks
+}
+
+func TestNetworkScopedSmartAccount(t *testing.T) {
+	api, rpc, networks := newScopedSmartAccountServers(t)
+	client := newReceiptTestClient(t, api.URL, rpc.URL)
+	account := NewSmartAccount(client, testOwner, testOtherOwner).OnNetwork("base-sepolia")
+	ctx := context.Background()
+
+	op, err := account.Transfer(ctx, testRecipient, nil, "usdc", UserOperationOptions{})
+	if err == nil {
+		t.Fatal("expected an error for a nil amount")
+	}
+	if op, err = account.Transfer(ctx, testRecipient, big.NewInt(1000), "usdc", UserOperationOptions{}); err != nil {
+		t.Fatalf("Transfer returned an error: %v", err)
+	}
+	if len(op.Calls) != 1 || !strings.EqualFold(op.Calls[0].To, "0x036CbD53842c5426634e7929541eC2318f3dCF7e") {
+		t.Errorf("unexpected transfer calls %+v", op.Calls)
+	}
+
+	if deployed, err := account.IsDeployed(ctx); err != nil || deployed {
+		t.Errorf("IsDeployed = %v, %v; want false", deployed, err)
+	}
+	if op, err := account.Deploy(ctx, UserOperationOptions{}); err != nil || op == nil || op.Calls[0].To != testOwner {
+		t.Errorf("Deploy = %+v, %v", op, err)
+	}
+
+	if balance, err := account.Balance(ctx, "eth"); err != nil || balance.Int64() != 100 {
+		t.Errorf("Balance(eth) = %v, %v; want 100", balance, err)
+	}
+	if balance, err := account.Balance(ctx, "usdc"); err != nil || balance.Int64() != 42 {
+		t.Errorf("Balance(usdc) = %v, %v; want 42", balance, err)
+	}
+
+	for _, network := range *networks {
+		if network != "base-sepolia" {
+			t.Errorf("user operation sent on %s, want base-sepolia", network)
+		}
+	}
+	if len(*networks) != 2 {
+		t.Errorf("sent %d user operations, want 2", len(*networks))
+	}
+}
@@ -0,0 +1,209 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testOwner      = "0x1111111111111111111111111111111111111111"
+	testOtherOwner = "0x2222222222222222222222222222222222222222"
+)
+
+// fakeAccountServer serves the by-name and create endpoints for EVM accounts and
+// smart accounts from in-memory maps keyed by name. Creating an account with a
+// name that is taken fails with 409 Conflict.
+type fakeAccountServer struct {
+	mu       sync.Mutex
+	accounts map[string]string   // name -> address
+	smart    map[string][]string // name -> owners
+	created  []string
+}
+
+func newFakeAccountServer(t *testing.T) (*fakeAccountServer, *Client) {
+	t.Helper()
+	f := &fakeAccountServer{accounts: map[string]string{}, smart: map[string][]string{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/v2/evm/accounts/by-name/", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		name := strings.TrimPrefix(r.URL.Path, "/platform/v2/evm/accounts/by-name/")
+		address, ok := f.accounts[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q,"name":%q}`, address, name)
+	})
+	mux.HandleFunc("/platform/v2/evm/accounts", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var body struct{ Name string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := f.accounts[body.Name]; ok {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errorType":"already_exists","errorMessage":"name taken"}`)
+			return
+		}
+		address := fmt.Sprintf("0x%040x", len(f.accounts)+1)
+		f.accounts[body.Name] = address
+		f.created = append(f.created, body.Name)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"address":%q,"name":%q}`, address, body.Name)
+	})
+	mux.HandleFunc("/platform/v2/evm/smart-accounts/by-name/", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		name := strings.TrimPrefix(r.URL.Path, "/platform/v2/evm/smart-accounts/by-name/")
+		owners, ok := f.smart[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"address": smartAddress(name), "name": name, "owners": owners,
+		})
+	})
+	mux.HandleFunc("/platform/v2/evm/smart-accounts", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var body struct {
+			Name   string
+			Owners []string
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := f.smart[body.Name]; ok {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errorType":"already_exists","errorMessage":"name taken"}`)
+			return
+		}
+		f.smart[body.Name] = body.Owners
+		f.created = append(f.created, body.Name)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"address": smartAddress(body.Name), "name": body.Name, "owners": body.Owners,
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return f, newTestClient(t, server.URL+"/platform")
+}
+
+func smartAddress(name string) string {
+	return fmt.Sprintf("0x%040x", len(name))
+}
+
+func TestGetOrCreateSmartAccountCreatesWhenMissing(t *testing.T) {
+	f, client := newFakeAccountServer(t)
+
+	account, err := client.GetOrCreateSmartAccount(context.Background(), testOwner, CreateOptions{Name: "treasury"})
+	if err != nil {
+		t.Fatalf("GetOrCreateSmartAccount returned an error: %v", err)
+	}
+	if account.Name != "treasury" || account.Owner != testOwner {
+		t.Errorf("unexpected account %+v", account)
+	}
+	if len(f.created) != 1 {
+		t.Errorf("expected one account to be created, got %v", f.created)
+	}
+}
+
+func TestGetOrCreateSmartAccountReuse(t *testing.T) {
+	f, client := newFakeAccountServer(t)
+	f.smart["treasury"] = []string{testOwner}
+	f.smart["ops"] = []string{testOtherOwner}
+
+	account, err := client.GetOrCreateSmartAccount(context.Background(), strings.ToUpper(testOwner[:2])+testOwner[2:], CreateOptions{Name: "treasury"})
+	if err != nil {
+		t.Fatalf("expected a compatible account to be reused, got %v", err)
+	}
+	if account.Name != "treasury" {
+		t.Errorf("Name = %q, want %q", account.Name, "treasury")
+	}
+
+	_, err = client.GetOrCreateSmartAccount(context.Background(), testOwner, CreateOptions{Name: "ops"})
+	if !errors.Is(err, ErrNameCollision) {
+		t.Fatalf("expected ErrNameCollision for an account with another owner, got %v", err)
+	}
+	if len(f.created) != 0 {
+		t.Errorf("expected no accounts to be created, got %v", f.created)
+	}
+}
+
+func TestGetOrCreateSmartAccountFail(t *testing.T) {
+	f, client := newFakeAccountServer(t)
+	f.smart["treasury"] = []string{testOwner}
+
+	_, err := client.GetOrCreateSmartAccount(context.Background(), testOwner, CreateOptions{
+		Name:            "treasury",
+		OnNameCollision: NameCollisionFail,
+	})
+	if !errors.Is(err, ErrNameCollision) {
+		t.Fatalf("expected ErrNameCollision, got %v", err)
+	}
+}
+
+func TestGetOrCreateSmartAccountSuffix(t *testing.T) {
+	f, client := newFakeAccountServer(t)
+	f.smart["treasury"] = []string{testOtherOwner}
+	f.smart["treasury-2"] = []string{testOtherOwner}
+
+	opts := CreateOptions{Name: "treasury", OnNameCollision: NameCollisionSuffix}
+	account, err := client.GetOrCreateSmartAccount(context.Background(), testOwner, opts)
+	if err != nil {
+		t.Fatalf("GetOrCreateSmartAccount returned an error: %v", err)
+	}
+	if account.Name != "treasury-3" {
+		t.Errorf("Name = %q, want %q", account.Name, "treasury-3")
+	}
+
+	// Re-running must resolve to the same account rather than creating another.
+	again, err := client.GetOrCreateSmartAccount(context.Background(), testOwner, opts)
+	if err != nil {
+		t.Fatalf("second GetOrCreateSmartAccount returned an error: %v", err)
+	}
+	if again.Address != account.Address {
+		t.Errorf("second call returned %s, want %s", again.Address, account.Address)
+	}
+	if len(f.created) != 1 {
+		t.Errorf("expected one account to be created, got %v", f.created)
+	}
+}
+
+func TestSuffixedNameRespectsMaxLength(t *testing.T) {
+	name := strings.Repeat("a", maxAccountNameLength)
+	got := suffixedName(name, 12)
+	if len(got) != maxAccountNameLength || !strings.HasSuffix(got, "-12") {
+		t.Errorf("suffixedName = %q", got)
+	}
+}
+
+func TestNewSmartAccountMakesNoRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+	client, err := NewClient(ClientOptions{BasePath: server.URL + "/platform"})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	account := NewSmartAccount(client, testOtherOwner, testOwner)
+	if account.Address != testOtherOwner || account.Owner != testOwner || account.Name != "" {
+		t.Errorf("got %+v", account)
+	}
+}
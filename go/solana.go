@@ -0,0 +1,237 @@
+package cdp
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrInvalidSolanaAddress is returned when an address is not a base58-encoded
+// 32-byte Solana public key.
+var ErrInvalidSolanaAddress = errors.New("invalid Solana address")
+
+// SolanaDevnet is the Solana network the faucet funds.
+const SolanaDevnet = "solana-devnet"
+
+// base58Alphabet is the Bitcoin base58 alphabet Solana addresses are encoded in.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// solanaPublicKeySize is the size of a Solana public key in bytes.
+const solanaPublicKeySize = 32
+
+// SolanaAccount is a handle to a CDP-managed Solana account.
+type SolanaAccount struct {
+	client *Client
+
+	// Address is the account's base58-encoded address.
+	Address string
+	// Name is the account's name, if it has one.
+	Name string
+	// Policies are the IDs of the policies that apply to the account, including
+	// the project-level policy, as of when the handle was fetched.
+	Policies []string
+}
+
+// newSolanaAccount converts an API account into a SolanaAccount handle.
+func newSolanaAccount(client *Client, account *openapi.SolanaAccount) *SolanaAccount {
+	a := &SolanaAccount{client: client, Address: account.Address}
+	if account.Name != nil {
+		a.Name = *account.Name
+	}
+	if account.Policies != nil {
+		a.Policies = *account.Policies
+	}
+	return a
+}
+
+// isSolanaNetwork reports whether network is a Solana network, such as
+// solana-devnet or the mainnet "solana". Only SolanaDevnet has a faucet.
+func isSolanaNetwork(network string) bool {
+	return strings.HasPrefix(network, "solana")
+}
+
+// ValidateSolanaAddress returns an error wrapping ErrInvalidSolanaAddress if
+// address is not a base58-encoded 32-byte Solana public key.
+func ValidateSolanaAddress(address string) error {
+	key, err := decodeBase58(address)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidSolanaAddress, address, err)
+	}
+	if len(key) != solanaPublicKeySize {
+		return fmt.Errorf("%w %q: decodes to %d bytes, want %d", ErrInvalidSolanaAddress, address, len(key), solanaPublicKeySize)
+	}
+	return nil
+}
+
+// decodeBase58 decodes a base58 string, in which each leading '1' encodes a
+// leading zero byte.
+func decodeBase58(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty base58 string")
+	}
+	n := new(big.Int)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+	for i, c := range s {
+		digit := strings.IndexRune(base58Alphabet, c)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q at position %d", c, i)
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+	zeros := len(s) - len(strings.TrimLeft(s, "1"))
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// GetSolanaAccount returns the Solana account at address. It returns an error
+// wrapping ErrInvalidSolanaAddress without making a request if address is not a
+// valid Solana address.
+func (c *Client) GetSolanaAccount(ctx context.Context, address string) (*SolanaAccount, error) {
+	if err := ValidateSolanaAddress(address); err != nil {
+		return nil, err
+	}
+	resp, err := c.GetSolanaAccountWithResponse(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Solana account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, unexpectedResponseError("get Solana account", resp.HTTPResponse, resp.Body)
+	}
+	return newSolanaAccount(c, resp.JSON200), nil
+}
+
+// GetOrCreateSolanaAccount returns the Solana account with the given name,
+// creating it if it does not exist. Like GetOrCreateEvmAccount, any existing
+// Solana account is compatible, so NameCollisionReuse and NameCollisionSuffix
+// both reuse it, and it is safe to call concurrently for the same name.
+func (c *Client) GetOrCreateSolanaAccount(ctx context.Context, opts CreateOptions) (*SolanaAccount, error) {
+	if opts.Name == "" || opts.OnNameCollision == NameCollisionFail {
+		return c.getOrCreateSolanaAccount(ctx, opts)
+	}
+	account, err := c.solanaAccountFlights.do(ctx, opts.Name, func() (*SolanaAccount, error) {
+		return c.getOrCreateSolanaAccount(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Give each caller its own handle.
+	handle := *account
+	return &handle, nil
+}
+
+// getOrCreateSolanaAccount implements GetOrCreateSolanaAccount for a single
+// caller.
+func (c *Client) getOrCreateSolanaAccount(ctx context.Context, opts CreateOptions) (*SolanaAccount, error) {
+	existing, err := c.getSolanaAccountByName(ctx, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		account, err := c.createSolanaAccount(ctx, opts.Name)
+		if !isConflict(err) {
+			return account, err
+		}
+		// Another client created the account since it was looked up.
+		if existing, err = c.getSolanaAccountByName(ctx, opts.Name); err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, fmt.Errorf("failed to create Solana account: name %q is taken but the account was not found", opts.Name)
+		}
+	}
+
+	if opts.OnNameCollision == NameCollisionFail {
+		return nil, fmt.Errorf("%w: a Solana account named %q already exists", ErrNameCollision, opts.Name)
+	}
+	return newSolanaAccount(c, existing), nil
+}
+
+// getSolanaAccountByName returns the Solana account with the given name, or nil
+// if none exists.
+func (c *Client) getSolanaAccountByName(ctx context.Context, name string) (*openapi.SolanaAccount, error) {
+	if name == "" {
+		return nil, nil
+	}
+	resp, err := c.GetSolanaAccountByNameWithResponse(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Solana account: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, unexpectedResponseError("get Solana account", resp.HTTPResponse, resp.Body)
+	}
+}
+
+// createSolanaAccount creates a Solana account with the given name.
+func (c *Client) createSolanaAccount(ctx context.Context, name string) (*SolanaAccount, error) {
+	body := openapi.CreateSolanaAccountJSONRequestBody{}
+	if name != "" {
+		body.Name = &name
+	}
+
+	resp, err := c.CreateSolanaAccountWithResponse(ctx, nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Solana account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated || resp.JSON201 == nil {
+		return nil, unexpectedStatusError("create Solana account", resp.StatusCode(), resp.Body)
+	}
+
+	return newSolanaAccount(c, resp.JSON201), nil
+}
+
+// SignMessage signs message with the account's ed25519 key and returns the
+// base58-encoded signature.
+func (a *SolanaAccount) SignMessage(ctx context.Context, message []byte) (string, error) {
+	resp, err := a.client.SignSolanaMessageWithResponse(ctx, a.Address, nil, openapi.SignSolanaMessageJSONRequestBody{
+		Message: string(message),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign message: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return "", unexpectedStatusError("sign message", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.Signature, nil
+}
+
+// SignTransaction signs transaction, a base64-encoded serialized Solana
+// transaction, with the account, and returns the base64-encoded signed
+// transaction. The transaction is not sent.
+func (a *SolanaAccount) SignTransaction(ctx context.Context, transaction string) (string, error) {
+	if _, err := base64.StdEncoding.DecodeString(transaction); err != nil {
+		return "", fmt.Errorf("invalid transaction: not base64-encoded: %w", err)
+	}
+	resp, err := a.client.SignSolanaTransactionWithResponse(ctx, a.Address, nil, openapi.SignSolanaTransactionJSONRequestBody{
+		Transaction: transaction,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return "", unexpectedStatusError("sign transaction", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.SignedTransaction, nil
+}
+
+// RequestFaucet requests devnet funds of token ("sol", the default if empty, or
+// "usdc") for the account on solana-devnet and returns the signature of the
+// funding transaction. It is Client.RequestFaucet for the account, and honors
+// ClientOptions.FaucetCooldown in the same way.
+func (a *SolanaAccount) RequestFaucet(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		token = string(openapi.RequestSolanaFaucetJSONBodyTokenSol)
+	}
+	return a.client.RequestFaucet(ctx, FaucetRequest{Address: a.Address, Network: SolanaDevnet, Token: token})
+}
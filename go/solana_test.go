@@ -0,0 +1,197 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// testSolanaAddress is the address of the wrapped SOL mint.
+const testSolanaAddress = "So11111111111111111111111111111111111111112"
+
+func TestValidateSolanaAddress(t *testing.T) {
+	for _, address := range []string{testSolanaAddress, "11111111111111111111111111111111"} {
+		if err := ValidateSolanaAddress(address); err != nil {
+			t.Errorf("ValidateSolanaAddress(%q) returned an error: %v", address, err)
+		}
+	}
+
+	for _, address := range []string{"", testOwner, "So1111111111111111111111111111111111111111O", "abc", testSolanaAddress + "2"} {
+		err := ValidateSolanaAddress(address)
+		if !errors.Is(err, ErrInvalidSolanaAddress) {
+			t.Errorf("ValidateSolanaAddress(%q) = %v, want ErrInvalidSolanaAddress", address, err)
+		}
+	}
+}
+
+// newSolanaServer serves the Solana account, signing and faucet endpoints. The
+// account named "existing" exists; other names are created on request.
+func newSolanaServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/v2/solana/accounts/by-name/existing":
+			fmt.Fprintf(w, `{"address":%q,"name":"existing","policies":["p1"]}`, testSolanaAddress)
+		case strings.HasPrefix(r.URL.Path, "/v2/solana/accounts/by-name/"):
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errorType":"not_found","errorMessage":"not found"}`)
+		case r.URL.Path == "/v2/solana/accounts" && r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"address":%q,"name":%q}`, testSolanaAddress, body["name"])
+		case r.URL.Path == "/v2/solana/accounts/"+testSolanaAddress:
+			fmt.Fprintf(w, `{"address":%q}`, testSolanaAddress)
+		case strings.HasSuffix(r.URL.Path, "/sign/message"):
+			fmt.Fprintf(w, `{"signature":"sig-%s"}`, body["message"])
+		case strings.HasSuffix(r.URL.Path, "/sign/transaction"):
+			fmt.Fprintf(w, `{"signedTransaction":"signed-%s"}`, body["transaction"])
+		case r.URL.Path == "/v2/solana/faucet":
+			if body["address"] != testSolanaAddress {
+				t.Errorf("faucet requested for %q", body["address"])
+			}
+			fmt.Fprintf(w, `{"transactionSignature":"faucet-%s"}`, body["token"])
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestGetOrCreateSolanaAccount(t *testing.T) {
+	server, requests := newSolanaServer(t)
+	client := newTestClient(t, server.URL)
+	ctx := context.Background()
+
+	existing, err := client.GetOrCreateSolanaAccount(ctx, CreateOptions{Name: "existing"})
+	if err != nil {
+		t.Fatalf("GetOrCreateSolanaAccount returned an error: %v", err)
+	}
+	if existing.Address != testSolanaAddress || existing.Name != "existing" || len(existing.Policies) != 1 {
+		t.Errorf("unexpected account %+v", existing)
+	}
+
+	created, err := client.GetOrCreateSolanaAccount(ctx, CreateOptions{Name: "fresh"})
+	if err != nil || created.Name != "fresh" {
+		t.Fatalf("GetOrCreateSolanaAccount = %+v, %v", created, err)
+	}
+	want := []string{
+		"GET /v2/solana/accounts/by-name/existing",
+		"GET /v2/solana/accounts/by-name/fresh",
+		"POST /v2/solana/accounts",
+	}
+	if strings.Join(*requests, ",") != strings.Join(want, ",") {
+		t.Errorf("requests = %q, want %q", *requests, want)
+	}
+
+	if _, err := client.GetOrCreateSolanaAccount(ctx, CreateOptions{Name: "existing", OnNameCollision: NameCollisionFail}); !errors.Is(err, ErrNameCollision) {
+		t.Errorf("expected ErrNameCollision, got %v", err)
+	}
+}
+
+func TestGetSolanaAccountRejectsInvalidAddress(t *testing.T) {
+	server, requests := newSolanaServer(t)
+	client := newTestClient(t, server.URL)
+
+	if _, err := client.GetSolanaAccount(context.Background(), testOwner); !errors.Is(err, ErrInvalidSolanaAddress) {
+		t.Errorf("expected ErrInvalidSolanaAddress, got %v", err)
+	}
+	if len(*requests) != 0 {
+		t.Errorf("expected no requests, got %q", *requests)
+	}
+
+	account, err := client.GetSolanaAccount(context.Background(), testSolanaAddress)
+	if err != nil || account.Address != testSolanaAddress {
+		t.Errorf("GetSolanaAccount = %+v, %v", account, err)
+	}
+}
+
+func TestSolanaAccountSigning(t *testing.T) {
+	server, requests := newSolanaServer(t)
+	account := &SolanaAccount{client: newTestClient(t, server.URL), Address: testSolanaAddress}
+	ctx := context.Background()
+
+	signature, err := account.SignMessage(ctx, []byte("hello"))
+	if err != nil || signature != "sig-hello" {
+		t.Errorf("SignMessage = %q, %v", signature, err)
+	}
+	signed, err := account.SignTransaction(ctx, "AQID")
+	if err != nil || signed != "signed-AQID" {
+		t.Errorf("SignTransaction = %q, %v", signed, err)
+	}
+
+	if _, err := account.SignTransaction(ctx, "not base64!"); err == nil {
+		t.Error("expected an error for a transaction that is not base64")
+	}
+	if len(*requests) != 2 {
+		t.Errorf("expected 2 requests, got %q", *requests)
+	}
+}
+
+func TestSolanaAccountRequestFaucet(t *testing.T) {
+	server, requests := newSolanaServer(t)
+	client := newTestClient(t, server.URL)
+	account := &SolanaAccount{client: client, Address: testSolanaAddress}
+
+	signature, err := account.RequestFaucet(context.Background(), "")
+	if err != nil || signature != "faucet-sol" {
+		t.Errorf("RequestFaucet = %q, %v", signature, err)
+	}
+	signature, err = account.RequestFaucet(context.Background(), "USDC")
+	if err != nil || signature != "faucet-usdc" {
+		t.Errorf("RequestFaucet = %q, %v", signature, err)
+	}
+
+	invalid := &SolanaAccount{client: client, Address: "not-an-address"}
+	if _, err := invalid.RequestFaucet(context.Background(), "sol"); !errors.Is(err, ErrInvalidSolanaAddress) {
+		t.Errorf("expected ErrInvalidSolanaAddress, got %v", err)
+	}
+	if len(*requests) != 2 {
+		t.Errorf("expected 2 requests, got %q", *requests)
+	}
+}
+
+func TestSolanaFaucetUnavailable(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"errorType":"faucet_limit_exceeded","errorMessage":"slow down"}`)
+	}))
+	defer server.Close()
+
+	account := &SolanaAccount{client: newTestClient(t, server.URL), Address: testSolanaAddress}
+	_, err := account.RequestFaucet(context.Background(), "sol")
+	var unavailable *FaucetUnavailableError
+	if !errors.As(err, &unavailable) || unavailable.Network != SolanaDevnet || unavailable.RetryAfter.Seconds() != 7 {
+		t.Errorf("expected a *FaucetUnavailableError for solana-devnet, got %v", err)
+	}
+}
+
+func TestRequestFaucetRejectsOtherSolanaNetworks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	for _, network := range []string{"solana", "solana-testnet"} {
+		_, err := client.RequestFaucet(context.Background(), FaucetRequest{Address: testSolanaAddress, Network: network, Token: "sol"})
+		if err == nil || !strings.Contains(err.Error(), SolanaDevnet) {
+			t.Errorf("expected an error for %s naming %s, got %v", network, SolanaDevnet, err)
+		}
+	}
+}
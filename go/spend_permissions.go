@@ -0,0 +1,176 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// spendPermissionManagerAddress is the address of the SpendPermissionManager
+// contract, which is deployed at the same address on every supported network.
+const spendPermissionManagerAddress = "0xf85210B21cC50302F477BA56686d2019dC9b67Ad"
+
+// ErrSpendPermissionNotFound is returned when a spend permission does not exist.
+var ErrSpendPermissionNotFound = errors.New("spend permission not found")
+
+// SpendPermissionStatus describes how much of a spend permission's allowance has
+// been used in its current period.
+type SpendPermissionStatus struct {
+	// PermissionHash is the hash identifying the spend permission.
+	PermissionHash string
+	// Network is the network the spend permission is valid on.
+	Network string
+	// Token is the address of the token the permission allows spending.
+	Token string
+	// Spender is the address allowed to spend.
+	Spender string
+	// Revoked is true if the permission has been revoked. The usage fields of a
+	// revoked permission are zero.
+	Revoked bool
+	// Allowance is the amount the spender may spend per period, in the token's
+	// smallest unit.
+	Allowance *big.Int
+	// Spent is the amount already spent in the current period.
+	Spent *big.Int
+	// Remaining is the amount that may still be spent in the current period.
+	Remaining *big.Int
+	// PeriodStart is when the current period started.
+	PeriodStart time.Time
+	// ResetsAt is when the current period ends and the allowance resets.
+	ResetsAt time.Time
+}
+
+// GetSpendPermissionStatus returns the usage of the spend permission identified by
+// permissionHash on the given smart account. The permission is looked up through the
+// CDP API, and its usage in the current period is read from the SpendPermissionManager
+// contract over the network's JSON-RPC endpoint (see ClientOptions.RPCURLs).
+//
+// Spend permissions are listed per account, so the account that granted the
+// permission is required. If no such permission exists, the returned error
+// matches ErrSpendPermissionNotFound.
+func (c *Client) GetSpendPermissionStatus(ctx context.Context, account, permissionHash string) (*SpendPermissionStatus, error) {
+	permission, err := c.findSpendPermission(ctx, account, permissionHash)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, ok := new(big.Int).SetString(permission.Permission.Allowance, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid spend permission allowance %q", permission.Permission.Allowance)
+	}
+
+	status := &SpendPermissionStatus{
+		PermissionHash: permission.PermissionHash,
+		Network:        string(permission.Network),
+		Token:          permission.Permission.Token,
+		Spender:        permission.Permission.Spender,
+		Revoked:        permission.Revoked,
+		Allowance:      allowance,
+		Spent:          new(big.Int),
+		Remaining:      new(big.Int),
+	}
+	if permission.Revoked {
+		return status, nil
+	}
+
+	calldata, err := encodeGetCurrentPeriod(permission.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.ethCall(ctx, status.Network, spendPermissionManagerAddress, calldata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spend permission period: %w", err)
+	}
+	if len(result) < 3*abiWordSize {
+		return nil, fmt.Errorf("unexpected getCurrentPeriod result length %d", len(result))
+	}
+
+	status.PeriodStart = time.Unix(wordToBigInt(result[:abiWordSize]).Int64(), 0)
+	status.ResetsAt = time.Unix(wordToBigInt(result[abiWordSize:2*abiWordSize]).Int64(), 0)
+	status.Spent = wordToBigInt(result[2*abiWordSize : 3*abiWordSize])
+	if status.Spent.Cmp(allowance) < 0 {
+		status.Remaining = new(big.Int).Sub(allowance, status.Spent)
+	}
+
+	return status, nil
+}
+
+// findSpendPermission pages through the spend permissions of account looking for
+// the one with the given hash.
+func (c *Client) findSpendPermission(ctx context.Context, account, permissionHash string) (*openapi.SpendPermissionResponseObject, error) {
+	params := &openapi.ListSpendPermissionsParams{}
+	for {
+		resp, err := c.ListSpendPermissionsWithResponse(ctx, account, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list spend permissions: %w", err)
+		}
+
+		if resp.StatusCode() == http.StatusNotFound {
+			return nil, ErrSpendPermissionNotFound
+		}
+		if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+			return nil, unexpectedStatusError("list spend permissions", resp.StatusCode(), resp.Body)
+		}
+
+		for i, permission := range resp.JSON200.SpendPermissions {
+			if strings.EqualFold(permission.PermissionHash, permissionHash) {
+				return &resp.JSON200.SpendPermissions[i], nil
+			}
+		}
+
+		if resp.JSON200.NextPageToken == nil || *resp.JSON200.NextPageToken == "" {
+			return nil, ErrSpendPermissionNotFound
+		}
+		params.PageToken = resp.JSON200.NextPageToken
+	}
+}
+
+// encodeGetCurrentPeriod ABI-encodes a call to SpendPermissionManager.getCurrentPeriod.
+func encodeGetCurrentPeriod(p openapi.SpendPermission) ([]byte, error) {
+	var head []byte
+	for _, address := range []string{p.Account, p.Spender, p.Token} {
+		word, err := addressWord(address)
+		if err != nil {
+			return nil, err
+		}
+		head = append(head, word...)
+	}
+
+	for _, field := range []struct{ name, value string }{
+		{"allowance", p.Allowance},
+		{"period", p.Period},
+		{"start", p.Start},
+		{"end", p.End},
+		{"salt", p.Salt},
+	} {
+		v, ok := new(big.Int).SetString(field.value, 10)
+		if !ok || v.Sign() < 0 {
+			return nil, fmt.Errorf("invalid spend permission %s %q", field.name, field.value)
+		}
+		head = append(head, uintWord(v)...)
+	}
+
+	extraData, err := decodeHexData(p.ExtraData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spend permission extraData: %w", err)
+	}
+
+	// The struct contains dynamic bytes, so it is encoded by reference: the call's
+	// single argument is the offset of the struct, and extraData is encoded after
+	// the struct's nine head words.
+	selector, _ := hex.DecodeString(getCurrentPeriodSelector)
+	calldata := append(selector, uintWord(big.NewInt(abiWordSize))...)
+	calldata = append(calldata, head...)
+	calldata = append(calldata, uintWord(big.NewInt(9*abiWordSize))...)
+	calldata = append(calldata, bytesTail(extraData)...)
+
+	return calldata, nil
+}
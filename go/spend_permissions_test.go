@@ -0,0 +1,97 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testPermissionHash = "0x0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func newSpendPermissionServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/v2/evm/smart-accounts/", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"spendPermissions":[{
+			"createdAt":"2025-01-01T00:00:00Z",
+			"network":"base-sepolia",
+			"permissionHash":%q,
+			"revoked":false,
+			"permission":{
+				"account":"0x1111111111111111111111111111111111111111",
+				"spender":"0x2222222222222222222222222222222222222222",
+				"token":"0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE",
+				"allowance":"100",
+				"period":"86400",
+				"start":"0",
+				"end":"281474976710655",
+				"salt":"0",
+				"extraData":"0x"
+			}
+		}]}`, testPermissionHash)
+	})
+	mux.HandleFunc("/rpc", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode RPC request: %v", err)
+		}
+		var call map[string]string
+		_ = json.Unmarshal(req.Params[0], &call)
+
+		if req.Method != "eth_call" || !strings.EqualFold(call["to"], spendPermissionManagerAddress) {
+			t.Errorf("unexpected RPC call %s to %s", req.Method, call["to"])
+		}
+		if !strings.HasPrefix(call["data"], "0x"+getCurrentPeriodSelector) {
+			t.Errorf("unexpected calldata %s", call["data"])
+		}
+
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%064x%064x%064x"}`, 1000, 87400, 30)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetSpendPermissionStatus(t *testing.T) {
+	server := newSpendPermissionServer(t)
+
+	client := newTestClientWithOptions(t, server.URL+"/platform", ClientOptions{
+		RPCURLs: map[string]string{"base-sepolia": server.URL + "/rpc"},
+	})
+
+	status, err := client.GetSpendPermissionStatus(context.Background(), "0x1111111111111111111111111111111111111111", testPermissionHash)
+	if err != nil {
+		t.Fatalf("GetSpendPermissionStatus returned an error: %v", err)
+	}
+
+	if status.Allowance.Int64() != 100 || status.Spent.Int64() != 30 || status.Remaining.Int64() != 70 {
+		t.Errorf("allowance/spent/remaining = %s/%s/%s, want 100/30/70", status.Allowance, status.Spent, status.Remaining)
+	}
+	if !status.PeriodStart.Equal(time.Unix(1000, 0)) || !status.ResetsAt.Equal(time.Unix(87400, 0)) {
+		t.Errorf("period = %s - %s, want %s - %s", status.PeriodStart, status.ResetsAt, time.Unix(1000, 0), time.Unix(87400, 0))
+	}
+	if status.Network != "base-sepolia" {
+		t.Errorf("Network = %q, want %q", status.Network, "base-sepolia")
+	}
+}
+
+func TestGetSpendPermissionStatusNotFound(t *testing.T) {
+	server := newSpendPermissionServer(t)
+	client := newTestClient(t, server.URL+"/platform")
+
+	_, err := client.GetSpendPermissionStatus(context.Background(), "0x1111111111111111111111111111111111111111", "0xdeadbeef")
+	if !errors.Is(err, ErrSpendPermissionNotFound) {
+		t.Fatalf("expected ErrSpendPermissionNotFound, got %v", err)
+	}
+}
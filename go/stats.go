@@ -0,0 +1,146 @@
+package cdp
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// rpcStatsKey is the Stats.Operations key of JSON-RPC calls to nodes.
+const rpcStatsKey = "json-rpc"
+
+// OperationStats counts the requests made for one operation.
+type OperationStats struct {
+	// Requests is the number of requests made, not counting retries.
+	Requests int64
+	// Errors is the number of requests that failed, after any retries, with an
+	// error or a 4xx or 5xx response.
+	Errors int64
+	// Retries is the number of times requests were retried.
+	Retries int64
+	// BytesSent is the total size of request bodies, including retries.
+	BytesSent int64
+	// BytesReceived is the total size of the response bodies read.
+	BytesReceived int64
+}
+
+// add adds the counts of o to s.
+func (s *OperationStats) add(o OperationStats) {
+	s.Requests += o.Requests
+	s.Errors += o.Errors
+	s.Retries += o.Retries
+	s.BytesSent += o.BytesSent
+	s.BytesReceived += o.BytesReceived
+}
+
+// Stats is a snapshot of a client's API usage, returned by Client.Stats.
+type Stats struct {
+	// Operations maps OpenAPI operation IDs (e.g. "CreateEvmAccount") to their
+	// counts. JSON-RPC calls to nodes are counted under "json-rpc".
+	Operations map[string]OperationStats
+	// Total sums the counts of all operations.
+	Total OperationStats
+}
+
+// Stats returns a snapshot of the client's API usage since it was created or
+// ResetStats was last called. It is empty unless ClientOptions.TrackStats is set.
+func (c *Client) Stats() Stats {
+	stats := Stats{Operations: map[string]OperationStats{}}
+	if c.stats == nil {
+		return stats
+	}
+
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+	for operation, s := range c.stats.operations {
+		snapshot := OperationStats{
+			Requests:      s.requests.Load(),
+			Errors:        s.errors.Load(),
+			Retries:       s.retries.Load(),
+			BytesSent:     s.bytesSent.Load(),
+			BytesReceived: s.bytesReceived.Load(),
+		}
+		stats.Operations[operation] = snapshot
+		stats.Total.add(snapshot)
+	}
+	return stats
+}
+
+// ResetStats clears the counts returned by Stats.
+func (c *Client) ResetStats() {
+	if c.stats == nil {
+		return
+	}
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+	clear(c.stats.operations)
+}
+
+// usageStats tracks a client's API usage per operation.
+type usageStats struct {
+	mu         sync.Mutex
+	operations map[string]*operationCounters
+}
+
+// operationCounters holds the live counts of one operation. They are updated
+// without holding usageStats.mu, so that response bodies read after a reset
+// update counters no longer reported rather than racing with it.
+type operationCounters struct {
+	requests, errors, retries, bytesSent, bytesReceived atomic.Int64
+}
+
+// counters returns the counters of the operation req belongs to.
+func (s *usageStats) counters(req *http.Request) *operationCounters {
+	key := rpcStatsKey
+	if operation, ok := OperationID(req.Context()); ok {
+		key = operation
+	} else if req.Context().Value(apiRequestKey{}) != nil {
+		key = "unknown"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counters, ok := s.operations[key]
+	if !ok {
+		counters = &operationCounters{}
+		s.operations[key] = counters
+	}
+	return counters
+}
+
+// statsTransport records each request and its outcome in stats. It sits above the
+// retryTransport, which records retries.
+type statsTransport struct {
+	next  http.RoundTripper
+	stats *usageStats
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *statsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	counters := t.stats.counters(req)
+	counters.requests.Add(1)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode >= 400 {
+		counters.errors.Add(1)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = &countingBody{ReadCloser: resp.Body, n: &counters.bytesReceived}
+	return resp, nil
+}
+
+// countingBody adds the number of bytes read from a response body to n.
+type countingBody struct {
+	io.ReadCloser
+	n *atomic.Int64
+}
+
+// Read implements io.Reader.
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n.Add(int64(n))
+	return n, err
+}
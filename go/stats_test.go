@@ -0,0 +1,96 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestClientStats(t *testing.T) {
+	setFastTimers(t)
+	const account = `{"address":"0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8"}`
+	var creates atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && creates.Add(1) == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, account)
+		case r.URL.Query().Get("pageSize") != "":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorType":"invalid_request","errorMessage":"bad page size"}`)
+		default:
+			fmt.Fprint(w, account)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{TrackStats: true, RetryOptions: RetryOptions{RetryNonIdempotent: true}})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetEvmAccountWithResponse(ctx, testOwner); err != nil {
+				t.Errorf("GetEvmAccount failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	name := "stats"
+	if _, err := client.CreateEvmAccountWithResponse(ctx, nil, openapi.CreateEvmAccountJSONRequestBody{Name: &name}); err != nil {
+		t.Fatalf("CreateEvmAccount failed: %v", err)
+	}
+	pageSize := 1000
+	if _, err := client.ListEvmAccountsWithResponse(ctx, &openapi.ListEvmAccountsParams{PageSize: &pageSize}); err != nil {
+		t.Fatalf("ListEvmAccounts failed: %v", err)
+	}
+
+	stats := client.Stats()
+	want := map[string]OperationStats{
+		"GetEvmAccount":    {Requests: 3, BytesReceived: 3 * int64(len(account))},
+		"CreateEvmAccount": {Requests: 1, Retries: 1, BytesSent: 2 * int64(len(`{"name":"stats"}`)), BytesReceived: int64(len(account))},
+	}
+	for operation, w := range want {
+		if got := stats.Operations[operation]; got != w {
+			t.Errorf("Stats for %s = %+v, want %+v", operation, got, w)
+		}
+	}
+	if got := stats.Operations["ListEvmAccounts"]; got.Requests != 1 || got.Errors != 1 {
+		t.Errorf("Stats for ListEvmAccounts = %+v, want 1 request and 1 error", got)
+	}
+	if stats.Total.Requests != 5 || stats.Total.Errors != 1 || stats.Total.Retries != 1 {
+		t.Errorf("Total = %+v, want 5 requests, 1 error and 1 retry", stats.Total)
+	}
+
+	client.ResetStats()
+	if stats := client.Stats(); len(stats.Operations) != 0 || stats.Total != (OperationStats{}) {
+		t.Errorf("Stats after ResetStats = %+v, want none", stats)
+	}
+}
+
+func TestClientStatsDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+	if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+		t.Fatalf("GetEvmAccount failed: %v", err)
+	}
+	if stats := client.Stats(); len(stats.Operations) != 0 || stats.Total.Requests != 0 {
+		t.Errorf("Stats without TrackStats = %+v, want none", stats)
+	}
+	client.ResetStats()
+}
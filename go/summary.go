@@ -0,0 +1,183 @@
+package cdp
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// CallKind classifies a call decoded by UserOperationSummary.
+type CallKind string
+
+const (
+	// CallKindNativeTransfer is a plain transfer of the network's native token.
+	CallKindNativeTransfer CallKind = "native_transfer"
+	// CallKindTokenTransfer is an ERC-20 transfer(address,uint256) call.
+	CallKindTokenTransfer CallKind = "token_transfer"
+	// CallKindTokenApproval is an ERC-20 approve(address,uint256) call.
+	CallKindTokenApproval CallKind = "token_approval"
+	// CallKindTransferFrom is an ERC-20 or ERC-721 transferFrom(address,address,uint256) call.
+	CallKindTransferFrom CallKind = "transfer_from"
+	// CallKindNFTTransfer is an ERC-721 safeTransferFrom call.
+	CallKindNFTTransfer CallKind = "nft_transfer"
+	// CallKindNFTApprovalForAll is an ERC-721 setApprovalForAll(address,bool) call.
+	CallKindNFTApprovalForAll CallKind = "nft_approval_for_all"
+	// CallKindUnknown is a call that could not be decoded.
+	CallKindUnknown CallKind = "unknown"
+)
+
+// CallSummary is a human-readable description of a single call in a user operation.
+type CallSummary struct {
+	// Kind classifies the call.
+	Kind CallKind
+	// Description is a human-readable summary of the call, e.g. "Send 0.1 ETH to 0x…".
+	Description string
+	// To is the address the call is made to.
+	To string
+	// Value is the amount of native token, in wei, sent with the call.
+	Value string
+	// Data is the raw calldata of the call.
+	Data string
+}
+
+// UserOperationSummary decodes the calls of a prepared user operation into
+// human-readable summaries, one per call and in the same order, suitable for
+// user confirmation screens.
+//
+// Native transfers and common ERC-20 and ERC-721 calls are decoded. Amounts of
+// tokens the SDK knows about on the operation's network (e.g. USDC) are formatted
+// with the token's decimals and symbol; other amounts are shown in raw units.
+// Calls that cannot be decoded fall back to a description containing the raw data.
+func UserOperationSummary(op *openapi.EvmUserOperation) []CallSummary {
+	if op == nil {
+		return nil
+	}
+
+	network := string(op.Network)
+	summaries := make([]CallSummary, len(op.Calls))
+	for i, call := range op.Calls {
+		summaries[i] = summarizeCall(network, call)
+	}
+	return summaries
+}
+
+// summarizeCall decodes a single call made on the given network.
+func summarizeCall(network string, call openapi.EvmCall) CallSummary {
+	summary := CallSummary{
+		Kind:  CallKindUnknown,
+		To:    call.To,
+		Value: call.Value,
+		Data:  call.Data,
+	}
+
+	// The API sends values in hex or decimal depending on the network.
+	value, err := ParseAmount(call.Value)
+	if err != nil {
+		value = new(big.Int)
+	}
+
+	data, err := decodeHexData(call.Data)
+	if err != nil {
+		summary.Description = fmt.Sprintf("Call %s with data %s", call.To, call.Data)
+		return summary
+	}
+
+	if len(data) == 0 {
+		summary.Kind = CallKindNativeTransfer
+		summary.Description = fmt.Sprintf("Send %s %s to %s", FormatEther(value), nativeSymbol(network), call.To)
+		return summary
+	}
+
+	if description, kind, ok := describeTokenCall(network, call.To, data); ok && value.Sign() == 0 {
+		summary.Kind = kind
+		summary.Description = description
+		return summary
+	}
+
+	if value.Sign() > 0 {
+		summary.Description = fmt.Sprintf("Call %s with %s %s and data %s", call.To, FormatEther(value), nativeSymbol(network), call.Data)
+	} else {
+		summary.Description = fmt.Sprintf("Call %s with data %s", call.To, call.Data)
+	}
+	return summary
+}
+
+// describeTokenCall decodes data as a call to a well-known token method on contract.
+func describeTokenCall(network, contract string, data []byte) (string, CallKind, bool) {
+	selector, words, err := splitCalldata(data)
+	if err != nil {
+		return "", "", false
+	}
+
+	switch selector {
+	case erc20TransferSelector:
+		if len(words) != 2 {
+			return "", "", false
+		}
+		return fmt.Sprintf("Send %s to %s", formatTokenAmount(network, contract, wordToBigInt(words[1])), wordToAddress(words[0])),
+			CallKindTokenTransfer, true
+
+	case erc20ApproveSelector:
+		if len(words) != 2 {
+			return "", "", false
+		}
+		amount := wordToBigInt(words[1])
+		if amount.Cmp(maxUint256) == 0 {
+			return fmt.Sprintf("Approve unlimited %s to %s", tokenName(network, contract), wordToAddress(words[0])),
+				CallKindTokenApproval, true
+		}
+		return fmt.Sprintf("Approve %s to %s", formatTokenAmount(network, contract, amount), wordToAddress(words[0])),
+			CallKindTokenApproval, true
+
+	case transferFromSelector:
+		if len(words) != 3 {
+			return "", "", false
+		}
+		amount := wordToBigInt(words[2])
+		if _, known := lookupTokenByAddress(network, contract); known {
+			return fmt.Sprintf("Transfer %s from %s to %s", formatTokenAmount(network, contract, amount), wordToAddress(words[0]), wordToAddress(words[1])),
+				CallKindTransferFrom, true
+		}
+		// ERC-20 and ERC-721 share this selector, so the last argument is ambiguous.
+		return fmt.Sprintf("Transfer %s (amount or token ID) of %s from %s to %s", amount, contract, wordToAddress(words[0]), wordToAddress(words[1])),
+			CallKindTransferFrom, true
+
+	case erc721SafeTransferFromSelector, erc721SafeTransferFromWithDataSelector:
+		if len(words) < 3 {
+			return "", "", false
+		}
+		return fmt.Sprintf("Transfer NFT #%s of %s from %s to %s", wordToBigInt(words[2]), contract, wordToAddress(words[0]), wordToAddress(words[1])),
+			CallKindNFTTransfer, true
+
+	case setApprovalForAllSelector:
+		if len(words) != 2 {
+			return "", "", false
+		}
+		if wordToBool(words[1]) {
+			return fmt.Sprintf("Approve %s to manage all NFTs of %s", wordToAddress(words[0]), contract),
+				CallKindNFTApprovalForAll, true
+		}
+		return fmt.Sprintf("Revoke approval for %s to manage all NFTs of %s", wordToAddress(words[0]), contract),
+			CallKindNFTApprovalForAll, true
+	}
+
+	return "", "", false
+}
+
+// formatTokenAmount formats amount of the token at contract, using the token's
+// decimals and symbol when the token is known on the network.
+func formatTokenAmount(network, contract string, amount *big.Int) string {
+	if token, ok := lookupTokenByAddress(network, contract); ok {
+		return FormatUnits(amount, token.Decimals) + " " + token.Symbol
+	}
+	return fmt.Sprintf("%s units of token %s", amount, contract)
+}
+
+// tokenName returns the symbol of the token at contract if known, or its address.
+func tokenName(network, contract string) string {
+	if token, ok := lookupTokenByAddress(network, contract); ok {
+		return token.Symbol
+	}
+	return "token " + contract
+}
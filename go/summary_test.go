@@ -0,0 +1,85 @@
+package cdp
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+const (
+	testRecipient = "0x450b2dc4ba2a08e58c7ecc3de48e3c825262caf8"
+	testSpender   = "0x1111111111111111111111111111111111111111"
+	testNFT       = "0x2222222222222222222222222222222222222222"
+)
+
+func encodeTestCall(selector string, args ...string) string {
+	var sb strings.Builder
+	sb.WriteString("0x" + selector)
+	for _, arg := range args {
+		sb.WriteString(fmt.Sprintf("%064s", strings.TrimPrefix(arg, "0x")))
+	}
+	return sb.String()
+}
+
+func TestUserOperationSummary(t *testing.T) {
+	usdc := "0x036CbD53842c5426634e7929541eC2318f3dCF7e"
+
+	op := &openapi.EvmUserOperation{
+		Network: openapi.EvmUserOperationNetworkBaseSepolia,
+		Calls: []openapi.EvmCall{
+			{To: testRecipient, Value: "100000000000000000", Data: "0x"},
+			{To: testRecipient, Value: "0x2386f26fc10000", Data: "0x"},
+			{To: usdc, Value: "0", Data: encodeTestCall(erc20TransferSelector, testRecipient, fmt.Sprintf("%x", 100_000_000))},
+			{To: usdc, Value: "0", Data: encodeTestCall(erc20ApproveSelector, testSpender, fmt.Sprintf("%x", maxUint256))},
+			{To: testNFT, Value: "0", Data: encodeTestCall(erc721SafeTransferFromSelector, testSpender, testRecipient, "2a")},
+			{To: testNFT, Value: "0", Data: "0xdeadbeef"},
+		},
+	}
+
+	want := []struct {
+		kind        CallKind
+		description string
+	}{
+		{CallKindNativeTransfer, "Send 0.1 ETH to " + testRecipient},
+		{CallKindNativeTransfer, "Send 0.01 ETH to " + testRecipient},
+		{CallKindTokenTransfer, "Send 100 USDC to " + testRecipient},
+		{CallKindTokenApproval, "Approve unlimited USDC to " + testSpender},
+		{CallKindNFTTransfer, "Transfer NFT #42 of " + testNFT + " from " + testSpender + " to " + testRecipient},
+		{CallKindUnknown, "Call " + testNFT + " with data 0xdeadbeef"},
+	}
+
+	got := UserOperationSummary(op)
+	if len(got) != len(want) {
+		t.Fatalf("got %d summaries, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Kind != w.kind {
+			t.Errorf("call %d: Kind = %q, want %q", i, got[i].Kind, w.kind)
+		}
+		if got[i].Description != w.description {
+			t.Errorf("call %d: Description = %q, want %q", i, got[i].Description, w.description)
+		}
+		if got[i].Data != op.Calls[i].Data {
+			t.Errorf("call %d: Data = %q, want raw calldata %q", i, got[i].Data, op.Calls[i].Data)
+		}
+	}
+}
+
+func TestUserOperationSummaryUnknownToken(t *testing.T) {
+	token := "0x3333333333333333333333333333333333333333"
+	op := &openapi.EvmUserOperation{
+		Network: openapi.EvmUserOperationNetworkBase,
+		Calls: []openapi.EvmCall{
+			{To: token, Value: "0", Data: encodeTestCall(erc20ApproveSelector, testSpender, fmt.Sprintf("%x", big.NewInt(500)))},
+		},
+	}
+
+	got := UserOperationSummary(op)
+	want := "Approve 500 units of token " + token + " to " + testSpender
+	if got[0].Description != want {
+		t.Errorf("Description = %q, want %q", got[0].Description, want)
+	}
+}
@@ -0,0 +1,113 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultTimeout is the time each request attempt may take when
+// ClientOptions.Timeout is zero.
+const defaultTimeout = 30 * time.Second
+
+// ErrTimeout is returned when a request attempt takes longer than
+// ClientOptions.Timeout, including reading the response body. A RequestError
+// for such a failure also matches ErrClientTimeout.
+var ErrTimeout = errors.New("request timed out")
+
+// timeoutError is the error of an attempt that exceeded the client's timeout. It
+// is a net.Error timeout, so RequestError reports it as ErrClientTimeout, and it
+// is retried like other network errors.
+type timeoutError struct {
+	timeout time.Duration
+}
+
+// Error implements the error interface.
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("%v after %s", ErrTimeout, e.timeout)
+}
+
+// Unwrap returns ErrTimeout.
+func (e *timeoutError) Unwrap() error { return ErrTimeout }
+
+// Timeout implements net.Error.
+func (e *timeoutError) Timeout() bool { return true }
+
+// Temporary implements net.Error.
+func (e *timeoutError) Temporary() bool { return true }
+
+// timeoutTransport bounds each request attempt, including reading the response
+// body, by timeout. It sits below retryTransport, so every attempt gets the full
+// timeout; a shorter deadline on the request context still applies.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// newTimeoutTransport wraps next to apply options.Timeout, or the default timeout
+// if it is zero. A negative timeout returns next unchanged.
+func newTimeoutTransport(next http.RoundTripper, options ClientOptions) http.RoundTripper {
+	timeout := options.Timeout
+	if timeout < 0 {
+		return next
+	}
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &timeoutTransport{next: next, timeout: timeout}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	parent := req.Context()
+	ctx, cancel := context.WithTimeoutCause(parent, t.timeout, ErrTimeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		err = t.attemptError(ctx, parent, err)
+		cancel()
+		return nil, err
+	}
+	resp.Body = &timeoutBody{ReadCloser: resp.Body, transport: t, ctx: ctx, parent: parent, cancel: cancel}
+	return resp, nil
+}
+
+// attemptError returns err, or a *timeoutError if the attempt failed because its
+// timeout passed while the caller's context was still live.
+func (t *timeoutTransport) attemptError(ctx, parent context.Context, err error) error {
+	if parent.Err() == nil && context.Cause(ctx) == ErrTimeout {
+		return &timeoutError{timeout: t.timeout}
+	}
+	return err
+}
+
+// timeoutBody keeps an attempt's timeout running while its body is read, and
+// releases it once the body is closed.
+type timeoutBody struct {
+	io.ReadCloser
+	transport *timeoutTransport
+	ctx       context.Context
+	parent    context.Context
+	cancel    context.CancelFunc
+	once      sync.Once
+}
+
+// Read implements io.Reader.
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = b.transport.attemptError(b.ctx, b.parent, err)
+	}
+	return n, err
+}
+
+// Close implements io.Closer.
+func (b *timeoutBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.cancel)
+	return err
+}
@@ -0,0 +1,87 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTimeoutFailsSlowRequests(t *testing.T) {
+	server := newSlowServer(t, time.Second)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{Timeout: 20 * time.Millisecond, MaxRetries: -1})
+
+	start := time.Now()
+	_, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if !errors.Is(err, ErrTimeout) || !errors.Is(err, ErrClientTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrContextDeadline) {
+		t.Errorf("timeout reported as a context deadline: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the request to give up after the timeout, took %s", elapsed)
+	}
+}
+
+func TestTimeoutCoversBodyRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"address":`)
+		w.(http.Flusher).Flush()
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{Timeout: 50 * time.Millisecond, MaxRetries: -1})
+
+	_, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("expected ErrTimeout while reading the body, got %v", err)
+	}
+}
+
+func TestTimeoutAppliesPerAttempt(t *testing.T) {
+	setFastTimers(t)
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+			return
+		}
+		time.Sleep(30 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{Timeout: 50 * time.Millisecond})
+
+	resp, err := client.GetEvmAccountWithResponse(context.Background(), testOwner)
+	if err != nil || resp.StatusCode() != http.StatusOK {
+		t.Fatalf("expected the third attempt to succeed within its own timeout, got %v", err)
+	}
+	if n := attempts.Load(); n != 3 {
+		t.Errorf("expected 3 attempts, got %d", n)
+	}
+}
+
+func TestTimeoutYieldsToShorterContextDeadline(t *testing.T) {
+	server := newSlowServer(t, time.Second)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{Timeout: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.GetEvmAccountWithResponse(ctx, testOwner)
+	if !errors.Is(err, ErrContextDeadline) || errors.Is(err, ErrTimeout) {
+		t.Fatalf("expected ErrContextDeadline, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package cdp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// defaultTokenCacheSkew is how long before expiry a cached API key JWT is replaced
+// when ClientOptions.TokenCacheSkew is zero.
+const defaultTokenCacheSkew = 10 * time.Second
+
+// memoryTokenCacheSweepSize is the number of entries above which MemoryTokenCache
+// drops expired tokens when adding one.
+const memoryTokenCacheSweepSize = 1024
+
+// TokenCache stores API key JWTs so that requests to the same endpoint can reuse a
+// token instead of signing a new one. Keys identify the API key that signed a
+// token, by its ID and a fingerprint of its secret, the method, host and path it
+// is bound to, and its lifetime, so a cache can be shared by clients with
+// different keys, and a secret rotated under the same key ID is not served tokens
+// signed with the old one. Implementations must be safe for
+// concurrent use. See ClientOptions.TokenCache.
+type TokenCache interface {
+	// Get returns the token cached under key and when it expires, if there is one.
+	Get(key string) (token string, expiresAt time.Time, ok bool)
+	// Set caches token under key until expiresAt.
+	Set(key, token string, expiresAt time.Time)
+}
+
+// secretFingerprint identifies secret in token cache keys without revealing it:
+// the hex-encoded first 8 bytes of its SHA-256 hash.
+func secretFingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:8])
+}
+
+// MemoryTokenCache is an in-memory TokenCache, and the default when
+// ClientOptions.TokenCache is nil. Its zero value is ready to use.
+type MemoryTokenCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedToken
+}
+
+// cachedToken is an entry of a MemoryTokenCache.
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// Get implements TokenCache.
+func (c *MemoryTokenCache) Get(key string) (string, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry.token, entry.expiresAt, ok
+}
+
+// Set implements TokenCache. Expired tokens are dropped once the cache holds many
+// entries, so that tokens for one-off paths don't accumulate.
+func (c *MemoryTokenCache) Set(key, token string, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]cachedToken)
+	}
+	if len(c.entries) >= memoryTokenCacheSweepSize {
+		now := time.Now()
+		for k, entry := range c.entries {
+			if !entry.expiresAt.After(now) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = cachedToken{token: token, expiresAt: expiresAt}
+}
+
+// cachedJWT returns the token cached under key if it is valid for longer than
+// skew, and otherwise signs a new one with generate and caches it. Concurrent
+// calls for the same key share a single signing.
+func cachedJWT(cache TokenCache, flights *flightGroup[string], key string, skew time.Duration, lifetime time.Duration, generate func() (string, error)) (string, error) {
+	if token, expiresAt, ok := cache.Get(key); ok && time.Until(expiresAt) > skew {
+		return token, nil
+	}
+	return flights.do(context.Background(), key, func() (string, error) {
+		// Another caller may have refreshed the token while this one waited.
+		if token, expiresAt, ok := cache.Get(key); ok && time.Until(expiresAt) > skew {
+			return token, nil
+		}
+		issuedAt := time.Now()
+		token, err := generate()
+		if err != nil {
+			return "", err
+		}
+		cache.Set(key, token, issuedAt.Add(lifetime))
+		return token, nil
+	})
+}
@@ -0,0 +1,209 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+)
+
+// countJWTSignings counts the API key JWTs signed until the test ends.
+func countJWTSignings(t *testing.T) *atomic.Int32 {
+	t.Helper()
+	var signings atomic.Int32
+	authGenerateJWT = func(options auth.JwtOptions) (string, error) {
+		signings.Add(1)
+		return auth.GenerateJWT(options)
+	}
+	t.Cleanup(func() { authGenerateJWT = auth.GenerateJWT })
+	return &signings
+}
+
+// newTokenRecordingServer returns a server that records the Authorization header
+// of each request.
+func newTokenRecordingServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), tokens...)
+	}
+}
+
+func TestTokenCacheReusesTokensPerEndpoint(t *testing.T) {
+	signings := countJWTSignings(t)
+	server, tokens := newTokenRecordingServer(t)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := client.GetEvmAccountWithResponse(ctx, testOwner); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	if _, err := client.GetEvmAccountWithResponse(ctx, testRecipient); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	// A call with an extended lifetime gets a token of its own.
+	if _, err := client.GetEvmAccountWithResponse(WithTokenLifetime(ctx, 10*time.Minute), testOwner); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	got := tokens()
+	if got[0] != got[1] || got[1] != got[2] {
+		t.Error("expected requests to the same endpoint to reuse the token")
+	}
+	if got[3] == got[0] || got[4] == got[0] {
+		t.Error("expected a new token for another path and for an extended lifetime")
+	}
+	if n := signings.Load(); n != 3 {
+		t.Errorf("signed %d tokens, want 3", n)
+	}
+}
+
+func TestTokenCacheSharedAcrossAPIKeys(t *testing.T) {
+	server, tokens := newTokenRecordingServer(t)
+	cache := &MemoryTokenCache{}
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{TokenCache: cache})
+	other, err := NewClient(ClientOptions{
+		APIKeyID:     "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy",
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+		BasePath:     server.URL,
+		TokenCache:   cache,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer other.Close()
+
+	for _, c := range []*Client{client, other} {
+		if _, err := c.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	if got := tokens(); got[0] == got[1] {
+		t.Error("expected a client with another API key not to reuse the cached token")
+	}
+}
+
+func TestTokenCacheRotatedSecret(t *testing.T) {
+	server, tokens := newTokenRecordingServer(t)
+	var secret atomic.Pointer[string]
+	first := generateTestECKeyForCdpTest(t)
+	secret.Store(&first)
+	client, err := NewClient(ClientOptions{
+		BasePath: server.URL,
+		CredentialProvider: func(context.Context) (Credentials, error) {
+			return Credentials{APIKeyID: testAPIKeyID, APIKeySecret: *secret.Load()}, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	for i := range 3 {
+		if i == 2 {
+			rotated := generateTestECKeyForCdpTest(t)
+			secret.Store(&rotated)
+		}
+		if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	got := tokens()
+	if got[0] != got[1] {
+		t.Error("expected the token to be reused while the secret is unchanged")
+	}
+	if got[1] == got[2] {
+		t.Error("expected a rotated secret with the same key ID not to reuse the cached token")
+	}
+}
+
+func TestTokenCacheRefreshesWithinSkew(t *testing.T) {
+	signings := countJWTSignings(t)
+	server, _ := newTokenRecordingServer(t)
+	// Tokens are within the skew of expiry as soon as they are signed.
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{ExpiresIn: 30, TokenCacheSkew: 30 * time.Second})
+
+	for range 3 {
+		if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	if n := signings.Load(); n != 3 {
+		t.Errorf("signed %d tokens, want 3", n)
+	}
+}
+
+func TestTokenCacheDisabled(t *testing.T) {
+	signings := countJWTSignings(t)
+	server, _ := newTokenRecordingServer(t)
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{DisableTokenCache: true})
+
+	for range 3 {
+		if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	if n := signings.Load(); n != 3 {
+		t.Errorf("signed %d tokens, want 3", n)
+	}
+}
+
+func TestTokenCacheConcurrentRequestsSignOnce(t *testing.T) {
+	signings := countJWTSignings(t)
+	server, _ := newTokenRecordingServer(t)
+	cache := &MemoryTokenCache{}
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{TokenCache: cache})
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+				t.Errorf("request failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := signings.Load(); n != 1 {
+		t.Errorf("signed %d tokens for concurrent requests, want 1", n)
+	}
+	if len(cache.entries) != 1 {
+		t.Errorf("expected the supplied cache to hold 1 token, got %d", len(cache.entries))
+	}
+}
+
+func TestMemoryTokenCacheDropsExpiredTokens(t *testing.T) {
+	var cache MemoryTokenCache
+	expired := time.Now().Add(-time.Second)
+	for i := range memoryTokenCacheSweepSize {
+		cache.Set(fmt.Sprintf("expired-%d", i), "token", expired)
+	}
+	cache.Set("live", "token", time.Now().Add(time.Minute))
+
+	if len(cache.entries) != 1 {
+		t.Errorf("expected expired tokens to be dropped, got %d entries", len(cache.entries))
+	}
+	if token, _, ok := cache.Get("live"); !ok || token != "token" {
+		t.Errorf("Get(live) = %q, %v", token, ok)
+	}
+}
@@ -0,0 +1,69 @@
+package cdp
+
+import (
+	"context"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+)
+
+// TokenKind is the kind of a JWT the client signs.
+type TokenKind string
+
+const (
+	// TokenKindAPIKey is an API key JWT, sent in the Authorization header.
+	TokenKindAPIKey TokenKind = "api_key"
+	// TokenKindWallet is a wallet JWT, sent in the wallet auth header.
+	TokenKindWallet TokenKind = "wallet"
+)
+
+// TokenMeta describes a JWT signed by the client, for audit logging with
+// ClientOptions.OnTokenIssued. It never includes the token or the key it was
+// signed with.
+type TokenMeta struct {
+	// Kind is the kind of token.
+	Kind TokenKind
+	// KeyID is the ID of the API key an API key JWT was signed with. It is empty
+	// for wallet JWTs.
+	KeyID string
+	// OperationID is the ID of the OpenAPI operation the token authenticates, e.g.
+	// "CreateEvmAccount", or empty if it is not for a known operation, such as a
+	// token from WebSocketToken.
+	OperationID string
+	// Method, Host and Path are the request the token is bound to. They are empty
+	// for tokens not bound to a request, such as those from WebSocketToken.
+	Method string
+	Host   string
+	Path   string
+	// ExpiresAt is when the token expires. It is zero for wallet JWTs, which carry
+	// no expiry.
+	ExpiresAt time.Time
+	// JTI identifies the token: the jti claim of a wallet JWT, or the nonce in the
+	// header of an API key JWT, which has no jti claim.
+	JTI string
+}
+
+// issueToken calls generate and, if it succeeds, reports the token to
+// options.OnTokenIssued with meta, completed from the token's claims and the
+// operation ID on ctx.
+func issueToken(ctx context.Context, options ClientOptions, meta TokenMeta, generate func() (string, error)) (string, error) {
+	token, err := generate()
+	if err != nil || options.OnTokenIssued == nil {
+		return token, err
+	}
+
+	meta.OperationID, _ = OperationID(ctx)
+	if claims, err := auth.DecodeClaims(token); err == nil {
+		if exp, ok := claims["exp"].(float64); ok {
+			meta.ExpiresAt = time.Unix(int64(exp), 0)
+		}
+		meta.JTI, _ = claims["jti"].(string)
+	}
+	if meta.JTI == "" {
+		if header, err := auth.DecodeHeader(token); err == nil {
+			meta.JTI, _ = header["nonce"].(string)
+		}
+	}
+	options.OnTokenIssued(meta)
+	return token, nil
+}
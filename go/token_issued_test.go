@@ -0,0 +1,94 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestOnTokenIssuedReportsTokens(t *testing.T) {
+	var tokens []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), r.Header.Get("X-Wallet-Auth"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}))
+	defer server.Close()
+
+	var issued []TokenMeta
+	keySecret, walletSecret := generateTestECKeyForCdpTest(t), generateTestWalletSecret(t)
+	client, err := NewClient(ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: keySecret,
+		WalletSecret: walletSecret,
+		BasePath:     server.URL,
+		OnTokenIssued: func(meta TokenMeta) {
+			mu.Lock()
+			defer mu.Unlock()
+			issued = append(issued, meta)
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	before := time.Now()
+	if _, err := client.CreateEvmAccountWithResponse(context.Background(), nil, openapi.CreateEvmAccountJSONRequestBody{}); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if len(issued) != 2 {
+		t.Fatalf("expected an API key and a wallet token, got %+v", issued)
+	}
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	apiKey, wallet := issued[0], issued[1]
+	if apiKey.Kind != TokenKindAPIKey || apiKey.KeyID != testAPIKeyID || apiKey.OperationID != "CreateEvmAccount" ||
+		apiKey.Method != http.MethodPost || apiKey.Host != host || apiKey.Path != "/v2/evm/accounts" {
+		t.Errorf("unexpected API key token metadata %+v", apiKey)
+	}
+	if expiry := before.Add(defaultTokenLifetime); apiKey.ExpiresAt.Before(expiry.Add(-2*time.Second)) || apiKey.ExpiresAt.After(expiry.Add(2*time.Second)) {
+		t.Errorf("expected the API key token to expire around %s, got %s", expiry, apiKey.ExpiresAt)
+	}
+	if header, _ := auth.DecodeHeader(tokens[0]); apiKey.JTI == "" || apiKey.JTI != header["nonce"] {
+		t.Errorf("expected the API key token's nonce %v as its ID, got %q", header["nonce"], apiKey.JTI)
+	}
+
+	if wallet.Kind != TokenKindWallet || wallet.KeyID != "" || wallet.OperationID != "CreateEvmAccount" ||
+		wallet.Method != http.MethodPost || wallet.Host != host || wallet.Path != "/v2/evm/accounts" || !wallet.ExpiresAt.IsZero() {
+		t.Errorf("unexpected wallet token metadata %+v", wallet)
+	}
+	if claims, _ := auth.DecodeClaims(tokens[1]); wallet.JTI == "" || wallet.JTI != claims["jti"] {
+		t.Errorf("expected the wallet token's jti %v, got %q", claims["jti"], wallet.JTI)
+	}
+
+	for _, meta := range issued {
+		described := fmt.Sprintf("%#v", meta)
+		for _, secret := range append(tokens, keySecret, walletSecret) {
+			if strings.Contains(described, secret) {
+				t.Errorf("token metadata leaks a token or key: %s", described)
+			}
+		}
+	}
+
+	// A cached token is not reported again.
+	for range 2 {
+		if _, err := client.GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	if len(issued) != 3 {
+		t.Errorf("expected one more token for the repeated request, got %d", len(issued)-2)
+	}
+}
@@ -0,0 +1,44 @@
+package cdp
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// defaultTokenLifetime is the lifetime of API key JWTs when ClientOptions.ExpiresIn
+// is zero.
+const defaultTokenLifetime = 120 * time.Second
+
+// tokenLifetimeKey is the context key for the lifetime set by WithTokenLifetime.
+type tokenLifetimeKey struct{}
+
+// WithTokenLifetime returns a copy of ctx for which the API key JWTs of requests
+// made with it, including each retry, stay valid for at least lifetime. Use it for
+// calls that may outlive the default lifetime of ClientOptions.ExpiresIn, such as
+// long uploads or streams, which would otherwise fail with an expired token
+// part way through. For a call bounded by a context deadline:
+//
+//	deadline, _ := ctx.Deadline()
+//	ctx = cdp.WithTokenLifetime(ctx, time.Until(deadline))
+//
+// Short-lived tokens limit how long a leaked token (e.g. one logged by a proxy)
+// can be replayed against the method and path it is bound to, so only extend the
+// lifetime for the calls that need it rather than raising ExpiresIn for the whole
+// client. A lifetime shorter than ExpiresIn has no effect.
+func WithTokenLifetime(ctx context.Context, lifetime time.Duration) context.Context {
+	return context.WithValue(ctx, tokenLifetimeKey{}, lifetime)
+}
+
+// tokenExpiresIn returns the lifetime in seconds of API key JWTs for requests made
+// with ctx, given the client's ExpiresIn.
+func tokenExpiresIn(ctx context.Context, expiresIn int64) int64 {
+	lifetime, ok := ctx.Value(tokenLifetimeKey{}).(time.Duration)
+	if !ok {
+		return expiresIn
+	}
+	if expiresIn == 0 {
+		expiresIn = int64(defaultTokenLifetime / time.Second)
+	}
+	return max(expiresIn, int64(math.Ceil(lifetime.Seconds())))
+}
@@ -0,0 +1,47 @@
+package cdp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+)
+
+func TestWithTokenLifetime(t *testing.T) {
+	tokens := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens <- strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want time.Duration
+	}{
+		{"default", context.Background(), defaultTokenLifetime},
+		{"extended", WithTokenLifetime(context.Background(), 10*time.Minute+time.Millisecond), 10*time.Minute + time.Second},
+		{"shorter than default", WithTokenLifetime(context.Background(), time.Second), defaultTokenLifetime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now().Truncate(time.Second)
+			_, _ = client.ListEvmAccountsWithResponse(tt.ctx, nil)
+
+			expiry, err := auth.TokenExpiry(<-tokens)
+			if err != nil {
+				t.Fatalf("TokenExpiry returned an error: %v", err)
+			}
+			if lifetime := expiry.Sub(start); lifetime < tt.want || lifetime > tt.want+2*time.Second {
+				t.Errorf("token lifetime = %s, want %s", lifetime, tt.want)
+			}
+		})
+	}
+}
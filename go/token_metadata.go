@@ -0,0 +1,134 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// ErrNotERC20 is returned when an address does not implement the ERC-20 metadata
+// methods.
+var ErrNotERC20 = errors.New("address is not an ERC-20 token")
+
+// tokenMetadataCache caches token metadata by network and lowercase address.
+// Metadata is immutable for well-behaved tokens, so entries never expire.
+type tokenMetadataCache struct {
+	tokens sync.Map // network + "/" + lowercase address -> Token
+}
+
+// GetTokenMetadata returns the name, symbol and decimals of the ERC-20 token at
+// tokenAddress on network. Tokens known to the SDK are returned without a network
+// call; others are read from the token contract and cached for the lifetime of the
+// client. It returns an error wrapping ErrNotERC20 if the address does not
+// implement decimals() and symbol().
+func (c *Client) GetTokenMetadata(ctx context.Context, network, tokenAddress string) (Token, error) {
+	network = c.networkOrDefault(network)
+	if _, err := addressWord(tokenAddress); err != nil {
+		return Token{}, err
+	}
+	if token, ok := lookupTokenByAddress(network, tokenAddress); ok {
+		return token, nil
+	}
+
+	key := network + "/" + strings.ToLower(tokenAddress)
+	if token, ok := c.tokens.tokens.Load(key); ok {
+		return token.(Token), nil
+	}
+
+	token, err := c.fetchTokenMetadata(ctx, network, tokenAddress)
+	if err != nil {
+		return Token{}, err
+	}
+	c.tokens.tokens.Store(key, token)
+	return token, nil
+}
+
+// fetchTokenMetadata reads the metadata of the token at tokenAddress from chain.
+func (c *Client) fetchTokenMetadata(ctx context.Context, network, tokenAddress string) (Token, error) {
+	token := Token{Address: tokenAddress}
+
+	decimals, err := c.callToken(ctx, network, tokenAddress, erc20DecimalsSelector)
+	if err != nil {
+		return Token{}, err
+	}
+	if len(decimals) != abiWordSize || wordToBigInt(decimals).Cmp(big.NewInt(255)) > 0 {
+		return Token{}, fmt.Errorf("%w: %s on %s returned invalid decimals", ErrNotERC20, tokenAddress, network)
+	}
+	token.Decimals = int(decimals[abiWordSize-1])
+
+	symbol, err := c.callToken(ctx, network, tokenAddress, erc20SymbolSelector)
+	if err != nil {
+		return Token{}, err
+	}
+	if token.Symbol, err = decodeStringResult(symbol); err != nil {
+		return Token{}, fmt.Errorf("%w: %s on %s returned an invalid symbol: %v", ErrNotERC20, tokenAddress, network, err)
+	}
+
+	// name() is optional in ERC-20, so tokens without it are still accepted.
+	if name, err := c.callToken(ctx, network, tokenAddress, erc20NameSelector); err == nil {
+		token.Name, _ = decodeStringResult(name)
+	} else if !errors.Is(err, ErrNotERC20) {
+		return Token{}, err
+	}
+
+	return token, nil
+}
+
+// callToken calls a parameterless method of the token at tokenAddress. Reverts
+// and empty return data, such as from an address without code, are reported as
+// ErrNotERC20; other RPC errors are returned wrapped.
+func (c *Client) callToken(ctx context.Context, network, tokenAddress, selector string) ([]byte, error) {
+	data, _ := hex.DecodeString(selector)
+	result, err := c.ethCall(ctx, network, tokenAddress, data)
+	var rpcErr *RPCError
+	if (errors.As(err, &rpcErr) && isExecutionRevert(rpcErr)) || (err == nil && len(result) == 0) {
+		return nil, fmt.Errorf("%w: %s on %s", ErrNotERC20, tokenAddress, network)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token %s on %s: %w", tokenAddress, network, err)
+	}
+	return result, nil
+}
+
+// isExecutionRevert reports whether err is an eth_call that reverted: error code
+// 3, revert data, or a message about the revert, as nodes differ in which they
+// report.
+func isExecutionRevert(err *RPCError) bool {
+	if err.Code == 3 || (len(err.Data) > 0 && string(err.Data) != "null") {
+		return true
+	}
+	return strings.Contains(strings.ToLower(err.Message), "revert")
+}
+
+// resolveToken returns the token identified by token on network: the native token
+// symbol, a known token symbol, or an ERC-20 contract address. The native token is
+// returned with an empty Address.
+func (c *Client) resolveToken(ctx context.Context, network, token string) (Token, error) {
+	if strings.EqualFold(token, nativeSymbol(network)) {
+		return Token{Symbol: nativeSymbol(network), Decimals: EtherDecimals}, nil
+	}
+	if known, ok := lookupTokenBySymbol(network, token); ok {
+		return known, nil
+	}
+	if _, err := addressWord(token); err != nil {
+		return Token{}, fmt.Errorf("unknown token %q on %s", token, network)
+	}
+	return c.GetTokenMetadata(ctx, network, token)
+}
+
+// FormatTokenAmount formats amount, in the smallest unit of token, as a decimal
+// amount followed by the token's symbol (e.g. "1.5 USDC"). The token is identified
+// as in NetworkScopedEvmAccount.Transfer; decimals of unknown tokens are looked up
+// with GetTokenMetadata.
+func (c *Client) FormatTokenAmount(ctx context.Context, network, token string, amount *big.Int) (string, error) {
+	network = c.networkOrDefault(network)
+	resolved, err := c.resolveToken(ctx, network, token)
+	if err != nil {
+		return "", err
+	}
+	return FormatUnits(amount, resolved.Decimals) + " " + resolved.Symbol, nil
+}
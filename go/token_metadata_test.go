@@ -0,0 +1,160 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const testToken = "0x3333333333333333333333333333333333333333"
+
+// abiString ABI-encodes s as the return data of a function returning a string.
+func abiString(s string) string {
+	return fmt.Sprintf("%064x%064x%x", 32, len(s), s) + strings.Repeat("0", (64-len(s)*2%64)%64)
+}
+
+// newTokenRPCServer returns a JSON-RPC server where testToken is an ERC-20 token
+// named "Test Token" with symbol "TT" and 8 decimals, and every other address has
+// no code. It counts the eth_call requests it serves.
+func newTokenRPCServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		var call struct{ To, Data string }
+		_ = json.Unmarshal(req.Params[0], &call)
+		calls.Add(1)
+
+		result := "0x"
+		if strings.EqualFold(call.To, testToken) {
+			switch strings.TrimPrefix(call.Data, "0x") {
+			case erc20DecimalsSelector:
+				result += fmt.Sprintf("%064x", 8)
+			case erc20SymbolSelector:
+				result += abiString("TT")
+			case erc20NameSelector:
+				result += abiString("Test Token")
+			}
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, result)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestGetTokenMetadata(t *testing.T) {
+	rpc, calls := newTokenRPCServer(t)
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+	for range 2 {
+		token, err := client.GetTokenMetadata(context.Background(), "base-sepolia", testToken)
+		if err != nil {
+			t.Fatalf("GetTokenMetadata returned an error: %v", err)
+		}
+		if token.Name != "Test Token" || token.Symbol != "TT" || token.Decimals != 8 || token.Address != testToken {
+			t.Errorf("got %+v", token)
+		}
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("got %d eth_calls, want 3 (metadata should be cached)", got)
+	}
+
+	usdc, err := client.GetTokenMetadata(context.Background(), "base-sepolia", "0x036CbD53842c5426634e7929541eC2318f3dCF7e")
+	if err != nil || usdc.Symbol != "USDC" || usdc.Decimals != 6 {
+		t.Errorf("got %+v, %v for a known token", usdc, err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("known token metadata was read from chain")
+	}
+}
+
+func TestGetTokenMetadataNotERC20(t *testing.T) {
+	rpc, _ := newTokenRPCServer(t)
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+	_, err := client.GetTokenMetadata(context.Background(), "base-sepolia", testRecipient)
+	if !errors.Is(err, ErrNotERC20) {
+		t.Errorf("got error %v, want ErrNotERC20", err)
+	}
+}
+
+func TestGetTokenMetadataRPCErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          string
+		wantNotERC20 bool
+	}{
+		{"revert code", `{"code":3,"message":"execution reverted","data":"0x"}`, true},
+		{"revert message", `{"code":-32000,"message":"execution reverted"}`, true},
+		{"revert data", `{"code":-32015,"message":"VM execution error","data":"0x08c379a0"}`, true},
+		{"node error", `{"code":-32000,"message":"header not found"}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"error":%s}`, tt.err)
+			}))
+			defer rpc.Close()
+			client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+			_, err := client.GetTokenMetadata(context.Background(), "base-sepolia", testToken)
+			var rpcErr *RPCError
+			if tt.wantNotERC20 && !errors.Is(err, ErrNotERC20) {
+				t.Errorf("got error %v, want ErrNotERC20", err)
+			}
+			if !tt.wantNotERC20 && (errors.Is(err, ErrNotERC20) || !errors.As(err, &rpcErr)) {
+				t.Errorf("got error %v, want the wrapped *RPCError", err)
+			}
+		})
+	}
+}
+
+func TestFormatTokenAmount(t *testing.T) {
+	rpc, _ := newTokenRPCServer(t)
+	client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+	tests := map[string]string{
+		"eth":     "0.00000000000000015 ETH",
+		"usdc":    "0.00015 USDC",
+		testToken: "0.0000015 TT",
+	}
+	for token, want := range tests {
+		got, err := client.FormatTokenAmount(context.Background(), "base-sepolia", token, big.NewInt(150))
+		if err != nil {
+			t.Fatalf("FormatTokenAmount(%s) returned an error: %v", token, err)
+		}
+		if got != want {
+			t.Errorf("FormatTokenAmount(%s) = %q, want %q", token, got, want)
+		}
+	}
+}
+
+func TestDecodeStringResult(t *testing.T) {
+	data, _ := decodeHexData(abiString("USD Coin"))
+	if got, err := decodeStringResult(data); err != nil || got != "USD Coin" {
+		t.Errorf("got %q, %v", got, err)
+	}
+
+	bytes32 := make([]byte, abiWordSize)
+	copy(bytes32, "MKR")
+	if got, err := decodeStringResult(bytes32); err != nil || got != "MKR" {
+		t.Errorf("got %q, %v for a bytes32 result", got, err)
+	}
+
+	truncated := data[:2*abiWordSize+2]
+	truncated[2*abiWordSize-1] = 0xff
+	if _, err := decodeStringResult(truncated); err == nil {
+		t.Error("expected an error for an out-of-range length")
+	}
+}
@@ -0,0 +1,50 @@
+package cdp
+
+import "strings"
+
+// Token describes an ERC-20 token known to the SDK.
+type Token struct {
+	// Name is the token's name (e.g. "USD Coin").
+	Name string
+	// Symbol is the token's ticker symbol (e.g. "USDC").
+	Symbol string
+	// Address is the token's contract address.
+	Address string
+	// Decimals is the number of decimals used by the token.
+	Decimals int
+}
+
+// knownTokens lists well-known ERC-20 tokens, keyed by CDP network name and then by
+// lowercase symbol.
+var knownTokens = map[string]map[string]Token{
+	"base": {
+		"usdc": {Name: "USD Coin", Symbol: "USDC", Address: "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913", Decimals: 6},
+		"weth": {Name: "Wrapped Ether", Symbol: "WETH", Address: "0x4200000000000000000000000000000000000006", Decimals: 18},
+	},
+	"base-sepolia": {
+		"usdc": {Name: "USD Coin", Symbol: "USDC", Address: "0x036CbD53842c5426634e7929541eC2318f3dCF7e", Decimals: 6},
+		"weth": {Name: "Wrapped Ether", Symbol: "WETH", Address: "0x4200000000000000000000000000000000000006", Decimals: 18},
+	},
+	"ethereum": {
+		"usdc": {Name: "USD Coin", Symbol: "USDC", Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Decimals: 6},
+	},
+	"ethereum-sepolia": {
+		"usdc": {Name: "USD Coin", Symbol: "USDC", Address: "0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238", Decimals: 6},
+	},
+}
+
+// lookupTokenBySymbol returns the known token with the given symbol on the given network.
+func lookupTokenBySymbol(network, symbol string) (Token, bool) {
+	token, ok := knownTokens[network][strings.ToLower(symbol)]
+	return token, ok
+}
+
+// lookupTokenByAddress returns the known token deployed at address on the given network.
+func lookupTokenByAddress(network, address string) (Token, bool) {
+	for _, token := range knownTokens[network] {
+		if strings.EqualFold(token.Address, address) {
+			return token, true
+		}
+	}
+	return Token{}, false
+}
@@ -0,0 +1,211 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// eip1559TxType is the EIP-2718 type byte of EIP-1559 transactions.
+const eip1559TxType = 0x02
+
+// eip2930TxType is the EIP-2718 type byte of EIP-2930 transactions.
+const eip2930TxType = 0x01
+
+// ErrChainIDMismatch is returned when a transaction's explicit chain ID does not
+// match the network it is being signed or sent for.
+var ErrChainIDMismatch = errors.New("transaction chain ID does not match network")
+
+// TransactionRequest describes an EIP-1559 transaction to sign or send.
+//
+// Fields left at their zero value are filled in by the API where possible (nonce,
+// gas and fees when sending). The chain ID is always derived from the network.
+type TransactionRequest struct {
+	// ChainID optionally pins the chain ID. If set, it must match the network the
+	// transaction is signed or sent for; if zero, it is populated from the network.
+	ChainID int64
+	// Nonce is the sender's transaction count.
+	Nonce uint64
+	// To is the 0x-prefixed recipient address. Leave empty to deploy a contract.
+	To string
+	// Value is the amount of native token, in wei, to send.
+	Value *big.Int
+	// Data is the 0x-prefixed calldata.
+	Data string
+	// Gas is the gas limit.
+	Gas uint64
+	// MaxFeePerGas is the maximum total fee per gas, in wei.
+	MaxFeePerGas *big.Int
+	// MaxPriorityFeePerGas is the maximum priority fee per gas, in wei.
+	MaxPriorityFeePerGas *big.Int
+}
+
+// ChainID returns the chain ID of the given EVM network (e.g. 84532 for
+// "base-sepolia").
+func ChainID(network string) (int64, error) {
+	n, ok := evmNetworks[network]
+	if !ok {
+		return 0, fmt.Errorf("unknown EVM network %q", network)
+	}
+	return n.chainID, nil
+}
+
+// SerializeTransaction returns the unsigned, RLP-encoded EIP-1559 transaction for
+// tx on network as a 0x-prefixed hex string, in the form expected by the signing
+// and sending endpoints.
+//
+// The chain ID is taken from network. If tx.ChainID is set and differs, an error
+// wrapping ErrChainIDMismatch is returned, so a transaction can never be signed
+// for a different chain than the one it is sent on.
+func SerializeTransaction(network string, tx TransactionRequest) (string, error) {
+	chainID, err := ChainID(network)
+	if err != nil {
+		return "", err
+	}
+	if tx.ChainID != 0 && tx.ChainID != chainID {
+		return "", fmt.Errorf("%w: chain ID %d is not %s (%d)", ErrChainIDMismatch, tx.ChainID, network, chainID)
+	}
+
+	var to []byte
+	if tx.To != "" {
+		word, err := addressWord(tx.To)
+		if err != nil {
+			return "", fmt.Errorf("invalid to address: %w", err)
+		}
+		to = word[abiWordSize-20:]
+	}
+
+	data, err := decodeHexData(tx.Data)
+	if err != nil {
+		return "", fmt.Errorf("invalid data: %w", err)
+	}
+
+	encoded := rlpList(
+		rlpUint(uint64(chainID)),
+		rlpUint(tx.Nonce),
+		rlpBigInt(tx.MaxPriorityFeePerGas),
+		rlpBigInt(tx.MaxFeePerGas),
+		rlpUint(tx.Gas),
+		rlpBytes(to),
+		rlpBigInt(tx.Value),
+		rlpBytes(data),
+		rlpList(), // access list
+	)
+
+	return "0x" + hex.EncodeToString(append([]byte{eip1559TxType}, encoded...)), nil
+}
+
+// SignTransaction signs tx for network with the account and returns the signed,
+// RLP-encoded transaction. It fails with ErrRecipientNotAllowed if tx's recipient
+// is not in ClientOptions.RecipientAllowlist.
+func (a *EvmAccount) SignTransaction(ctx context.Context, network string, tx TransactionRequest) (string, error) {
+	network = a.client.networkOrDefault(network)
+	if err := a.client.checkRecipient(tx.To, tx.Value, tx.Data); err != nil {
+		return "", err
+	}
+	serialized, err := SerializeTransaction(network, tx)
+	if err != nil {
+		return "", err
+	}
+
+	return a.signRawTransaction(ctx, serialized)
+}
+
+// signRawTransaction signs an RLP-encoded, 0x-prefixed transaction with the account.
+func (a *EvmAccount) signRawTransaction(ctx context.Context, serialized string) (string, error) {
+	resp, err := a.client.SignEvmTransactionWithResponse(ctx, a.Address, nil, openapi.SignEvmTransactionJSONRequestBody{
+		Transaction: serialized,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return "", unexpectedStatusError("sign transaction", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.SignedTransaction, nil
+}
+
+// SendTransaction signs tx with the account, sends it on network and returns the
+// transaction hash. It fails with ErrRecipientNotAllowed if tx's recipient is not
+// in ClientOptions.RecipientAllowlist. If the network rejects tx because a
+// transaction with its nonce is pending, the error matches
+// ErrReplacementUnderpriced or ErrAlreadyKnown.
+//
+// If ClientOptions.ManageNonces is set and tx.Nonce is zero, the nonce is taken
+// from the client's NonceManager. Should the send then be rejected with a "nonce
+// too low" error, the account's nonce is re-synced from chain state and the
+// transaction is sent once more with the corrected nonce.
+func (a *EvmAccount) SendTransaction(ctx context.Context, network string, tx TransactionRequest) (string, error) {
+	if err := a.client.checkWritable(); err != nil {
+		return "", err
+	}
+	network = a.client.networkOrDefault(network)
+	if err := a.client.checkRecipient(tx.To, tx.Value, tx.Data); err != nil {
+		return "", err
+	}
+	tx, err := a.withGasLimit(ctx, network, tx)
+	if err != nil {
+		return "", err
+	}
+
+	nonces := a.client.nonces
+	if nonces == nil || tx.Nonce != 0 {
+		return a.sendTransaction(ctx, network, tx)
+	}
+	return a.sendWithNonces(ctx, network, tx, nonces)
+}
+
+// sendWithNonces sends tx on network with the next nonce from nonces, re-syncing
+// and retrying once if the nonce turns out to be stale.
+func (a *EvmAccount) sendWithNonces(ctx context.Context, network string, tx TransactionRequest, nonces *NonceManager) (string, error) {
+	nonce, err := nonces.Next(ctx, network, a.Address)
+	if err != nil {
+		return "", err
+	}
+	tx.Nonce = nonce
+	hash, err := a.sendTransaction(ctx, network, tx)
+	if isNonceTooLow(err) {
+		if _, err := nonces.Sync(ctx, network, a.Address); err != nil {
+			return "", err
+		}
+		if tx.Nonce, err = nonces.Next(ctx, network, a.Address); err != nil {
+			return "", err
+		}
+		hash, err = a.sendTransaction(ctx, network, tx)
+	}
+	if err != nil {
+		// The nonce may not have been used; re-sync before the next send.
+		nonces.Reset(network, a.Address)
+	}
+	return hash, err
+}
+
+// sendTransaction serializes tx and sends it on network.
+func (a *EvmAccount) sendTransaction(ctx context.Context, network string, tx TransactionRequest) (string, error) {
+	serialized, err := SerializeTransaction(network, tx)
+	if err != nil {
+		return "", err
+	}
+	return a.sendSerializedTransaction(ctx, network, serialized)
+}
+
+// sendSerializedTransaction sends the 0x-prefixed serialized, unsigned transaction
+// on network and returns the transaction hash.
+func (a *EvmAccount) sendSerializedTransaction(ctx context.Context, network, serialized string) (string, error) {
+	resp, err := a.client.SendEvmTransactionWithResponse(ctx, a.Address, nil, openapi.SendEvmTransactionJSONRequestBody{
+		Network:     openapi.SendEvmTransactionJSONBodyNetwork(network),
+		Transaction: serialized,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to send transaction: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return "", unexpectedStatusError("send transaction", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.TransactionHash, nil
+}
@@ -0,0 +1,84 @@
+package cdp
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestSerializeTransactionPopulatesChainID(t *testing.T) {
+	tx := TransactionRequest{
+		To:    testRecipient,
+		Value: big.NewInt(1000),
+	}
+
+	tests := []struct {
+		network string
+		// encodedChainID is the RLP encoding of the network's chain ID, which is the
+		// first field of the transaction payload.
+		encodedChainID string
+	}{
+		{"ethereum", "01"},
+		{"base", "822105"},
+		{"base-sepolia", "83014a34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.network, func(t *testing.T) {
+			serialized, err := SerializeTransaction(tt.network, tx)
+			if err != nil {
+				t.Fatalf("SerializeTransaction returned an error: %v", err)
+			}
+			// 0x02 type byte, then a short list header, then the chain ID.
+			if !strings.HasPrefix(serialized, "0x02") || serialized[6:6+len(tt.encodedChainID)] != tt.encodedChainID {
+				t.Errorf("serialized = %s, want chain ID %s", serialized, tt.encodedChainID)
+			}
+		})
+	}
+}
+
+func TestSerializeTransactionEncoding(t *testing.T) {
+	serialized, err := SerializeTransaction("ethereum", TransactionRequest{
+		Nonce:                9,
+		To:                   "0x3535353535353535353535353535353535353535",
+		Value:                big.NewInt(1e18),
+		Gas:                  21000,
+		MaxFeePerGas:         big.NewInt(2e10),
+		MaxPriorityFeePerGas: big.NewInt(1e9),
+	})
+	if err != nil {
+		t.Fatalf("SerializeTransaction returned an error: %v", err)
+	}
+
+	want := "0x02" + "f0" + // list of 48 bytes
+		"01" + // chain ID
+		"09" + // nonce
+		"843b9aca00" + // max priority fee
+		"8504a817c800" + // max fee
+		"825208" + // gas
+		"94" + "3535353535353535353535353535353535353535" +
+		"880de0b6b3a7640000" + // value
+		"80" + // data
+		"c0" // access list
+	if serialized != want {
+		t.Errorf("serialized = %s\nwant         %s", serialized, want)
+	}
+}
+
+func TestSerializeTransactionRejectsMismatchedChainID(t *testing.T) {
+	_, err := SerializeTransaction("base-sepolia", TransactionRequest{ChainID: 8453, To: testRecipient})
+	if !errors.Is(err, ErrChainIDMismatch) {
+		t.Fatalf("expected ErrChainIDMismatch, got %v", err)
+	}
+
+	if _, err := SerializeTransaction("base-sepolia", TransactionRequest{ChainID: 84532, To: testRecipient}); err != nil {
+		t.Fatalf("expected a matching chain ID to be accepted, got %v", err)
+	}
+}
+
+func TestSerializeTransactionUnknownNetwork(t *testing.T) {
+	if _, err := SerializeTransaction("not-a-network", TransactionRequest{}); err == nil {
+		t.Fatal("expected an error for an unknown network")
+	}
+}
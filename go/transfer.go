@@ -0,0 +1,154 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// NetworkScopedEvmAccount is an EvmAccount bound to a single network, so that
+// network-specific helpers don't need it passed on every call.
+type NetworkScopedEvmAccount struct {
+	*EvmAccount
+
+	// Network is the network the account operates on (e.g. "base-sepolia").
+	Network string
+}
+
+// UseNetwork returns the account scoped to network, or to
+// ClientOptions.DefaultNetwork if network is empty.
+func (a *EvmAccount) UseNetwork(network string) *NetworkScopedEvmAccount {
+	network = a.client.networkOrDefault(network)
+	return &NetworkScopedEvmAccount{EvmAccount: a, Network: network}
+}
+
+// EvmServerAccount is a CDP-managed EVM account, as opposed to an EVM smart
+// account. It is another name for EvmAccount, matching the TypeScript SDK; its
+// Transfer behaves as NetworkScopedEvmAccount.Transfer on the given network.
+type EvmServerAccount = EvmAccount
+
+// Transfer sends amount of token to the recipient on network, or on
+// ClientOptions.DefaultNetwork if network is empty, and returns the transaction
+// hash. The recipient is an address, or a name resolved with ResolveName. The
+// token is the network's native token symbol (e.g. "eth"), the symbol of a token
+// known to the SDK on network (e.g. "usdc"), or an ERC-20 contract address; an
+// unknown symbol is an error. The amount is in the token's smallest unit and must
+// be positive.
+func (a *EvmAccount) Transfer(ctx context.Context, to string, amount *big.Int, token, network string) (string, error) {
+	return a.UseNetwork(network).Transfer(ctx, to, amount, token)
+}
+
+// TransferOptions configures TransferAndWait.
+type TransferOptions struct {
+	// Timeout is how long to wait for the transfer to be confirmed. Defaults to a
+	// timeout based on the network's EstimatedConfirmationTime, as for
+	// Client.WaitForTransactionReceipt.
+	Timeout time.Duration
+	// Confirmations is the number of blocks to wait for, as in
+	// ReceiptOptions.Confirmations.
+	Confirmations int
+}
+
+// Transfer sends amount of token to the recipient and returns the transaction
+// hash. The recipient is an address, or a name resolved with ResolveName. The
+// token is the network's native token symbol (e.g. "eth"), the symbol of a token
+// known to the SDK (e.g. "usdc"), or an ERC-20 contract address. Amounts are in
+// the token's smallest unit (wei for ETH, 10^-6 USDC for USDC) and must be
+// positive.
+func (a *NetworkScopedEvmAccount) Transfer(ctx context.Context, to string, amount *big.Int, token string) (string, error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return "", errors.New("transfer amount must be positive")
+	}
+	to, err := a.client.resolveRecipient(ctx, a.Network, to)
+	if err != nil {
+		return "", err
+	}
+	tx, err := transferTransaction(a.Network, to, amount, token)
+	if err != nil {
+		return "", err
+	}
+	if tx.Data == "" {
+		tx.Data = "0x"
+	}
+	return a.SendTransaction(ctx, a.Network, tx)
+}
+
+// TransferAmount sends a decimal amount of token (e.g. "1.5") to the recipient and
+// returns the transaction hash. The recipient and token are identified as in Transfer; the
+// decimals of tokens the SDK doesn't know are read with Client.GetTokenMetadata.
+func (a *NetworkScopedEvmAccount) TransferAmount(ctx context.Context, to, amount, token string) (string, error) {
+	resolved, err := a.client.resolveToken(ctx, a.Network, token)
+	if err != nil {
+		return "", err
+	}
+	value, err := ParseUnits(amount, resolved.Decimals)
+	if err != nil {
+		return "", err
+	}
+	return a.Transfer(ctx, to, value, token)
+}
+
+// TransferAndWait sends a transfer like Transfer and waits until it is confirmed,
+// returning its receipt. If the transfer reverts, the receipt is returned along
+// with a *TransactionRevertedError. If it is not confirmed within opts.Timeout,
+// the error includes the transaction hash so the transfer can still be tracked.
+func (a *NetworkScopedEvmAccount) TransferAndWait(ctx context.Context, to string, amount *big.Int, token string, opts TransferOptions) (*TransactionReceipt, error) {
+	txHash, err := a.Transfer(ctx, to, amount, token)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultReceiptTimeout(a.Network)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	receipt, err := a.client.WaitForTransactionReceipt(waitCtx, a.Network, txHash, ReceiptOptions{Confirmations: opts.Confirmations})
+	if err != nil && receipt == nil {
+		return nil, fmt.Errorf("transfer %s was not confirmed: %w", txHash, err)
+	}
+	return receipt, err
+}
+
+// resolveRecipient returns the address of the transfer recipient to on network,
+// resolving it with ResolveName if it is a name.
+func (c *Client) resolveRecipient(ctx context.Context, network, to string) (string, error) {
+	address, err := ResolveName(ctx, c, network, to)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient: %w", err)
+	}
+	return address, nil
+}
+
+// transferTransaction builds the transaction that sends amount of token to the
+// address to on network.
+func transferTransaction(network, to string, amount *big.Int, token string) (TransactionRequest, error) {
+	if amount == nil || amount.Sign() < 0 {
+		return TransactionRequest{}, errors.New("transfer amount must be non-negative")
+	}
+	if _, err := addressWord(to); err != nil {
+		return TransactionRequest{}, fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	if strings.EqualFold(token, nativeSymbol(network)) {
+		return TransactionRequest{To: to, Value: amount}, nil
+	}
+
+	contract := token
+	if known, ok := lookupTokenBySymbol(network, token); ok {
+		contract = known.Address
+	} else if _, err := addressWord(token); err != nil {
+		return TransactionRequest{}, fmt.Errorf("unknown token %q on %s", token, network)
+	}
+
+	data, err := EncodeCall("transfer(address,uint256)", to, amount)
+	if err != nil {
+		return TransactionRequest{}, err
+	}
+	return TransactionRequest{To: contract, Data: data}, nil
+}
@@ -0,0 +1,186 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransferTransaction(t *testing.T) {
+	tests := []struct {
+		name, token, wantTo, wantData string
+		wantValue                     int64
+	}{
+		{"native", "ETH", testRecipient, "", 1000},
+		{"known token", "usdc", "0x036CbD53842c5426634e7929541eC2318f3dCF7e",
+			"0x" + erc20TransferSelector + fmt.Sprintf("%064s", testRecipient[2:]) + fmt.Sprintf("%064x", 1000), 0},
+		{"token address", testNFT, testNFT,
+			"0x" + erc20TransferSelector + fmt.Sprintf("%064s", testRecipient[2:]) + fmt.Sprintf("%064x", 1000), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := transferTransaction("base-sepolia", testRecipient, big.NewInt(1000), tt.token)
+			if err != nil {
+				t.Fatalf("transferTransaction returned an error: %v", err)
+			}
+			if tx.To != tt.wantTo || !strings.EqualFold(tx.Data, tt.wantData) {
+				t.Errorf("got to=%s data=%s, want to=%s data=%s", tx.To, tx.Data, tt.wantTo, tt.wantData)
+			}
+			if tt.wantValue != 0 && (tx.Value == nil || tx.Value.Int64() != tt.wantValue) {
+				t.Errorf("Value = %v, want %d", tx.Value, tt.wantValue)
+			}
+		})
+	}
+
+	if _, err := transferTransaction("base-sepolia", testRecipient, big.NewInt(1), "doge"); err == nil {
+		t.Error("expected an error for an unknown token")
+	}
+}
+
+func TestTransferAndWait(t *testing.T) {
+	setFastTimers(t)
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Network, Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path != "/v2/evm/accounts/"+testOwner+"/send/transaction" || body.Network != "base-sepolia" {
+			t.Errorf("unexpected request to %s with %+v", r.URL.Path, body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, testTxHash)
+	}))
+	defer api.Close()
+
+	t.Run("confirmed", func(t *testing.T) {
+		rpc, _ := newReceiptRPCServer(t, 2, "0x1")
+		client := newReceiptTestClient(t, api.URL, rpc.URL)
+		account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+		receipt, err := account.TransferAndWait(context.Background(), testRecipient, big.NewInt(1000), "usdc", TransferOptions{})
+		if err != nil {
+			t.Fatalf("TransferAndWait returned an error: %v", err)
+		}
+		if receipt.TransactionHash != testTxHash || receipt.Status != 1 {
+			t.Errorf("unexpected receipt %+v", receipt)
+		}
+	})
+
+	t.Run("reverted", func(t *testing.T) {
+		rpc, _ := newReceiptRPCServer(t, 0, "0x0")
+		client := newReceiptTestClient(t, api.URL, rpc.URL)
+		account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+		receipt, err := account.TransferAndWait(context.Background(), testRecipient, big.NewInt(1000), "eth", TransferOptions{})
+		if !errors.Is(err, ErrTransactionReverted) || receipt == nil {
+			t.Fatalf("expected a reverted receipt, got %v, %v", receipt, err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		rpc, _ := newReceiptRPCServer(t, 1<<30, "0x1")
+		client := newReceiptTestClient(t, api.URL, rpc.URL)
+		account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+		_, err := account.TransferAndWait(context.Background(), testRecipient, big.NewInt(1000), "eth", TransferOptions{Timeout: 20 * time.Millisecond})
+		if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), testTxHash) {
+			t.Fatalf("expected a timeout mentioning the transaction hash, got %v", err)
+		}
+	})
+}
+
+func TestTransferAmount(t *testing.T) {
+	transactions := make(chan string, 1)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		transactions <- body.Transaction
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, testTxHash)
+	}))
+	defer api.Close()
+	rpc, _ := newTokenRPCServer(t)
+	client := newReceiptTestClient(t, api.URL, rpc.URL)
+	account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+	if _, err := account.TransferAmount(context.Background(), testRecipient, "1.5", testToken); err != nil {
+		t.Fatalf("TransferAmount returned an error: %v", err)
+	}
+	if tx := <-transactions; !strings.Contains(tx, fmt.Sprintf("%064x", 150000000)) {
+		t.Errorf("transaction %s does not transfer 1.5 tokens with 8 decimals", tx)
+	}
+
+	if _, err := account.TransferAmount(context.Background(), testRecipient, "0.0000001", "usdc"); err == nil {
+		t.Error("expected an error for an amount with more decimals than the token")
+	}
+}
+
+func TestEvmServerAccountTransfer(t *testing.T) {
+	transactions := make(chan string, 1)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Network, Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path != "/v2/evm/accounts/"+testOwner+"/send/transaction" || body.Network != "base-sepolia" {
+			t.Errorf("unexpected request to %s with %+v", r.URL.Path, body)
+		}
+		transactions <- body.Transaction
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, testTxHash)
+	}))
+	defer api.Close()
+	var account *EvmServerAccount = &EvmAccount{client: newTestClient(t, api.URL), Address: testOwner}
+
+	tests := []struct {
+		token string
+		want  TransactionRequest
+	}{
+		{"eth", TransactionRequest{To: testRecipient, Value: big.NewInt(1000), Data: "0x"}},
+		{"USDC", TransactionRequest{To: "0x036CbD53842c5426634e7929541eC2318f3dCF7e",
+			Data: "0x" + erc20TransferSelector + fmt.Sprintf("%064s", testRecipient[2:]) + fmt.Sprintf("%064x", 1000)}},
+		{testNFT, TransactionRequest{To: testNFT,
+			Data: "0x" + erc20TransferSelector + fmt.Sprintf("%064s", testRecipient[2:]) + fmt.Sprintf("%064x", 1000)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			hash, err := account.Transfer(context.Background(), testRecipient, big.NewInt(1000), tt.token, "base-sepolia")
+			if err != nil || hash != testTxHash {
+				t.Fatalf("Transfer returned %q, %v", hash, err)
+			}
+			want, err := SerializeTransaction("base-sepolia", tt.want)
+			if err != nil {
+				t.Fatalf("failed to serialize the expected transaction: %v", err)
+			}
+			if got := <-transactions; !strings.EqualFold(got, want) {
+				t.Errorf("sent %s, want %s", got, want)
+			}
+		})
+	}
+
+	errorTests := []struct {
+		name, token, wantErr string
+		amount               *big.Int
+	}{
+		{"zero amount", "eth", "must be positive", big.NewInt(0)},
+		{"nil amount", "eth", "must be positive", nil},
+		{"unknown token", "doge", `unknown token "doge" on base-sepolia`, big.NewInt(1)},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := account.Transfer(context.Background(), testRecipient, tt.amount, tt.token, "base-sepolia")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+			_, err = account.UseNetwork("base-sepolia").Transfer(context.Background(), testRecipient, tt.amount, tt.token)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NetworkScopedEvmAccount.Transfer: expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package cdp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// eip712DomainType is the name of the EIP-712 domain struct type.
+const eip712DomainType = "EIP712Domain"
+
+// eip712DomainFields are the fields of the EIP-712 domain, in the order the
+// standard encodes them.
+var eip712DomainFields = []typedDataField{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+	{Name: "salt", Type: "bytes32"},
+}
+
+// typedDataField is a member of an EIP-712 struct type.
+type typedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// typedDataTypes maps EIP-712 struct type names to their members.
+type typedDataTypes map[string][]typedDataField
+
+// HashTypedDataDomain returns the 0x-prefixed EIP-712 domain separator of domain,
+// the hash of the domain struct with the fields that are set. Compare it with the
+// domain separator a verifying contract reports to find domain mismatches, the
+// most common cause of typed-data signatures that fail to verify.
+func HashTypedDataDomain(domain openapi.EIP712Domain) (string, error) {
+	hash, err := hashTypedDataDomain(nil, domain)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(hash), nil
+}
+
+// HashTypedData returns the 0x-prefixed EIP-712 digest of typedData, the hash
+// that EvmAccount.SignTypedData signs. If typedData.Types defines EIP712Domain,
+// the domain is encoded with those fields; otherwise with the domain fields that
+// are set.
+func HashTypedData(typedData openapi.EIP712Message) (string, error) {
+	var types typedDataTypes
+	if err := normalizeTypedData(typedData.Types, &types); err != nil {
+		return "", fmt.Errorf("invalid types: %w", err)
+	}
+
+	domainHash, err := hashTypedDataDomain(types, typedData.Domain)
+	if err != nil {
+		return "", err
+	}
+	if typedData.PrimaryType == eip712DomainType {
+		return "0x" + hex.EncodeToString(keccak256([]byte{0x19, 0x01}, domainHash)), nil
+	}
+
+	var message map[string]interface{}
+	if err := normalizeTypedData(typedData.Message, &message); err != nil {
+		return "", fmt.Errorf("invalid message: %w", err)
+	}
+	messageHash, err := hashStruct(types, typedData.PrimaryType, message)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(keccak256([]byte{0x19, 0x01}, domainHash, messageHash)), nil
+}
+
+// hashTypedDataDomain returns the domain separator of domain. The domain type is
+// taken from types if defined there, and otherwise from the fields that are set.
+func hashTypedDataDomain(types typedDataTypes, domain openapi.EIP712Domain) ([]byte, error) {
+	var values map[string]interface{}
+	if err := normalizeTypedData(domain, &values); err != nil {
+		return nil, fmt.Errorf("invalid domain: %w", err)
+	}
+
+	if _, ok := types[eip712DomainType]; !ok {
+		var fields []typedDataField
+		for _, field := range eip712DomainFields {
+			if _, ok := values[field.Name]; ok {
+				fields = append(fields, field)
+			}
+		}
+		types = typedDataTypes{eip712DomainType: fields}
+	}
+
+	hash, err := hashStruct(types, eip712DomainType, values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain: %w", err)
+	}
+	return hash, nil
+}
+
+// normalizeTypedData converts v to out through JSON, so typed data built from Go
+// values and typed data decoded from JSON are encoded the same way. Numbers are
+// decoded as json.Number to keep their precision.
+func normalizeTypedData(v, out interface{}) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	return decoder.Decode(out)
+}
+
+// hashStruct returns the EIP-712 hashStruct of data as the struct type name.
+func hashStruct(types typedDataTypes, name string, data map[string]interface{}) ([]byte, error) {
+	fields, ok := types[name]
+	if !ok {
+		return nil, fmt.Errorf("undefined type %q", name)
+	}
+
+	encoded := [][]byte{keccak256([]byte(encodeType(types, name)))}
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing field %q", name, field.Name)
+		}
+		word, err := encodeTypedValue(types, field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, field.Name, err)
+		}
+		encoded = append(encoded, word)
+	}
+	return keccak256(encoded...), nil
+}
+
+// encodeType returns the EIP-712 type string of the struct type name: its own
+// definition followed by those of the struct types it references, sorted by name.
+func encodeType(types typedDataTypes, name string) string {
+	deps := map[string]bool{}
+	collectTypeDeps(types, name, deps)
+	delete(deps, name)
+
+	names := []string{name}
+	for dep := range deps {
+		names = append(names, dep)
+	}
+	slices.Sort(names[1:])
+
+	var b strings.Builder
+	for _, n := range names {
+		b.WriteString(n + "(")
+		for i, field := range types[n] {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(field.Type + " " + field.Name)
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// collectTypeDeps adds name and the struct types it references to deps.
+func collectTypeDeps(types typedDataTypes, name string, deps map[string]bool) {
+	if deps[name] {
+		return
+	}
+	if _, ok := types[name]; !ok {
+		return
+	}
+	deps[name] = true
+	for _, field := range types[name] {
+		base, _, _ := strings.Cut(field.Type, "[")
+		collectTypeDeps(types, base, deps)
+	}
+}
+
+// encodeTypedValue returns the 32-byte EIP-712 encoding of value as typ.
+func encodeTypedValue(types typedDataTypes, typ string, value interface{}) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		open := strings.LastIndex(typ, "[")
+		if open < 0 {
+			return nil, fmt.Errorf("invalid type %q", typ)
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an array for %s", typ)
+		}
+		if size := typ[open+1 : len(typ)-1]; size != "" {
+			if n, err := strconv.Atoi(size); err != nil || n != len(items) {
+				return nil, fmt.Errorf("expected %s elements for %s, got %d", size, typ, len(items))
+			}
+		}
+		encoded := make([][]byte, len(items))
+		for i, item := range items {
+			word, err := encodeTypedValue(types, typ[:open], item)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			encoded[i] = word
+		}
+		return keccak256(encoded...), nil
+	}
+
+	if _, ok := types[typ]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object for %s", typ)
+		}
+		return hashStruct(types, typ, data)
+	}
+
+	switch {
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %v", value)
+		}
+		return keccak256([]byte(s)), nil
+	case typ == "bytes":
+		data, err := typedDataBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(data), nil
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %v", value)
+		}
+		word := make([]byte, abiWordSize)
+		if b {
+			word[abiWordSize-1] = 1
+		}
+		return word, nil
+	case typ == "address":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected an address, got %v", value)
+		}
+		return addressWord(s)
+	case strings.HasPrefix(typ, "bytes"):
+		n, err := strconv.Atoi(typ[len("bytes"):])
+		if err != nil || n < 1 || n > abiWordSize {
+			return nil, fmt.Errorf("invalid type %q", typ)
+		}
+		data, err := typedDataBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != n {
+			return nil, fmt.Errorf("expected %d bytes, got %d", n, len(data))
+		}
+		word := make([]byte, abiWordSize)
+		copy(word, data)
+		return word, nil
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		return encodeTypedInt(typ, value)
+	}
+	return nil, fmt.Errorf("unsupported type %q", typ)
+}
+
+// typedDataBytes decodes a 0x-prefixed hex bytes value.
+func typedDataBytes(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("expected 0x-prefixed hex bytes, got %v", value)
+	}
+	return decodeHexData(s)
+}
+
+// encodeTypedInt encodes value as the integer type typ, e.g. uint256 or int8.
+// Values may be JSON numbers, decimal strings, or 0x-prefixed hex strings.
+func encodeTypedInt(typ string, value interface{}) ([]byte, error) {
+	signed := strings.HasPrefix(typ, "int")
+	bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(typ, "u"), "int"))
+	if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+		return nil, fmt.Errorf("invalid type %q", typ)
+	}
+
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("expected an integer, got %v", value)
+	}
+	n, ok := new(big.Int), false
+	if hexDigits, isHex := strings.CutPrefix(s, "0x"); isHex {
+		n, ok = n.SetString(hexDigits, 16)
+	} else {
+		n, ok = n.SetString(s, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	low := big.NewInt(0)
+	if signed {
+		limit.Rsh(limit, 1)
+		low.Neg(limit)
+	}
+	if n.Cmp(low) < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("%s out of range for %s", n, typ)
+	}
+	if n.Sign() < 0 {
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return uintWord(n), nil
+}
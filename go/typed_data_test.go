@@ -0,0 +1,162 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// mailTypedData is the example from the EIP-712 specification.
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func mailMessage(t *testing.T) openapi.EIP712Message {
+	t.Helper()
+	var typedData openapi.EIP712Message
+	if err := json.Unmarshal([]byte(mailTypedData), &typedData); err != nil {
+		t.Fatalf("failed to decode typed data: %v", err)
+	}
+	return typedData
+}
+
+func TestHashTypedDataSpecVectors(t *testing.T) {
+	typedData := mailMessage(t)
+
+	if got := encodeType(typedDataTypes{
+		"Person": {{"name", "string"}, {"wallet", "address"}},
+		"Mail":   {{"from", "Person"}, {"to", "Person"}, {"contents", "string"}},
+	}, "Mail"); got != "Mail(Person from,Person to,string contents)Person(string name,address wallet)" {
+		t.Errorf("encodeType = %s", got)
+	}
+
+	domainHash, err := HashTypedDataDomain(typedData.Domain)
+	if err != nil {
+		t.Fatalf("HashTypedDataDomain returned an error: %v", err)
+	}
+	if want := "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"; domainHash != want {
+		t.Errorf("HashTypedDataDomain = %s, want %s", domainHash, want)
+	}
+
+	digest, err := HashTypedData(typedData)
+	if err != nil {
+		t.Fatalf("HashTypedData returned an error: %v", err)
+	}
+	if want := "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"; digest != want {
+		t.Errorf("HashTypedData = %s, want %s", digest, want)
+	}
+
+	// The same typed data built from Go values hashes identically.
+	chainID := int64(1)
+	typedData.Domain.ChainId = &chainID
+	typedData.Message["from"] = map[string]string{"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"}
+	if again, err := HashTypedData(typedData); err != nil || again != digest {
+		t.Errorf("HashTypedData of Go values = %s, %v, want %s", again, err, digest)
+	}
+}
+
+func TestHashTypedDataValues(t *testing.T) {
+	typedData := openapi.EIP712Message{
+		Domain:      openapi.EIP712Domain{},
+		PrimaryType: "Values",
+		Types: openapi.EIP712Types{
+			"Values": []map[string]string{
+				{"name": "small", "type": "int8"},
+				{"name": "hex", "type": "uint256"},
+				{"name": "flag", "type": "bool"},
+				{"name": "data", "type": "bytes"},
+				{"name": "tag", "type": "bytes4"},
+				{"name": "list", "type": "uint8[2]"},
+			},
+		},
+		Message: map[string]interface{}{
+			"small": -1,
+			"hex":   "0xff",
+			"flag":  true,
+			"data":  "0x1234",
+			"tag":   "0xdeadbeef",
+			"list":  []int{1, 2},
+		},
+	}
+	if _, err := HashTypedData(typedData); err != nil {
+		t.Fatalf("HashTypedData returned an error: %v", err)
+	}
+
+	invalid := []struct {
+		field string
+		value interface{}
+	}{
+		{"small", 128},
+		{"hex", -1},
+		{"tag", "0xdead"},
+		{"list", []int{1}},
+		{"flag", "yes"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.field, func(t *testing.T) {
+			message := map[string]interface{}{}
+			for k, v := range typedData.Message {
+				message[k] = v
+			}
+			message[tt.field] = tt.value
+			bad := typedData
+			bad.Message = message
+			if _, err := HashTypedData(bad); err == nil || !strings.Contains(err.Error(), tt.field) {
+				t.Errorf("expected an error for %s = %v, got %v", tt.field, tt.value, err)
+			}
+		})
+	}
+}
+
+func TestSignTypedData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body openapi.EIP712Message
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path != "/v2/evm/accounts/"+testOwner+"/sign/typed-data" || body.PrimaryType != "Mail" {
+			t.Errorf("unexpected request to %s with %+v", r.URL.Path, body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"signature":"0xsig"}`)
+	}))
+	defer server.Close()
+	account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+
+	signature, err := account.SignTypedData(context.Background(), mailMessage(t))
+	if err != nil || signature != "0xsig" {
+		t.Fatalf("SignTypedData = %q, %v", signature, err)
+	}
+}
@@ -0,0 +1,129 @@
+package cdp
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// UserOperationStatus is the status of a user operation.
+type UserOperationStatus string
+
+// User operation statuses.
+const (
+	UserOperationPending   UserOperationStatus = "pending"
+	UserOperationSigned    UserOperationStatus = "signed"
+	UserOperationBroadcast UserOperationStatus = "broadcast"
+	UserOperationComplete  UserOperationStatus = "complete"
+	UserOperationFailed    UserOperationStatus = "failed"
+	UserOperationDropped   UserOperationStatus = "dropped"
+)
+
+// Call is a call made by a user operation.
+type Call struct {
+	// To is the address the call is made to.
+	To string
+	// Value is the amount of native token, in wei, sent with the call.
+	Value *big.Int
+	// Data is the 0x-prefixed calldata.
+	Data string
+}
+
+// UserOperation is an EVM smart account user operation.
+type UserOperation struct {
+	// UserOpHash is the hash of the user operation. It is not the hash of the
+	// transaction that includes it.
+	UserOpHash string
+	// Network is the network the user operation is for.
+	Network string
+	// Status is the status of the user operation.
+	Status UserOperationStatus
+	// Calls are the calls the user operation makes.
+	Calls []Call
+	// TransactionHash is the hash of the transaction that included the user
+	// operation, once it has been included in a block, and empty before that.
+	//
+	// Depending on the network and on how far the operation has progressed, the
+	// API reports it in the operation's transactionHash field, only in its
+	// receipts, or not yet at all; the response to sending an operation may
+	// already carry it where operations are included quickly. It is taken from
+	// whichever is set, preferring the transactionHash field, so callers need not
+	// check both.
+	TransactionHash string
+	// ExpiresAt is when a prepared user operation expires, if set.
+	ExpiresAt time.Time
+	// RevertReason is why the user operation reverted, if its receipts report a
+	// revert: the decoded revert message, or the raw revert data if the API could
+	// not decode it.
+	RevertReason string
+}
+
+// newUserOperation converts an API user operation into a UserOperation.
+func newUserOperation(op *openapi.EvmUserOperation) (*UserOperation, error) {
+	result := &UserOperation{
+		UserOpHash:      op.UserOpHash,
+		Network:         string(op.Network),
+		Status:          UserOperationStatus(op.Status),
+		Calls:           make([]Call, len(op.Calls)),
+		TransactionHash: stringValue(op.TransactionHash),
+	}
+	if op.Receipts != nil {
+		for _, receipt := range *op.Receipts {
+			if hash := stringValue(receipt.TransactionHash); hash != "" && stringValue(op.TransactionHash) == "" {
+				result.TransactionHash = hash
+			}
+			if receipt.Revert != nil {
+				result.RevertReason = receipt.Revert.Message
+				if result.RevertReason == "" {
+					result.RevertReason = receipt.Revert.Data
+				}
+			}
+		}
+	}
+	if op.ExpiresAt != nil {
+		result.ExpiresAt = *op.ExpiresAt
+	}
+	for i, call := range op.Calls {
+		value := new(big.Int)
+		if call.Value != "" {
+			var err error
+			if value, err = ParseAmount(call.Value); err != nil {
+				return nil, fmt.Errorf("invalid value of call %d in user operation %s: %w", i, op.UserOpHash, err)
+			}
+		}
+		result.Calls[i] = Call{To: call.To, Value: value, Data: call.Data}
+	}
+	return result, nil
+}
+
+// TokenBalance is an account's balance of a token.
+type TokenBalance struct {
+	// Token is the token. The native token has the address
+	// 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE, per EIP-7528. Name and Symbol
+	// are empty for tokens the API has no metadata for.
+	Token Token
+	// Network is the network the balance is on.
+	Network string
+	// Amount is the balance in the token's smallest unit.
+	Amount *big.Int
+}
+
+// newTokenBalance converts an API token balance into a TokenBalance.
+func newTokenBalance(balance openapi.TokenBalance) (TokenBalance, error) {
+	amount, err := ParseAmount(balance.Amount.Amount)
+	if err != nil {
+		return TokenBalance{}, fmt.Errorf("invalid balance of token %s: %w", balance.Token.ContractAddress, err)
+	}
+	return TokenBalance{
+		Token: Token{
+			Name:     stringValue(balance.Token.Name),
+			Symbol:   stringValue(balance.Token.Symbol),
+			Address:  balance.Token.ContractAddress,
+			Decimals: int(balance.Amount.Decimals),
+		},
+		Network: string(balance.Token.Network),
+		Amount:  amount,
+	}, nil
+}
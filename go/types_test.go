@@ -0,0 +1,152 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestNewUserOperation(t *testing.T) {
+	txHash := testTxHash
+	expires := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	op, err := newUserOperation(&openapi.EvmUserOperation{
+		UserOpHash: "0xop",
+		Network:    "base-sepolia",
+		Status:     openapi.EvmUserOperationStatusComplete,
+		Calls: []openapi.EvmCall{
+			{To: testRecipient, Value: "1000", Data: "0x"},
+			{To: testRecipient, Value: "0x10", Data: "0xabcd"},
+			{To: testRecipient, Data: "0x"},
+		},
+		TransactionHash: &txHash,
+		ExpiresAt:       &expires,
+	})
+	if err != nil {
+		t.Fatalf("newUserOperation returned an error: %v", err)
+	}
+
+	if op.UserOpHash != "0xop" || op.Network != "base-sepolia" || op.Status != UserOperationComplete ||
+		op.TransactionHash != testTxHash || !op.ExpiresAt.Equal(expires) {
+		t.Errorf("got %+v", op)
+	}
+	for i, want := range []int64{1000, 16, 0} {
+		if op.Calls[i].Value.Int64() != want || op.Calls[i].To != testRecipient {
+			t.Errorf("call %d = %+v, want value %d", i, op.Calls[i], want)
+		}
+	}
+
+	if _, err := newUserOperation(&openapi.EvmUserOperation{Calls: []openapi.EvmCall{{Value: "lots"}}}); err == nil {
+		t.Error("expected an error for an invalid call value")
+	}
+}
+
+func TestListTokenBalances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/evm/token-balances/base-sepolia/"+testOwner {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("pageToken") == "" {
+			fmt.Fprint(w, `{"balances":[{
+				"amount":{"amount":"1500000","decimals":6},
+				"token":{"contractAddress":"0x036CbD53842c5426634e7929541eC2318f3dCF7e","name":"USD Coin","symbol":"USDC","network":"base-sepolia"}
+			}],"nextPageToken":"p2"}`)
+			return
+		}
+		fmt.Fprint(w, `{"balances":[{
+			"amount":{"amount":"115792089237316195423570985008687907853269984665640564039457584007913129639935","decimals":18},
+			"token":{"contractAddress":"0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE","network":"base-sepolia"}
+		}]}`)
+	}))
+	defer server.Close()
+
+	it := ListTokenBalances(newTestClient(t, server.URL), testOwner, "base-sepolia", ListOptions{})
+	var balances []TokenBalance
+	for {
+		balance, ok, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next returned an error: %v", err)
+		}
+		if !ok {
+			break
+		}
+		balances = append(balances, balance)
+	}
+
+	if len(balances) != 2 {
+		t.Fatalf("got %d balances, want 2", len(balances))
+	}
+	usdc := balances[0]
+	if usdc.Token.Symbol != "USDC" || usdc.Token.Name != "USD Coin" || usdc.Token.Decimals != 6 ||
+		usdc.Network != "base-sepolia" || usdc.Amount.Int64() != 1500000 {
+		t.Errorf("got %+v", usdc)
+	}
+	if eth := balances[1]; eth.Token.Symbol != "" || eth.Amount.Cmp(maxUint256) != 0 {
+		t.Errorf("got %+v", eth)
+	}
+}
+
+func TestNewUserOperationTransactionHash(t *testing.T) {
+	txHash, otherHash := testTxHash, "0x"+fmt.Sprintf("%064x", 1)
+	tests := []struct {
+		name     string
+		top      *string
+		receipts *[]openapi.UserOperationReceipt
+		want     string
+	}{
+		{"no transaction yet", nil, nil, ""},
+		{"transactionHash field", &txHash, nil, testTxHash},
+		{"receipts only", nil, &[]openapi.UserOperationReceipt{{}, {TransactionHash: &txHash}}, testTxHash},
+		{"both, preferring the field", &txHash, &[]openapi.UserOperationReceipt{{TransactionHash: &otherHash}}, testTxHash},
+	}
+	for _, tt := range tests {
+		op, err := newUserOperation(&openapi.EvmUserOperation{
+			UserOpHash:      "0xop",
+			Network:         "base",
+			Status:          openapi.EvmUserOperationStatusBroadcast,
+			TransactionHash: tt.top,
+			Receipts:        tt.receipts,
+		})
+		if err != nil {
+			t.Fatalf("%s: newUserOperation returned an error: %v", tt.name, err)
+		}
+		if op.UserOpHash != "0xop" || op.TransactionHash != tt.want {
+			t.Errorf("%s: got UserOpHash %q and TransactionHash %q, want 0xop and %q", tt.name, op.UserOpHash, op.TransactionHash, tt.want)
+		}
+	}
+}
+
+func TestSendUserOperationResponses(t *testing.T) {
+	responses := []string{
+		`{"network":"base","calls":[],"status":"broadcast","userOpHash":"0xop"}`,
+		`{"network":"base","calls":[],"status":"complete","userOpHash":"0xop","transactionHash":"` + testTxHash + `"}`,
+		`{"network":"base","calls":[],"status":"broadcast","transactionHash":"` + testTxHash + `"}`,
+	}
+	var next int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, responses[next])
+		next++
+	}))
+	defer server.Close()
+
+	account := NewSmartAccount(newTestClient(t, server.URL), testOwner, testOtherOwner)
+	calls := []openapi.EvmCall{{To: testRecipient, Value: "0", Data: "0x"}}
+	for _, want := range []string{"", testTxHash} {
+		op, err := account.SendUserOperation(context.Background(), calls, "base", UserOperationOptions{})
+		if err != nil {
+			t.Fatalf("SendUserOperation returned an error: %v", err)
+		}
+		if op.UserOpHash != "0xop" || op.TransactionHash != want {
+			t.Errorf("got UserOpHash %q and TransactionHash %q, want 0xop and %q", op.UserOpHash, op.TransactionHash, want)
+		}
+	}
+	if _, err := account.SendUserOperation(context.Background(), calls, "base", UserOperationOptions{}); err == nil {
+		t.Error("expected an error for a response without a user operation hash")
+	}
+}
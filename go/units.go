@@ -0,0 +1,138 @@
+package cdp
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// EtherDecimals is the number of decimals of ETH (and most EVM native tokens).
+const EtherDecimals = 18
+
+// GweiDecimals is the number of decimals of an amount of wei in gwei, the unit
+// gas prices are usually given in.
+const GweiDecimals = 9
+
+// maxUnitDecimals is the largest number of decimals ParseUnits accepts: any more
+// and even the smallest nonzero whole amount would not fit in 256 bits.
+const maxUnitDecimals = 77
+
+// maxUnitAmount is the largest magnitude ParseUnits returns, the largest uint256,
+// so that parsed amounts always fit in an EVM word.
+var maxUnitAmount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// FormatUnits formats value, expressed in the token's smallest unit, as a decimal
+// string with the given number of decimals. Trailing zeros in the fractional part
+// are trimmed, so FormatUnits(big.NewInt(1500000), 6) returns "1.5".
+func FormatUnits(value *big.Int, decimals int) string {
+	if value == nil {
+		return "0"
+	}
+
+	negative := value.Sign() < 0
+	digits := new(big.Int).Abs(value).String()
+
+	if decimals > 0 {
+		if len(digits) <= decimals {
+			digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+		}
+		whole, fraction := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+		digits = whole
+		if fraction != "" {
+			digits += "." + fraction
+		}
+	}
+
+	if negative {
+		return "-" + digits
+	}
+	return digits
+}
+
+// FormatEther formats an amount of wei as a decimal amount of ETH.
+func FormatEther(wei *big.Int) string {
+	return FormatUnits(wei, EtherDecimals)
+}
+
+// FormatGwei formats an amount of wei as a decimal amount of gwei.
+func FormatGwei(wei *big.Int) string {
+	return FormatUnits(wei, GweiDecimals)
+}
+
+// ParseEther parses a decimal amount of ETH (e.g. "0.01") into wei. See
+// ParseUnits.
+func ParseEther(value string) (*big.Int, error) {
+	return ParseUnits(value, EtherDecimals)
+}
+
+// ParseGwei parses a decimal amount of gwei (e.g. "1.5") into wei. See ParseUnits.
+func ParseGwei(value string) (*big.Int, error) {
+	return ParseUnits(value, GweiDecimals)
+}
+
+// RoundingMode determines how ParseUnitsRounded handles digits beyond a token's
+// decimals.
+type RoundingMode int
+
+const (
+	// RoundExact rejects values that cannot be represented exactly.
+	RoundExact RoundingMode = iota
+	// RoundDown rounds toward zero, so a parsed amount never exceeds the value;
+	// use it when spending to avoid sending more than intended.
+	RoundDown
+	// RoundUp rounds away from zero, so a parsed amount is never less than the
+	// value; use it for amounts that must be covered in full.
+	RoundUp
+	// RoundNearest rounds to the nearest unit, with halves rounded away from zero.
+	RoundNearest
+)
+
+// ParseUnits parses a decimal amount (e.g. "1.5") into the token's smallest unit,
+// given its number of decimals. It returns an error if value has more fractional
+// digits than decimals, rather than rounding, and if the amount does not fit in
+// 256 bits.
+func ParseUnits(value string, decimals int) (*big.Int, error) {
+	return ParseUnitsRounded(value, decimals, RoundExact)
+}
+
+// ParseUnitsRounded parses a decimal amount (e.g. "1.5") into the token's smallest
+// unit, given its number of decimals, rounding digits beyond decimals according to
+// mode. With RoundExact it returns an error if rounding would change the value.
+// Like ParseUnits, it returns an error if the amount does not fit in 256 bits.
+func ParseUnitsRounded(value string, decimals int, mode RoundingMode) (*big.Int, error) {
+	if decimals < 0 || decimals > maxUnitDecimals {
+		return nil, fmt.Errorf("invalid number of decimals %d", decimals)
+	}
+	digits := strings.TrimPrefix(value, "-")
+	whole, fraction, _ := strings.Cut(digits, ".")
+	if whole == "" && fraction == "" || strings.Trim(whole+fraction, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid amount %q", value)
+	}
+
+	var excess string
+	if len(fraction) > decimals {
+		fraction, excess = fraction[:decimals], fraction[decimals:]
+	}
+
+	amount, _ := new(big.Int).SetString("0"+whole+fraction+strings.Repeat("0", decimals-len(fraction)), 10)
+	if strings.Trim(excess, "0") != "" {
+		switch mode {
+		case RoundExact:
+			return nil, fmt.Errorf("amount %q has more than %d decimals", value, decimals)
+		case RoundUp:
+			amount.Add(amount, big.NewInt(1))
+		case RoundNearest:
+			if excess[0] >= '5' {
+				amount.Add(amount, big.NewInt(1))
+			}
+		}
+	}
+
+	if amount.Cmp(maxUnitAmount) > 0 {
+		return nil, fmt.Errorf("amount %q is too large", value)
+	}
+	if digits != value {
+		amount.Neg(amount)
+	}
+	return amount, nil
+}
@@ -0,0 +1,181 @@
+package cdp
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestFormatUnits(t *testing.T) {
+	large, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	tests := map[string]struct {
+		value    *big.Int
+		decimals int
+		want     string
+	}{
+		"zero":                  {big.NewInt(0), 18, "0"},
+		"nil":                   {nil, 18, "0"},
+		"whole":                 {big.NewInt(1_000_000), 6, "1"},
+		"trims trailing zeros":  {big.NewInt(1_500_000), 6, "1.5"},
+		"smaller than one unit": {big.NewInt(1), 18, "0.000000000000000001"},
+		"negative":              {big.NewInt(-2_500_000), 6, "-2.5"},
+		"zero decimals":         {big.NewInt(42), 0, "42"},
+		"larger than int64":     {large, 18, "123456789012.34567890123456789"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := FormatUnits(tc.value, tc.decimals); got != tc.want {
+				t.Errorf("FormatUnits(%v, %d) = %q, want %q", tc.value, tc.decimals, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFormatEther(t *testing.T) {
+	if got := FormatEther(big.NewInt(100_000_000_000_000_000)); got != "0.1" {
+		t.Errorf("FormatEther = %q, want %q", got, "0.1")
+	}
+}
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals int
+		want     string
+	}{
+		{"1.5", 6, "1500000"},
+		{"0.000001", 6, "1"},
+		{"1", 18, "1000000000000000000"},
+		{".5", 1, "5"},
+		{"2.", 2, "200"},
+		{"1.50", 1, "15"},
+		{"-0.25", 2, "-25"},
+		{"12345678901234567890.123456789012345678", 18, "12345678901234567890123456789012345678"},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnits(tt.value, tt.decimals)
+		if err != nil {
+			t.Errorf("ParseUnits(%q, %d) returned an error: %v", tt.value, tt.decimals, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParseUnits(%q, %d) = %s, want %s", tt.value, tt.decimals, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", ".", "1.2.3", "abc", "1e6", "--1", "0.0000001"} {
+		if _, err := ParseUnits(value, 6); err == nil {
+			t.Errorf("ParseUnits(%q, 6) succeeded, want an error", value)
+		}
+	}
+}
+
+func TestParseUnitsRounded(t *testing.T) {
+	tests := []struct {
+		value string
+		mode  RoundingMode
+		want  string
+	}{
+		{"1.234", RoundExact, "1234"},
+		{"1.2340", RoundExact, "1234"},
+		{"1.2341", RoundDown, "1234"},
+		{"1.2349", RoundDown, "1234"},
+		{"1.2341", RoundUp, "1235"},
+		{"1.2340000", RoundUp, "1234"},
+		{"1.2344", RoundNearest, "1234"},
+		{"1.2345", RoundNearest, "1235"},
+		{"1.2349", RoundNearest, "1235"},
+		{"0.0009", RoundDown, "0"},
+		{"0.0001", RoundUp, "1"},
+		{"0.9999", RoundUp, "1000"},
+		{"-1.2341", RoundDown, "-1234"},
+		{"-1.2341", RoundUp, "-1235"},
+		{"-1.2345", RoundNearest, "-1235"},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnitsRounded(tt.value, 3, tt.mode)
+		if err != nil {
+			t.Errorf("ParseUnitsRounded(%q, 3, %d) returned an error: %v", tt.value, tt.mode, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParseUnitsRounded(%q, 3, %d) = %s, want %s", tt.value, tt.mode, got, tt.want)
+		}
+	}
+
+	if _, err := ParseUnitsRounded("1.2341", 3, RoundExact); err == nil {
+		t.Error("expected RoundExact to reject a value that would lose precision")
+	}
+}
+
+func TestParseUnitsEdgeCases(t *testing.T) {
+	maxUint256 := "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	tests := []struct {
+		value    string
+		decimals int
+		want     string
+	}{
+		{"0", 18, "0"},
+		{"0.0", 18, "0"},
+		{"-0", 6, "0"},
+		{"-1", 0, "-1"},
+		{maxUint256, 0, maxUint256},
+		{"-" + maxUint256, 0, "-" + maxUint256},
+		{"0." + strings.Repeat("0", 76) + "1", 77, "1"},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnits(tt.value, tt.decimals)
+		if err != nil {
+			t.Errorf("ParseUnits(%q, %d) returned an error: %v", tt.value, tt.decimals, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParseUnits(%q, %d) = %s, want %s", tt.value, tt.decimals, got, tt.want)
+		}
+	}
+
+	invalid := []struct {
+		value    string
+		decimals int
+	}{
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", 0},
+		{"1", 78},
+		{"1", -1},
+		{"1" + strings.Repeat("0", 60), 18},
+		{"+1", 6},
+		{"-", 6},
+	}
+	for _, tt := range invalid {
+		if got, err := ParseUnits(tt.value, tt.decimals); err == nil {
+			t.Errorf("ParseUnits(%q, %d) = %s, want an error", tt.value, tt.decimals, got)
+		}
+	}
+	if _, err := ParseUnitsRounded(maxUint256+".9", 0, RoundUp); err == nil {
+		t.Error("expected rounding up past the largest uint256 to fail")
+	}
+}
+
+func TestParseEtherAndGwei(t *testing.T) {
+	wei, err := ParseEther("0.000001")
+	if err != nil || wei.String() != "1000000000000" {
+		t.Errorf("ParseEther = %v, %v", wei, err)
+	}
+	if _, err := ParseEther("0.0000000000000000001"); err == nil {
+		t.Error("expected ParseEther to reject amounts below one wei")
+	}
+	gwei, err := ParseGwei("1.5")
+	if err != nil || gwei.String() != "1500000000" {
+		t.Errorf("ParseGwei = %v, %v", gwei, err)
+	}
+	if got := FormatGwei(gwei); got != "1.5" {
+		t.Errorf("FormatGwei = %q, want %q", got, "1.5")
+	}
+	if got := FormatEther(big.NewInt(-1)); got != "-0.000000000000000001" {
+		t.Errorf("FormatEther(-1) = %q", got)
+	}
+	if got := FormatEther(new(big.Int)); got != "0" {
+		t.Errorf("FormatEther(0) = %q, want %q", got, "0")
+	}
+}
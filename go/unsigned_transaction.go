@@ -0,0 +1,142 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// legacyTxFields is the number of fields of an unsigned legacy transaction, before
+// the signature fields.
+const legacyTxFields = 6
+
+// eip1559TxFields is the number of fields of an unsigned EIP-1559 transaction,
+// before the signature fields.
+const eip1559TxFields = 9
+
+// SendUnsignedTransaction signs a binary-encoded, unsigned transaction with the
+// account via CDP, sends it on the account's network, and returns the transaction
+// hash. It bridges transactions built with other libraries to CDP custody; for
+// go-ethereum transactions, use SendTransaction in the
+// github.com/coinbase/cdp-sdk/go/geth module.
+//
+// The transaction is sent with the nonce and gas it was built with, including a
+// nonce of 0; the client's NonceManager and gas limit estimation are not used.
+// EIP-1559 (dynamic fee) transactions are sent as they are, and their chain ID
+// must match the network. Legacy transactions are sent as EIP-1559 transactions
+// with both fee caps set to their gas price, which costs the same; their chain
+// ID, if set, must match the network. EIP-2930 and blob transactions are not
+// supported. The signature fields must be empty, as geth leaves them on
+// transactions that have not been signed.
+func (a *NetworkScopedEvmAccount) SendUnsignedTransaction(ctx context.Context, unsignedTx []byte) (string, error) {
+	if err := a.client.checkWritable(); err != nil {
+		return "", err
+	}
+	if len(unsignedTx) == 0 {
+		return "", errors.New("empty transaction")
+	}
+
+	if unsignedTx[0] >= 0xc0 {
+		tx, err := decodeLegacyTransaction(a.Network, unsignedTx)
+		if err != nil {
+			return "", err
+		}
+		if err := a.client.checkRecipient(tx.To, tx.Value, tx.Data); err != nil {
+			return "", err
+		}
+		return a.sendTransaction(ctx, a.Network, tx)
+	}
+
+	if unsignedTx[0] != eip1559TxType {
+		return "", fmt.Errorf("unsupported transaction type %d: only legacy and EIP-1559 transactions can be sent", unsignedTx[0])
+	}
+	items, err := rlpListItems(unsignedTx[1:])
+	if err != nil {
+		return "", fmt.Errorf("malformed transaction: %w", err)
+	}
+	if len(items) != eip1559TxFields && len(items) != eip1559TxFields+3 {
+		return "", fmt.Errorf("malformed transaction: expected %d fields, got %d", eip1559TxFields, len(items))
+	}
+	if err := checkUnsigned(items[eip1559TxFields:]); err != nil {
+		return "", err
+	}
+	if err := checkTransactionChainID(a.Network, new(big.Int).SetBytes(items[0])); err != nil {
+		return "", err
+	}
+	to, value, data := items[5], new(big.Int).SetBytes(items[6]), items[7]
+	if err := a.client.checkRecipient(optionalAddress(to), value, "0x"+hex.EncodeToString(data)); err != nil {
+		return "", err
+	}
+	return a.sendSerializedTransaction(ctx, a.Network, "0x"+hex.EncodeToString(unsignedTx))
+}
+
+// decodeLegacyTransaction decodes an unsigned legacy transaction for network. Its
+// signature fields, if present, hold the chain ID (EIP-155) or are empty.
+func decodeLegacyTransaction(network string, unsignedTx []byte) (TransactionRequest, error) {
+	items, err := rlpListItems(unsignedTx)
+	if err != nil {
+		return TransactionRequest{}, fmt.Errorf("malformed transaction: %w", err)
+	}
+	if len(items) != legacyTxFields && len(items) != legacyTxFields+3 {
+		return TransactionRequest{}, fmt.Errorf("malformed transaction: expected %d fields, got %d", legacyTxFields, len(items))
+	}
+	if len(items) > legacyTxFields {
+		if err := checkUnsigned(items[legacyTxFields+1:]); err != nil {
+			return TransactionRequest{}, err
+		}
+		if v := new(big.Int).SetBytes(items[legacyTxFields]); v.Sign() != 0 {
+			if err := checkTransactionChainID(network, v); err != nil {
+				return TransactionRequest{}, err
+			}
+		}
+	}
+
+	nonce, gas := new(big.Int).SetBytes(items[0]), new(big.Int).SetBytes(items[2])
+	if !nonce.IsUint64() || !gas.IsUint64() {
+		return TransactionRequest{}, errors.New("malformed transaction: nonce or gas limit overflows uint64")
+	}
+	gasPrice := new(big.Int).SetBytes(items[1])
+	return TransactionRequest{
+		Nonce:                nonce.Uint64(),
+		MaxPriorityFeePerGas: gasPrice,
+		MaxFeePerGas:         gasPrice,
+		Gas:                  gas.Uint64(),
+		To:                   optionalAddress(items[3]),
+		Value:                new(big.Int).SetBytes(items[4]),
+		Data:                 "0x" + hex.EncodeToString(items[5]),
+	}, nil
+}
+
+// checkUnsigned returns an error if any of the signature fields sig is set.
+func checkUnsigned(sig [][]byte) error {
+	for _, field := range sig {
+		if new(big.Int).SetBytes(field).Sign() != 0 {
+			return errors.New("transaction is already signed")
+		}
+	}
+	return nil
+}
+
+// checkTransactionChainID returns an error wrapping ErrChainIDMismatch if chainID
+// is not the chain ID of network.
+func checkTransactionChainID(network string, chainID *big.Int) error {
+	want, err := ChainID(network)
+	if err != nil {
+		return err
+	}
+	if chainID.Cmp(big.NewInt(want)) != 0 {
+		return fmt.Errorf("%w: chain ID %s is not %s (%d)", ErrChainIDMismatch, chainID, network, want)
+	}
+	return nil
+}
+
+// optionalAddress returns the 0x-prefixed hex address of an RLP-decoded to field,
+// or "" for a contract creation.
+func optionalAddress(to []byte) string {
+	if len(to) == 0 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(to)
+}
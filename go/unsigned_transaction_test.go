@@ -0,0 +1,122 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Transactions encoded as go-ethereum's Transaction.MarshalBinary encodes
+// unsigned transactions, with zero signature fields.
+func gethLegacyTx(v uint64) []byte {
+	to, _ := hex.DecodeString(testRecipient[2:])
+	return rlpList(
+		rlpUint(7), rlpBigInt(big.NewInt(1e9)), rlpUint(21000), rlpBytes(to), rlpBigInt(big.NewInt(1000)), rlpBytes(nil),
+		rlpUint(v), rlpUint(0), rlpUint(0),
+	)
+}
+
+func gethDynamicFeeTx(chainID uint64) []byte {
+	to, _ := hex.DecodeString(testRecipient[2:])
+	return append([]byte{eip1559TxType}, rlpList(
+		rlpUint(chainID), rlpUint(7), rlpBigInt(big.NewInt(1)), rlpBigInt(big.NewInt(2e9)), rlpUint(21000),
+		rlpBytes(to), rlpBigInt(big.NewInt(1000)), rlpBytes([]byte{0xab}), rlpList(),
+		rlpUint(0), rlpUint(0), rlpUint(0),
+	)...)
+}
+
+func TestSendUnsignedTransaction(t *testing.T) {
+	sent := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Network, Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Network != "base-sepolia" {
+			t.Errorf("unexpected network %s", body.Network)
+		}
+		sent <- body.Transaction
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, testTxHash)
+	}))
+	defer server.Close()
+	account := (&EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}).UseNetwork("base-sepolia")
+
+	legacy, _ := SerializeTransaction("base-sepolia", TransactionRequest{
+		Nonce: 7, MaxPriorityFeePerGas: big.NewInt(1e9), MaxFeePerGas: big.NewInt(1e9), Gas: 21000, To: testRecipient, Value: big.NewInt(1000),
+	})
+	tests := []struct {
+		name string
+		tx   []byte
+		want string
+	}{
+		{"dynamic fee", gethDynamicFeeTx(84532), "0x" + hex.EncodeToString(gethDynamicFeeTx(84532))},
+		{"legacy", gethLegacyTx(0), legacy},
+		{"legacy with EIP-155 chain ID", gethLegacyTx(84532), legacy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txHash, err := account.SendUnsignedTransaction(context.Background(), tt.tx)
+			if err != nil || txHash != testTxHash {
+				t.Fatalf("SendUnsignedTransaction = %q, %v", txHash, err)
+			}
+			if got := <-sent; got != tt.want {
+				t.Errorf("sent transaction %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	signed := gethDynamicFeeTx(84532)
+	signed[len(signed)-1] = 0x01
+	invalid := []struct {
+		name    string
+		tx      []byte
+		wantErr error
+	}{
+		{"wrong chain", gethDynamicFeeTx(1), ErrChainIDMismatch},
+		{"wrong legacy chain", gethLegacyTx(1), ErrChainIDMismatch},
+		{"signed", signed, nil},
+		{"access list transaction", append([]byte{0x01}, rlpList()...), nil},
+		{"empty", nil, nil},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := account.SendUnsignedTransaction(context.Background(), tt.tx)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSendUnsignedTransactionKeepsNonceZero(t *testing.T) {
+	sent := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Transaction string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		sent <- body.Transaction
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transactionHash":%q}`, testTxHash)
+	}))
+	defer server.Close()
+	client := newTestClientWithOptions(t, server.URL, ClientOptions{ManageNonces: true})
+	account := (&EvmAccount{client: client, Address: testOwner}).UseNetwork("base-sepolia")
+
+	to, _ := hex.DecodeString(testRecipient[2:])
+	unsigned := rlpList(rlpUint(0), rlpBigInt(big.NewInt(1e9)), rlpUint(21000), rlpBytes(to), rlpBigInt(big.NewInt(1000)), rlpBytes(nil))
+	want, _ := SerializeTransaction("base-sepolia", TransactionRequest{
+		MaxPriorityFeePerGas: big.NewInt(1e9), MaxFeePerGas: big.NewInt(1e9), Gas: 21000, To: testRecipient, Value: big.NewInt(1000),
+	})
+
+	if _, err := account.SendUnsignedTransaction(context.Background(), unsigned); err != nil {
+		t.Fatalf("SendUnsignedTransaction failed: %v", err)
+	}
+	if got := <-sent; got != want {
+		t.Errorf("sent transaction %s, want %s with nonce 0", got, want)
+	}
+}
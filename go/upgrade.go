@@ -0,0 +1,160 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// transferGas is the gas used by a native token transfer to an EOA. Transfers to a
+// smart account that is not deployed yet cost the same.
+const transferGas = 21000
+
+// UpgradeOptions configures UpgradeToSmartAccount.
+type UpgradeOptions struct {
+	// Name is the smart account's name. A smart account with this name owned by
+	// the EOA is reused, so an interrupted upgrade can be resumed by calling
+	// UpgradeToSmartAccount again. If empty, a new unnamed smart account is
+	// created on every call.
+	Name string
+	// Network is the network to sweep balances on. Defaults to
+	// ClientOptions.DefaultNetwork.
+	Network string
+	// SweepTokens are the tokens to move from the EOA to the smart account, as
+	// accepted by NetworkScopedEvmAccount.Transfer (e.g. "eth", "usdc" or an
+	// ERC-20 contract address). If empty, no balances are swept.
+	SweepTokens []string
+	// GasReserve is the amount of the native token, in wei, left in the EOA to pay
+	// for the sweep transfers. Defaults to the most the native token transfer can
+	// cost: its padded gas estimate at twice the current base fee plus the
+	// priority fee, plus twice its L1 data fee on OP Stack networks such as Base.
+	// The native token transfer is sent with that gas limit and those fees.
+	GasReserve *big.Int
+}
+
+// UpgradeResult is the outcome of UpgradeToSmartAccount.
+type UpgradeResult struct {
+	// SmartAccount is the smart account owned by the EOA.
+	SmartAccount *SmartAccount
+	// Transfers maps each swept token to the hash of its transfer. Tokens with
+	// nothing to sweep are omitted.
+	Transfers map[string]string
+}
+
+// UpgradeToSmartAccount migrates the EOA eoa to a smart account that it owns, in
+// these steps:
+//
+//  1. The smart account is created, or reused if opts.Name names one owned by
+//     eoa. If this fails, nothing else is done and the result is nil.
+//  2. Each ERC-20 token in opts.SweepTokens is transferred in full to the smart
+//     account, waiting for each transfer to be confirmed. Tokens with a zero
+//     balance are skipped.
+//  3. If opts.SweepTokens includes the native token, the native balance minus
+//     opts.GasReserve is transferred last, so the reserve pays for the gas of
+//     the token transfers. It is skipped if the balance does not exceed the
+//     reserve.
+//
+// If a sweep fails, the result holds the smart account and the transfers made so
+// far, along with the error; tokens after the failed one are not swept. Reading a
+// balance can race with other transfers from the EOA, which then fail for lack
+// of funds rather than sweeping less.
+func (c *Client) UpgradeToSmartAccount(ctx context.Context, eoa *EvmAccount, opts UpgradeOptions) (*UpgradeResult, error) {
+	if err := c.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	var smartAccount *SmartAccount
+	var err error
+	if opts.Name == "" {
+		smartAccount, err = c.createSmartAccount(ctx, eoa.Address, "")
+	} else {
+		smartAccount, err = c.GetOrCreateSmartAccount(ctx, eoa.Address, CreateOptions{Name: opts.Name})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UpgradeResult{SmartAccount: smartAccount, Transfers: map[string]string{}}
+	if len(opts.SweepTokens) == 0 {
+		return result, nil
+	}
+
+	account := eoa.UseNetwork(opts.Network)
+	native := ""
+	for _, token := range opts.SweepTokens {
+		if strings.EqualFold(token, nativeSymbol(account.Network)) {
+			native = token
+			continue
+		}
+
+		balance, err := c.balanceOf(ctx, account.Network, eoa.Address, token)
+		if err != nil {
+			return result, fmt.Errorf("failed to sweep %s: %w", token, err)
+		}
+		if balance.Sign() == 0 {
+			continue
+		}
+		receipt, err := account.TransferAndWait(ctx, smartAccount.Address, balance, token, TransferOptions{})
+		if receipt != nil {
+			result.Transfers[token] = receipt.TransactionHash
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to sweep %s: %w", token, err)
+		}
+	}
+
+	if native == "" {
+		return result, nil
+	}
+	txHash, err := c.sweepNative(ctx, account, smartAccount.Address, opts.GasReserve)
+	if txHash != "" {
+		result.Transfers[native] = txHash
+	}
+	if err != nil {
+		return result, fmt.Errorf("failed to sweep %s: %w", native, err)
+	}
+	return result, nil
+}
+
+// sweepNative transfers the native balance of account minus reserve to to, and
+// returns the transaction hash, or "" if there is nothing to sweep. The transfer
+// is sent with the gas limit and fees a nil reserve is computed from, so that it
+// cannot cost more than that reserve.
+func (c *Client) sweepNative(ctx context.Context, account *NetworkScopedEvmAccount, to string, reserve *big.Int) (string, error) {
+	native := nativeSymbol(account.Network)
+	balance, err := c.balanceOf(ctx, account.Network, account.Address, native)
+	if err != nil {
+		return "", err
+	}
+	if balance.Sign() == 0 {
+		return "", nil
+	}
+
+	// The recipient may be a deployed smart account, whose receive function costs
+	// more than a plain transfer, so the gas is estimated rather than assumed.
+	tx, err := transferTransaction(account.Network, to, new(big.Int), native)
+	if err != nil {
+		return "", err
+	}
+	gas, err := c.estimateGas(ctx, account.Network, account.Address, tx)
+	if err != nil {
+		return "", err
+	}
+	tx.Gas = padGasLimit(gas, c.options.GasLimitMultiplier).Uint64()
+	if tx.MaxFeePerGas, tx.MaxPriorityFeePerGas, err = c.estimateGasFees(ctx, account.Network); err != nil {
+		return "", err
+	}
+	tx.Value = balance
+	if reserve == nil {
+		if reserve, err = c.estimateFee(ctx, account.Network, tx); err != nil {
+			return "", err
+		}
+	}
+
+	if balance.Cmp(reserve) <= 0 {
+		return "", nil
+	}
+	tx.Value = new(big.Int).Sub(balance, reserve)
+	return account.SendTransaction(ctx, account.Network, tx)
+}
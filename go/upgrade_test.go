@@ -0,0 +1,165 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newUpgradeServers serves the API and JSON-RPC requests of UpgradeToSmartAccount
+// for an EOA holding nativeBalance wei and tokenBalance of every ERC-20 token, at
+// a base fee of 1 gwei, no priority fee and an L1 data fee of testL1Fee, with
+// native transfers using 21000 gas. It returns a client using them and the
+// serialized transactions sent.
+func newUpgradeServers(t *testing.T, nativeBalance, tokenBalance int64) (*Client, *[]string) {
+	t.Helper()
+	setFastTimers(t)
+
+	var mu sync.Mutex
+	var sent []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errorType":"not_found","errorMessage":"not found"}`)
+		case r.URL.Path == "/v2/evm/smart-accounts":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"address":%q,"owners":[%q],"name":"upgraded"}`, testRecipient, testOwner)
+		default:
+			var body struct{ Transaction string }
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			sent = append(sent, body.Transaction)
+			n := len(sent)
+			mu.Unlock()
+			fmt.Fprintf(w, `{"transactionHash":"0x%064x"}`, n)
+		}
+	}))
+	t.Cleanup(api.Close)
+
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch req.Method {
+		case "eth_getBalance":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, nativeBalance)
+		case "eth_call":
+			if isL1FeeCall(req.Params[0]) {
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%064x"}`, testL1Fee)
+				return
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%064x"}`, tokenBalance)
+		case "eth_estimateGas":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x5208"}`)
+		case "eth_getBlockByNumber":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"baseFeePerGas":"0x3b9aca00"}}`)
+		case "eth_maxPriorityFeePerGas":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x0"}`)
+		case "eth_getTransactionReceipt":
+			var hash string
+			_ = json.Unmarshal(req.Params[0], &hash)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":%q,"blockHash":"0xb10c","blockNumber":"0x1","status":"0x1","from":%q,"to":%q,"gasUsed":"0x5208","effectiveGasPrice":"0x1","logs":[]}}`, hash, testOwner, testRecipient)
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+	}))
+	t.Cleanup(rpc.Close)
+
+	return newReceiptTestClient(t, api.URL, rpc.URL), &sent
+}
+
+// sweepReserve is the gas reserve of a native sweep on base-sepolia with
+// newUpgradeServers: the padded 21000 gas at twice the base fee, plus twice the
+// L1 data fee.
+const sweepReserve = 25200*2e9 + 2*testL1Fee
+
+func TestUpgradeToSmartAccount(t *testing.T) {
+	tests := []struct {
+		name                        string
+		nativeBalance, tokenBalance int64
+		wantTransfers               []string
+	}{
+		{"sweeps balances", 1e18, 1000, []string{"usdc", "eth"}},
+		{"no funds", 0, 0, nil},
+		{"native balance below gas reserve", sweepReserve, 1000, []string{"usdc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, sent := newUpgradeServers(t, tt.nativeBalance, tt.tokenBalance)
+			eoa := &EvmAccount{client: client, Address: testOwner}
+
+			result, err := client.UpgradeToSmartAccount(context.Background(), eoa, UpgradeOptions{
+				Name:        "upgraded",
+				Network:     "base-sepolia",
+				SweepTokens: []string{"eth", "usdc"},
+			})
+			if err != nil {
+				t.Fatalf("UpgradeToSmartAccount returned an error: %v", err)
+			}
+			if result.SmartAccount.Address != testRecipient || result.SmartAccount.Owner != testOwner {
+				t.Errorf("unexpected smart account %+v", result.SmartAccount)
+			}
+			if len(result.Transfers) != len(tt.wantTransfers) || len(*sent) != len(tt.wantTransfers) {
+				t.Fatalf("got transfers %v after %d sends, want %v", result.Transfers, len(*sent), tt.wantTransfers)
+			}
+			for _, token := range tt.wantTransfers {
+				if !strings.HasPrefix(result.Transfers[token], "0x") {
+					t.Errorf("missing transfer of %s in %v", token, result.Transfers)
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradeToSmartAccountWithoutSweep(t *testing.T) {
+	client, sent := newUpgradeServers(t, 1e18, 1000)
+	eoa := &EvmAccount{client: client, Address: testOwner}
+
+	result, err := client.UpgradeToSmartAccount(context.Background(), eoa, UpgradeOptions{})
+	if err != nil {
+		t.Fatalf("UpgradeToSmartAccount returned an error: %v", err)
+	}
+	if result.SmartAccount == nil || len(result.Transfers) != 0 || len(*sent) != 0 {
+		t.Errorf("expected only a smart account, got %+v after %d sends", result, len(*sent))
+	}
+}
+
+func TestUpgradeToSmartAccountSweepsNativeWithinReserve(t *testing.T) {
+	client, sent := newUpgradeServers(t, 1e18, 0)
+	eoa := &EvmAccount{client: client, Address: testOwner}
+
+	if _, err := client.UpgradeToSmartAccount(context.Background(), eoa, UpgradeOptions{
+		Name:        "upgraded",
+		Network:     "base-sepolia",
+		SweepTokens: []string{"eth"},
+	}); err != nil {
+		t.Fatalf("UpgradeToSmartAccount returned an error: %v", err)
+	}
+
+	// The transfer is sent with the gas limit and fees the reserve is made of, so
+	// it cannot cost more than the reserve.
+	want, err := SerializeTransaction("base-sepolia", TransactionRequest{
+		To:                   testRecipient,
+		Value:                big.NewInt(1e18 - sweepReserve),
+		Gas:                  25200,
+		MaxFeePerGas:         big.NewInt(2e9),
+		MaxPriorityFeePerGas: new(big.Int),
+	})
+	if err != nil {
+		t.Fatalf("failed to serialize the expected transaction: %v", err)
+	}
+	if len(*sent) != 1 || !strings.EqualFold((*sent)[0], want) {
+		t.Errorf("sent %v, want [%s]", *sent, want)
+	}
+}
@@ -0,0 +1,172 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// ErrUserOperationFailed is returned when a user operation ends in the failed or
+// dropped state.
+var ErrUserOperationFailed = errors.New("user operation failed")
+
+// UserOperationFailedError is returned when a user operation ends in the failed
+// or dropped state. It matches ErrUserOperationFailed with errors.Is.
+type UserOperationFailedError struct {
+	// Operation is the failed user operation. Its Status is UserOperationFailed or
+	// UserOperationDropped, and its RevertReason is set if it reverted.
+	Operation *UserOperation
+}
+
+// Error implements the error interface.
+func (e *UserOperationFailedError) Error() string {
+	msg := fmt.Sprintf("user operation %s is %s", e.Operation.UserOpHash, e.Operation.Status)
+	if e.Operation.RevertReason != "" {
+		msg += ": " + e.Operation.RevertReason
+	}
+	return msg
+}
+
+// Is reports whether target is ErrUserOperationFailed.
+func (e *UserOperationFailedError) Is(target error) bool {
+	return target == ErrUserOperationFailed
+}
+
+// UserOperationOptions configures how a user operation is sent.
+type UserOperationOptions struct {
+	// PaymasterURL is the URL of the paymaster used to sponsor the user operation.
+	// If empty, the smart account's default paymaster is used (see
+	// SmartAccount.WithPaymaster); if it has none, the smart account pays for gas
+	// (or CDP sponsors it on Base Sepolia).
+	PaymasterURL string
+	// NoPaymaster disables the smart account's default paymaster for the
+	// operation. It is ignored if PaymasterURL is set.
+	NoPaymaster bool
+}
+
+// SendUserOperation prepares, signs and sends a user operation making calls from the
+// smart account on network, and returns the submitted operation. The operation's
+// UserOpHash is always set; its TransactionHash is set if the response already
+// reports the including transaction (see UserOperation.TransactionHash). The smart
+// account's owner must be a CDP-managed account. Call values may be decimal or
+// hex; they are sent in the encoding configured for network (see
+// ClientOptions.AmountEncodings). Calls without an OverrideGasLimit get a padded
+// estimate, as described for ClientOptions.DisableGasLimitEstimation. It fails
+// with ErrRecipientNotAllowed if the recipient of any call is not in
+// ClientOptions.RecipientAllowlist.
+func (s *SmartAccount) SendUserOperation(ctx context.Context, calls []openapi.EvmCall, network string, opts UserOperationOptions) (*UserOperation, error) {
+	if err := s.client.checkWritable(); err != nil {
+		return nil, err
+	}
+	network = s.client.networkOrDefault(network)
+	if err := s.client.checkCallRecipients(calls); err != nil {
+		return nil, err
+	}
+	calls, err := s.client.encodeCallValues(network, calls)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calls: %w", err)
+	}
+	calls = s.withCallGasLimits(ctx, network, calls)
+
+	body := openapi.PrepareAndSendUserOperationJSONRequestBody{
+		Calls:   calls,
+		Network: openapi.EvmUserOperationNetwork(network),
+	}
+	if paymasterURL := s.paymasterFor(opts); paymasterURL != "" {
+		body.PaymasterUrl = &paymasterURL
+	}
+
+	resp, err := s.client.PrepareAndSendUserOperationWithResponse(ctx, s.Address, nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send user operation: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, unexpectedStatusError("send user operation", resp.StatusCode(), resp.Body)
+	}
+	if resp.JSON200.UserOpHash == "" {
+		return nil, errors.New("failed to send user operation: response has no user operation hash")
+	}
+	op, err := newUserOperation(resp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+	s.client.userOps.add(network, s.Address, op.UserOpHash)
+	return op, nil
+}
+
+// WithPaymaster returns a copy of the smart account handle whose user operations
+// are sponsored by the paymaster at paymasterURL unless the call's
+// UserOperationOptions set another paymaster or NoPaymaster. An empty
+// paymasterURL clears the default.
+func (s *SmartAccount) WithPaymaster(paymasterURL string) *SmartAccount {
+	account := *s
+	account.paymasterURL = paymasterURL
+	return &account
+}
+
+// paymasterFor returns the URL of the paymaster that sponsors a user operation
+// sent with opts, or "" if the smart account pays for gas.
+func (s *SmartAccount) paymasterFor(opts UserOperationOptions) string {
+	switch {
+	case opts.PaymasterURL != "":
+		return opts.PaymasterURL
+	case opts.NoPaymaster:
+		return ""
+	default:
+		return s.paymasterURL
+	}
+}
+
+// WaitForUserOperation polls the user operation with the given hash until it
+// completes, and returns it. If the operation fails or is dropped, it returns the
+// operation along with a *UserOperationFailedError describing why. It returns
+// ctx.Err() if ctx is done first. It polls as decided by
+// ClientOptions.UserOperationPollStrategy.
+func (s *SmartAccount) WaitForUserOperation(ctx context.Context, userOpHash string) (*UserOperation, error) {
+	strategy := s.client.userOperationPollStrategy()
+	for attempt := 1; ; attempt++ {
+		op, err := s.GetUserOperation(ctx, userOpHash)
+		if err != nil {
+			return nil, err
+		}
+		switch op.Status {
+		case UserOperationComplete:
+			s.client.userOps.forget(s.Address, userOpHash)
+			return op, nil
+		case UserOperationFailed, UserOperationDropped:
+			s.client.userOps.forget(s.Address, userOpHash)
+			return op, &UserOperationFailedError{Operation: op}
+		}
+
+		timer := time.NewTimer(strategy.Next(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// GetUserOperation returns the user operation with the given hash sent by the
+// smart account, with its current status.
+func (s *SmartAccount) GetUserOperation(ctx context.Context, userOpHash string) (*UserOperation, error) {
+	return s.client.getUserOperation(ctx, s.Address, userOpHash)
+}
+
+// getUserOperation returns the user operation with the given hash sent by the
+// smart account at address.
+func (c *Client) getUserOperation(ctx context.Context, address, userOpHash string) (*UserOperation, error) {
+	resp, err := c.GetUserOperationWithResponse(ctx, address, userOpHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user operation: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, unexpectedStatusError("get user operation", resp.StatusCode(), resp.Body)
+	}
+	return newUserOperation(resp.JSON200)
+}
@@ -0,0 +1,72 @@
+package cdp
+
+import (
+	"context"
+	"sync"
+)
+
+// maxUserOperationStatusConcurrency bounds the number of user operations
+// GetUserOperationStatuses fetches at once.
+const maxUserOperationStatusConcurrency = 8
+
+// UserOperationStatusResult is the status of one user operation fetched by
+// GetUserOperationStatuses.
+type UserOperationStatusResult struct {
+	// Status is the status of the user operation.
+	Status UserOperationStatus
+	// Err is the error that occurred while fetching the user operation, if any.
+	// Status is empty when Err is set.
+	Err error
+}
+
+// GetUserOperationStatuses fetches the status of each of the user operations
+// with the given hashes, sent by the smart account at smartAccount, for
+// reconciling many in-flight operations at once.
+//
+// The API has no bulk endpoint, so each distinct hash is fetched with its own
+// request, at most 8 at a time; repeated hashes are fetched once. The result has
+// an entry for every distinct hash; a hash that could not be fetched has its
+// error set rather than failing the whole call. Canceling ctx stops fetches that
+// have not started.
+func (c *Client) GetUserOperationStatuses(ctx context.Context, smartAccount string, hashes []string) map[string]UserOperationStatusResult {
+	results := make(map[string]UserOperationStatusResult, len(hashes))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxUserOperationStatusConcurrency)
+	seen := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			results[hash] = UserOperationStatusResult{Err: ctx.Err()}
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var result UserOperationStatusResult
+			if op, err := c.getUserOperation(ctx, smartAccount, hash); err != nil {
+				result.Err = err
+			} else {
+				result.Status = op.Status
+			}
+			mu.Lock()
+			results[hash] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,61 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestGetUserOperationStatuses(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hash := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		mu.Lock()
+		requests[hash]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if hash == "0xmissing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errorType":"not_found","errorMessage":"user operation not found"}`)
+			return
+		}
+		status := strings.TrimPrefix(hash, "0x")
+		fmt.Fprintf(w, `{"network":"base-sepolia","calls":[],"status":%q,"userOpHash":%q}`, status, hash)
+	}))
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	results := client.GetUserOperationStatuses(context.Background(), testOwner, []string{"0xcomplete", "0xbroadcast", "0xcomplete", "0xmissing"})
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %v", results)
+	}
+	if results["0xcomplete"].Status != UserOperationComplete || results["0xbroadcast"].Status != UserOperationBroadcast {
+		t.Errorf("unexpected statuses %v", results)
+	}
+	if results["0xmissing"].Err == nil || results["0xmissing"].Status != "" {
+		t.Errorf("expected an error for a missing user operation, got %+v", results["0xmissing"])
+	}
+	if requests["0xcomplete"] != 1 {
+		t.Errorf("duplicate hash fetched %d times, want 1", requests["0xcomplete"])
+	}
+}
+
+func TestGetUserOperationStatusesCanceled(t *testing.T) {
+	client := newTestClient(t, "http://127.0.0.1:1")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := client.GetUserOperationStatuses(ctx, testOwner, []string{"0x1", "0x2"})
+	for _, hash := range []string{"0x1", "0x2"} {
+		if results[hash].Err == nil {
+			t.Errorf("expected an error for %s", hash)
+		}
+	}
+}
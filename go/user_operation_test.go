@@ -0,0 +1,152 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func TestWaitForUserOperation(t *testing.T) {
+	strategy := defaultUserOperationPollStrategy
+	defaultUserOperationPollStrategy = FixedPollStrategy{Interval: time.Millisecond}
+	t.Cleanup(func() { defaultUserOperationPollStrategy = strategy })
+
+	tests := []struct {
+		name     string
+		final    string
+		wantErr  error
+		wantPoll int32
+	}{
+		{"complete", "complete", nil, 3},
+		{"failed", "failed", ErrUserOperationFailed, 3},
+		{"dropped", "dropped", ErrUserOperationFailed, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				status := "broadcast"
+				if polls.Add(1) >= 3 {
+					status = tt.final
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"network":"base-sepolia","calls":[],"status":%q,"userOpHash":"0xop"}`, status)
+			}))
+			defer server.Close()
+
+			account := &SmartAccount{client: newTestClient(t, server.URL), Address: testOwner}
+			op, err := account.WaitForUserOperation(context.Background(), "0xop")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if string(op.Status) != tt.final {
+				t.Errorf("Status = %s, want %s", op.Status, tt.final)
+			}
+			if n := polls.Load(); n != tt.wantPoll {
+				t.Errorf("polled %d times, want %d", n, tt.wantPoll)
+			}
+		})
+	}
+}
+
+func TestSmartAccountWithPaymaster(t *testing.T) {
+	var paymasters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body openapi.PrepareAndSendUserOperationJSONRequestBody
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		paymaster := ""
+		if body.PaymasterUrl != nil {
+			paymaster = *body.PaymasterUrl
+		}
+		paymasters = append(paymasters, paymaster)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(openapi.EvmUserOperation{
+			Calls:      body.Calls,
+			Network:    body.Network,
+			Status:     openapi.EvmUserOperationStatusBroadcast,
+			UserOpHash: "0xop",
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	plain := NewSmartAccount(client, testOwner, testOtherOwner)
+	sponsored := plain.WithPaymaster("https://paymaster.example/default")
+	scoped := plain.OnNetwork("base-sepolia").WithPaymaster("https://paymaster.example/scoped")
+	calls := []openapi.EvmCall{{To: testRecipient, Value: "0", Data: "0x"}}
+	ctx := context.Background()
+
+	sends := []func() (*UserOperation, error){
+		func() (*UserOperation, error) {
+			return plain.SendUserOperation(ctx, calls, "base-sepolia", UserOperationOptions{})
+		},
+		func() (*UserOperation, error) {
+			return sponsored.SendUserOperation(ctx, calls, "base-sepolia", UserOperationOptions{})
+		},
+		func() (*UserOperation, error) {
+			return sponsored.SendUserOperation(ctx, calls, "base-sepolia", UserOperationOptions{PaymasterURL: "https://paymaster.example/call"})
+		},
+		func() (*UserOperation, error) {
+			return sponsored.SendUserOperation(ctx, calls, "base-sepolia", UserOperationOptions{NoPaymaster: true})
+		},
+		func() (*UserOperation, error) {
+			return scoped.SendUserOperation(ctx, calls, UserOperationOptions{})
+		},
+	}
+	for _, send := range sends {
+		if _, err := send(); err != nil {
+			t.Fatalf("SendUserOperation returned an error: %v", err)
+		}
+	}
+
+	want := []string{
+		"",
+		"https://paymaster.example/default",
+		"https://paymaster.example/call",
+		"",
+		"https://paymaster.example/scoped",
+	}
+	if strings.Join(paymasters, ",") != strings.Join(want, ",") {
+		t.Errorf("paymasters = %q, want %q", paymasters, want)
+	}
+	if plain.paymasterURL != "" {
+		t.Errorf("WithPaymaster modified the original handle: %q", plain.paymasterURL)
+	}
+}
+
+func TestWaitForUserOperationReportsRevertReason(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"network":"base-sepolia","calls":[],"status":"failed","userOpHash":"0xop",
+			"receipts":[{"transactionHash":"0xtx","revert":{"data":"0x08c379a0","message":"insufficient balance"}}]}`)
+	}))
+	defer server.Close()
+
+	account := &SmartAccount{client: newTestClient(t, server.URL), Address: testOwner}
+	_, err := account.WaitForUserOperation(context.Background(), "0xop")
+	var failed *UserOperationFailedError
+	if !errors.As(err, &failed) || !errors.Is(err, ErrUserOperationFailed) {
+		t.Fatalf("expected a UserOperationFailedError, got %v", err)
+	}
+	if failed.Operation.RevertReason != "insufficient balance" || failed.Operation.TransactionHash != "0xtx" {
+		t.Errorf("unexpected failed operation %+v", failed.Operation)
+	}
+	if want := "user operation 0xop is failed: insufficient balance"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	op, err := account.GetUserOperation(context.Background(), "0xop")
+	if err != nil || op.Status != UserOperationFailed {
+		t.Errorf("GetUserOperation = %+v, %v", op, err)
+	}
+}
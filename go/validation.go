@@ -0,0 +1,327 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// FieldError describes a problem with a single field of a request body.
+type FieldError struct {
+	// Field is the dotted path to the offending field (e.g. "calls[0].to").
+	Field string
+	// Message describes what is wrong with the field.
+	Message string
+}
+
+// RequestValidationError is returned when StrictValidation is enabled and a request
+// body does not conform to the operation's OpenAPI schema. No request is sent.
+type RequestValidationError struct {
+	// OperationID is the name of the operation whose body failed validation.
+	OperationID string
+	// FieldErrors lists every schema violation found in the request body.
+	FieldErrors []FieldError
+}
+
+// Error implements the error interface.
+func (e *RequestValidationError) Error() string {
+	problems := make([]string, len(e.FieldErrors))
+	for i, fe := range e.FieldErrors {
+		problems[i] = fmt.Sprintf("%s: %s", fe.Field, fe.Message)
+	}
+	return fmt.Sprintf("invalid request body for %s: %s", e.OperationID, strings.Join(problems, "; "))
+}
+
+// ErrTokenMismatch is returned when StrictValidation is enabled and the API key JWT
+// generated for a request does not match the request it authenticates, such as
+// when its uris claim names a different host or path than the request is sent to.
+// No request is sent.
+var ErrTokenMismatch = errors.New("JWT does not match request")
+
+// tokenSelfCheckFn checks that the API key JWT set on a request by apiKeyHeaderFn
+// was issued for the request's actual target, catching a stale host override or a
+// path rewritten after signing before the server rejects the token. If
+// ClientOptions.SigningHost is set, the target's host is the signing host, since
+// the request is deliberately sent elsewhere.
+func tokenSelfCheckFn(options ClientOptions) openapi.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			return nil
+		}
+		claims, err := auth.DecodeClaims(token)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrTokenMismatch, err)
+		}
+
+		method := strings.ToUpper(req.Method)
+		if method == "" {
+			method = "GET"
+		}
+		host := options.SigningHost
+		if host == "" {
+			host = req.Host
+		}
+		if host == "" {
+			host = req.URL.Host
+		}
+		target := fmt.Sprintf("%s %s%s", method, host, req.URL.Path)
+
+		uris, _ := claims["uris"].([]interface{})
+		if len(uris) != 1 || uris[0] != target {
+			return fmt.Errorf("%w: uris claim %v does not match request target %q", ErrTokenMismatch, claims["uris"], target)
+		}
+		if !audienceMatches(claims["aud"], options.Audience) {
+			return fmt.Errorf("%w: aud claim %v does not match the configured audience %q", ErrTokenMismatch, claims["aud"], options.Audience)
+		}
+		return nil
+	}
+}
+
+// audienceMatches reports whether the decoded aud claim of a JWT lists exactly the
+// audiences in want. A missing claim matches an empty want.
+func audienceMatches(claim interface{}, want []string) bool {
+	if claim == nil {
+		return len(want) == 0
+	}
+	got, ok := claim.([]interface{})
+	if !ok || len(got) != len(want) {
+		return false
+	}
+	for i, aud := range got {
+		if aud != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// strictValidationFn validates outgoing JSON request bodies against the operation's
+// OpenAPI schema, failing the request before it is sent if the body is invalid.
+func strictValidationFn() openapi.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		if req.Body == nil || req.Body == http.NoBody {
+			return nil
+		}
+
+		operation, ok := openapi.LookupOperation(req.Method, req.URL.Path)
+		if !ok || operation.RequestBody == nil {
+			return nil
+		}
+
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		// Restore the body for future readers
+		req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+
+		if len(bodyBytes) == 0 {
+			return nil
+		}
+
+		var body interface{}
+		decoder := json.NewDecoder(bytes.NewReader(bodyBytes))
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil {
+			return fmt.Errorf("failed to parse request body: %w", err)
+		}
+
+		if fieldErrors := validateSchema(body, operation.RequestBody, ""); len(fieldErrors) > 0 {
+			return &RequestValidationError{OperationID: operation.ID, FieldErrors: fieldErrors}
+		}
+
+		return nil
+	}
+}
+
+// validateSchema returns the violations of schema by value. The path identifies value
+// within the request body and is empty for the body itself.
+func validateSchema(value interface{}, schema *openapi.Schema, path string) []FieldError {
+	if schema == nil {
+		return nil
+	}
+
+	if schema.Ref != "" {
+		return validateSchema(value, openapi.Schemas[schema.Ref], path)
+	}
+
+	if value == nil {
+		if schema.Nullable || schema.Type == "" {
+			return nil
+		}
+		return []FieldError{{Field: fieldName(path), Message: "must not be null"}}
+	}
+
+	var errs []FieldError
+
+	for _, sub := range schema.AllOf {
+		errs = append(errs, validateSchema(value, sub, path)...)
+	}
+	if len(schema.AnyOf) > 0 && !matchesAny(value, schema.AnyOf, path) {
+		errs = append(errs, FieldError{Field: fieldName(path), Message: "does not match any of the allowed schemas"})
+	}
+	if len(schema.OneOf) > 0 && !matchesAny(value, schema.OneOf, path) {
+		errs = append(errs, FieldError{Field: fieldName(path), Message: "does not match any of the allowed schemas"})
+	}
+
+	if msg := checkType(value, schema.Type); msg != "" {
+		return append(errs, FieldError{Field: fieldName(path), Message: msg})
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, ok := v[name]; !ok {
+				errs = append(errs, FieldError{Field: joinPath(path, name), Message: "is required"})
+			}
+		}
+
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if propSchema, ok := schema.Properties[name]; ok {
+				errs = append(errs, validateSchema(v[name], propSchema, joinPath(path, name))...)
+			}
+		}
+
+	case []interface{}:
+		for i, elem := range v {
+			errs = append(errs, validateSchema(elem, schema.Items, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+
+	case string:
+		if len(schema.Enum) > 0 && !contains(schema.Enum, v) {
+			errs = append(errs, FieldError{
+				Field:   fieldName(path),
+				Message: fmt.Sprintf("must be one of [%s], got %q", strings.Join(schema.Enum, ", "), v),
+			})
+		}
+		if schema.Pattern != nil && !schema.Pattern.MatchString(v) {
+			errs = append(errs, FieldError{
+				Field:   fieldName(path),
+				Message: fmt.Sprintf("%q does not match pattern %s", v, schema.Pattern.String()),
+			})
+		}
+		if schema.Format != "" && !matchesFormat(v, schema.Format) {
+			errs = append(errs, FieldError{
+				Field:   fieldName(path),
+				Message: fmt.Sprintf("%q is not a valid %s", v, schema.Format),
+			})
+		}
+		if n := utf8.RuneCountInString(v); schema.MinLength > 0 && n < schema.MinLength {
+			errs = append(errs, FieldError{Field: fieldName(path), Message: fmt.Sprintf("must be at least %d characters long", schema.MinLength)})
+		} else if schema.MaxLength > 0 && n > schema.MaxLength {
+			errs = append(errs, FieldError{Field: fieldName(path), Message: fmt.Sprintf("must be at most %d characters long", schema.MaxLength)})
+		}
+	}
+
+	return errs
+}
+
+// matchesAny reports whether value satisfies at least one of schemas.
+func matchesAny(value interface{}, schemas []*openapi.Schema, path string) bool {
+	for _, s := range schemas {
+		if len(validateSchema(value, s, path)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// uuidPattern matches a UUID in its canonical, hyphenated form.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// matchesFormat reports whether v is a valid string of the given format. Formats
+// the validator does not know are not checked.
+func matchesFormat(v, format string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339Nano, v)
+		return err == nil
+	case "email":
+		addr, err := mail.ParseAddress(v)
+		return err == nil && addr.Address == v
+	case "uri":
+		u, err := url.Parse(v)
+		return err == nil && u.IsAbs()
+	case "uuid":
+		return uuidPattern.MatchString(v)
+	default:
+		return true
+	}
+}
+
+// checkType returns a message describing the mismatch between value and the JSON
+// Schema type, or an empty string if value is of that type.
+func checkType(value interface{}, schemaType string) string {
+	var ok bool
+	switch schemaType {
+	case "":
+		return ""
+	case "object":
+		_, ok = value.(map[string]interface{})
+	case "array":
+		_, ok = value.([]interface{})
+	case "string":
+		_, ok = value.(string)
+	case "boolean":
+		_, ok = value.(bool)
+	case "number":
+		_, ok = value.(json.Number)
+	case "integer":
+		var n json.Number
+		if n, ok = value.(json.Number); ok {
+			_, err := n.Int64()
+			ok = err == nil || !strings.ContainsAny(n.String(), ".eE")
+		}
+	default:
+		return ""
+	}
+
+	if ok {
+		return ""
+	}
+	return "must be of type " + schemaType
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func fieldName(path string) string {
+	if path == "" {
+		return "(body)"
+	}
+	return path
+}
+
+func contains(values []string, v string) bool {
+	for _, candidate := range values {
+		if candidate == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+func validateBody(t *testing.T, method, path, body string) error {
+	t.Helper()
+	req, err := http.NewRequest(method, "https://api.cdp.coinbase.com/platform"+path, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	return strictValidationFn()(context.Background(), req)
+}
+
+func TestStrictValidationMissingRequiredField(t *testing.T) {
+	err := validateBody(t, http.MethodPost, "/v2/evm/faucet",
+		`{"address":"0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8","network":"base-sepolia"}`)
+
+	var validationErr *RequestValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *RequestValidationError, got %v", err)
+	}
+	if validationErr.OperationID != "RequestEvmFaucet" {
+		t.Errorf("OperationID = %q, want %q", validationErr.OperationID, "RequestEvmFaucet")
+	}
+	if len(validationErr.FieldErrors) != 1 || validationErr.FieldErrors[0].Field != "token" {
+		t.Fatalf("expected a single error for field %q, got %+v", "token", validationErr.FieldErrors)
+	}
+	if validationErr.FieldErrors[0].Message != "is required" {
+		t.Errorf("unexpected message %q", validationErr.FieldErrors[0].Message)
+	}
+}
+
+func TestStrictValidationBadEnum(t *testing.T) {
+	err := validateBody(t, http.MethodPost, "/v2/evm/faucet",
+		`{"address":"0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8","network":"base-mainnet","token":"eth"}`)
+
+	var validationErr *RequestValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *RequestValidationError, got %v", err)
+	}
+	if len(validationErr.FieldErrors) != 1 || validationErr.FieldErrors[0].Field != "network" {
+		t.Fatalf("expected a single error for field %q, got %+v", "network", validationErr.FieldErrors)
+	}
+	if !strings.Contains(validationErr.FieldErrors[0].Message, `got "base-mainnet"`) {
+		t.Errorf("unexpected message %q", validationErr.FieldErrors[0].Message)
+	}
+}
+
+func TestStrictValidationNestedPattern(t *testing.T) {
+	err := validateBody(t, http.MethodPost, "/v2/evm/smart-accounts/0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8/user-operations/prepare-and-send",
+		`{"network":"base-sepolia","calls":[{"to":"not-an-address","value":"0","data":"0x"}]}`)
+
+	var validationErr *RequestValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *RequestValidationError, got %v", err)
+	}
+	if validationErr.FieldErrors[0].Field != "calls[0].to" {
+		t.Errorf("Field = %q, want %q", validationErr.FieldErrors[0].Field, "calls[0].to")
+	}
+}
+
+func TestStrictValidationFormat(t *testing.T) {
+	body := func(agreementAcceptedAt string) string {
+		return `{"paymentCurrency":"USD","purchaseCurrency":"USDC","paymentMethod":"GUEST_CHECKOUT_APPLE_PAY",
+			"destinationAddress":"0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8","destinationNetwork":"base",
+			"phoneNumber":"+12055550100","email":"user@example.com","phoneNumberVerifiedAt":"2025-01-02T15:04:05Z",
+			"partnerUserRef":"user-1","agreementAcceptedAt":"` + agreementAcceptedAt + `"}`
+	}
+
+	if err := validateBody(t, http.MethodPost, "/v2/onramp/orders", body("2025-01-02T15:04:05.123+01:00")); err != nil {
+		t.Fatalf("expected a valid date-time to pass, got %v", err)
+	}
+
+	err := validateBody(t, http.MethodPost, "/v2/onramp/orders", body("2025-01-02"))
+	var validationErr *RequestValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *RequestValidationError, got %v", err)
+	}
+	if len(validationErr.FieldErrors) != 1 || validationErr.FieldErrors[0].Field != "agreementAcceptedAt" {
+		t.Fatalf("expected a single error for field %q, got %+v", "agreementAcceptedAt", validationErr.FieldErrors)
+	}
+	if want := `"2025-01-02" is not a valid date-time`; validationErr.FieldErrors[0].Message != want {
+		t.Errorf("Message = %q, want %q", validationErr.FieldErrors[0].Message, want)
+	}
+}
+
+func TestMatchesFormat(t *testing.T) {
+	tests := []struct {
+		format, value string
+		want          bool
+	}{
+		{"date-time", "2025-01-02T15:04:05Z", true},
+		{"date-time", "2025-01-02 15:04:05", false},
+		{"email", "user@example.com", true},
+		{"email", "User <user@example.com>", false},
+		{"email", "user", false},
+		{"uri", "https://example.com/callback", true},
+		{"uri", "/callback", false},
+		{"uuid", "123e4567-e89b-12d3-a456-426614174000", true},
+		{"uuid", "123e4567e89b12d3a456426614174000", false},
+		{"unknown", "anything", true},
+	}
+	for _, tt := range tests {
+		if got := matchesFormat(tt.value, tt.format); got != tt.want {
+			t.Errorf("matchesFormat(%q, %q) = %v, want %v", tt.value, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestStrictValidationValidBody(t *testing.T) {
+	err := validateBody(t, http.MethodPost, "/v2/evm/faucet",
+		`{"address":"0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8","network":"base-sepolia","token":"eth"}`)
+	if err != nil {
+		t.Fatalf("expected a valid body to pass, got %v", err)
+	}
+}
+
+func TestStrictValidationPreventsRequest(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientOptions{
+		APIKeyID:         testAPIKeyID,
+		APIKeySecret:     generateTestECKeyForCdpTest(t),
+		BasePath:         server.URL,
+		StrictValidation: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	_, err = client.RequestEvmFaucetWithResponse(context.Background(), openapi.RequestEvmFaucetJSONRequestBody{
+		Address: "0x450B2dC4Ba2a08E58C7ECc3DE48e3C825262caF8",
+		Network: "base-mainnet",
+		Token:   "eth",
+	})
+
+	var validationErr *RequestValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *RequestValidationError, got %v", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("expected no requests to reach the server, got %d", n)
+	}
+}
+
+func TestStrictValidationTokenSelfCheck(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	newClient := func(interceptors ...RequestInterceptor) *Client {
+		client, err := NewClient(ClientOptions{
+			APIKeyID:            testAPIKeyID,
+			APIKeySecret:        generateTestECKeyForCdpTest(t),
+			BasePath:            server.URL,
+			HostOverride:        "api.cdp.coinbase.com",
+			StrictValidation:    true,
+			MaxRetries:          -1,
+			RequestInterceptors: interceptors,
+		})
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		t.Cleanup(func() { _ = client.Close() })
+		return client
+	}
+
+	if _, err := newClient().GetEvmAccountWithResponse(context.Background(), testOwner); err != nil {
+		t.Fatalf("expected a matching token to pass the check, got %v", err)
+	}
+
+	// An interceptor that reroutes the request after the host override is applied
+	// leaves the token signed for the overridden host.
+	reroute := func(_ context.Context, req *http.Request) error {
+		req.Host = "api-staging.cdp.coinbase.com"
+		return nil
+	}
+	_, err := newClient(reroute).GetEvmAccountWithResponse(context.Background(), testOwner)
+	if !errors.Is(err, ErrTokenMismatch) {
+		t.Fatalf("expected ErrTokenMismatch, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "api-staging.cdp.coinbase.com") {
+		t.Errorf("error %q does not name the request's host", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected only the matching request to reach the server, got %d", n)
+	}
+}
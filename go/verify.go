@@ -0,0 +1,95 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// isValidSignatureSelector is the selector of EIP-1271's
+// isValidSignature(bytes32,bytes), which is also the value it returns for a valid
+// signature.
+const isValidSignatureSelector = "1626ba7e"
+
+// ErrInvalidSignature is returned when a signature is malformed.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// hashMessage returns the EIP-191 hash of message, as signed by
+// EvmAccount.SignMessage and the personal_sign RPC method.
+func hashMessage(message []byte) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return keccak256([]byte(prefix), message)
+}
+
+// VerifySignature reports whether signature, a 0x-prefixed 65-byte EIP-191
+// signature such as one returned by EvmAccount.SignMessage, was made over message
+// by the account at expectedAddress. It returns an error wrapping
+// ErrInvalidSignature if the signature is malformed.
+//
+// Only signatures by EOAs can be verified locally; smart account signatures are
+// checked by the account contract (see SmartAccount.VerifySignature).
+func VerifySignature(message []byte, signature, expectedAddress string) (bool, error) {
+	signer, err := recoverAddress(hashMessage(message), signature)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(signer, expectedAddress), nil
+}
+
+// recoverAddress recovers the address that produced the 65-byte r||s||v signature
+// over hash.
+func recoverAddress(hash []byte, signature string) (string, error) {
+	sig, err := decodeHexData(signature)
+	if err != nil || len(sig) != 65 {
+		return "", fmt.Errorf("%w: expected 65 hex-encoded bytes", ErrInvalidSignature)
+	}
+
+	// Signatures carry the recovery ID v as 0/1 or, more commonly, 27/28.
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return "", fmt.Errorf("%w: invalid recovery ID %d", ErrInvalidSignature, sig[64])
+	}
+
+	// RecoverCompact expects the recovery code first, followed by r and s.
+	compact := make([]byte, 65)
+	compact[0] = 27 + v
+	copy(compact[1:], sig[:64])
+	pub, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	return "0x" + hex.EncodeToString(keccak256(pub.SerializeUncompressed()[1:])[12:]), nil
+}
+
+// VerifySignature reports whether signature is a valid signature by the smart
+// account over message, by calling the account contract's EIP-1271
+// isValidSignature method on network. The account must be deployed there.
+func (s *SmartAccount) VerifySignature(ctx context.Context, network string, message []byte, signature string) (bool, error) {
+	network = s.client.networkOrDefault(network)
+	sig, err := decodeHexData(signature)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	selector, _ := decodeHexData(isValidSignatureSelector)
+	data := append(selector, hashMessage(message)...)
+	data = append(data, uintWord(big.NewInt(2*abiWordSize))...)
+	data = append(data, bytesTail(sig)...)
+
+	result, err := s.client.ethCall(ctx, network, s.Address, data)
+	if err != nil {
+		return false, fmt.Errorf("failed to call isValidSignature: %w", err)
+	}
+	return len(result) >= 4 && bytes.Equal(result[:4], selector), nil
+}
@@ -0,0 +1,98 @@
+package cdp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// signTestMessage signs message with a new key and returns the signer's address
+// and the r||s||v signature, with v as 27 or 28.
+func signTestMessage(t *testing.T, message []byte) (string, []byte) {
+	t.Helper()
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	compact := ecdsa.SignCompact(key, hashMessage(message), false)
+
+	sig := append(compact[1:], compact[0])
+	address := "0x" + hex.EncodeToString(keccak256(key.PubKey().SerializeUncompressed()[1:])[12:])
+	return address, sig
+}
+
+func TestVerifySignature(t *testing.T) {
+	message := []byte("hello world")
+	address, sig := signTestMessage(t, message)
+
+	ok, err := VerifySignature(message, "0x"+hex.EncodeToString(sig), "0x"+strings.ToUpper(address[2:]))
+	if err != nil || !ok {
+		t.Errorf("got %v, %v; want true", ok, err)
+	}
+
+	// A recovery ID of 0 or 1 is accepted too.
+	zeroBased := append([]byte(nil), sig...)
+	zeroBased[64] -= 27
+	if ok, err := VerifySignature(message, "0x"+hex.EncodeToString(zeroBased), address); err != nil || !ok {
+		t.Errorf("zero-based v: got %v, %v; want true", ok, err)
+	}
+
+	if ok, err := VerifySignature([]byte("hello world!"), "0x"+hex.EncodeToString(sig), address); err != nil || ok {
+		t.Errorf("tampered message: got %v, %v; want false", ok, err)
+	}
+
+	tampered := append([]byte(nil), sig...)
+	tampered[10] ^= 0xff
+	if ok, _ := VerifySignature(message, "0x"+hex.EncodeToString(tampered), address); ok {
+		t.Error("tampered signature verified")
+	}
+
+	if ok, err := VerifySignature(message, "0x"+hex.EncodeToString(sig), testOwner); err != nil || ok {
+		t.Errorf("other address: got %v, %v; want false", ok, err)
+	}
+}
+
+func TestVerifySignatureMalformed(t *testing.T) {
+	badV := strings.Repeat("11", 64) + "05"
+	for _, sig := range []string{"", "0x", "0x1234", "not hex", "0x" + badV, "0x" + strings.Repeat("00", 65)} {
+		_, err := VerifySignature([]byte("hello"), sig, testOwner)
+		if !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("VerifySignature(%q) error = %v, want ErrInvalidSignature", sig, err)
+		}
+	}
+}
+
+func TestSmartAccountVerifySignature(t *testing.T) {
+	for name, tc := range map[string]struct {
+		result string
+		want   bool
+	}{
+		"valid":   {"0x1626ba7e" + strings.Repeat("00", 28), true},
+		"invalid": {"0xffffffff" + strings.Repeat("00", 28), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, tc.result)
+			}))
+			defer rpc.Close()
+			client := newReceiptTestClient(t, "https://api.cdp.coinbase.com/platform", rpc.URL)
+
+			account := &SmartAccount{client: client, Address: testOwner}
+			got, err := account.VerifySignature(context.Background(), "base-sepolia", []byte("hello"), "0x"+strings.Repeat("ab", 65))
+			if err != nil {
+				t.Fatalf("VerifySignature returned an error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/openapi"
+)
+
+// accountPollInterval is how often WaitForAccountReady polls for the account.
+var accountPollInterval = time.Second
+
+// WaitForAccountReady polls until the EVM account or smart account at address can
+// be used, and returns nil once it can.
+//
+// Accounts are currently provisioned synchronously, so this returns after a
+// single request for accounts returned by the SDK's creation helpers. It exists
+// so provisioning code keeps working if creation becomes asynchronous for some
+// account types: an account that is not found yet, or that the API reports as
+// not ready, is polled again. Since an unknown address is indistinguishable from
+// one still being provisioned, ctx should carry a deadline; its error is
+// returned when it is done. Other API errors are returned immediately.
+func (c *Client) WaitForAccountReady(ctx context.Context, address string) error {
+	ticker := time.NewTicker(accountPollInterval)
+	defer ticker.Stop()
+
+	for {
+		ready, err := c.accountReady(ctx, address)
+		if err != nil {
+			return err
+		}
+		if ready {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("account %s is not ready: %w", address, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitReady waits until the account can be used. See Client.WaitForAccountReady.
+func (a *EvmAccount) WaitReady(ctx context.Context) error {
+	return a.client.WaitForAccountReady(ctx, a.Address)
+}
+
+// WaitReady waits until the smart account can be used. See
+// Client.WaitForAccountReady.
+func (s *SmartAccount) WaitReady(ctx context.Context) error {
+	return s.client.WaitForAccountReady(ctx, s.Address)
+}
+
+// accountReady reports whether address is a usable EVM account or smart account.
+func (c *Client) accountReady(ctx context.Context, address string) (bool, error) {
+	resp, err := c.GetEvmAccountWithResponse(ctx, address)
+	if err != nil {
+		return false, fmt.Errorf("failed to get EVM account: %w", err)
+	}
+	if ready, done := accountReadiness(resp.StatusCode(), resp.Body); done {
+		return ready, nil
+	}
+
+	smartResp, err := c.GetEvmSmartAccountWithResponse(ctx, address)
+	if err != nil {
+		return false, fmt.Errorf("failed to get smart account: %w", err)
+	}
+	if ready, done := accountReadiness(smartResp.StatusCode(), smartResp.Body); done {
+		return ready, nil
+	}
+
+	if statusCode := smartResp.StatusCode(); statusCode != http.StatusNotFound {
+		return false, unexpectedResponseError("get smart account", smartResp.HTTPResponse, smartResp.Body)
+	}
+	if statusCode := resp.StatusCode(); statusCode != http.StatusNotFound {
+		return false, unexpectedResponseError("get EVM account", resp.HTTPResponse, resp.Body)
+	}
+	return false, nil
+}
+
+// accountReadiness interprets a response to a get-account request. done is true
+// if the response settles the account's readiness: it exists and is ready, or the
+// API reports it as still being provisioned.
+func accountReadiness(statusCode int, body []byte) (ready, done bool) {
+	if statusCode == http.StatusOK {
+		return true, true
+	}
+	if NewAPIError(statusCode, body).ErrorType == string(openapi.ErrorTypeAccountNotReady) {
+		return false, true
+	}
+	return false, false
+}
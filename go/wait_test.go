@@ -0,0 +1,89 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForAccountReadyPollsUntilReady(t *testing.T) {
+	setFastTimers(t)
+
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "/smart-accounts/") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errorType":"not_found","errorMessage":"not found"}`)
+			return
+		}
+		if polls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorType":"account_not_ready","errorMessage":"still provisioning"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"address":%q}`, testOwner)
+	}))
+	defer server.Close()
+
+	account := &EvmAccount{client: newTestClient(t, server.URL), Address: testOwner}
+	if err := account.WaitReady(context.Background()); err != nil {
+		t.Fatalf("WaitReady returned an error: %v", err)
+	}
+	if n := polls.Load(); n != 3 {
+		t.Errorf("polled %d times, want 3", n)
+	}
+}
+
+func TestWaitForAccountReadyFindsSmartAccounts(t *testing.T) {
+	setFastTimers(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.Contains(r.URL.Path, "/smart-accounts/") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errorType":"not_found","errorMessage":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"address":%q,"owners":[%q]}`, testRecipient, testOwner)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	if err := client.WaitForAccountReady(context.Background(), testRecipient); err != nil {
+		t.Fatalf("WaitForAccountReady returned an error: %v", err)
+	}
+}
+
+func TestWaitForAccountReadyRespectsContext(t *testing.T) {
+	setFastTimers(t)
+
+	server := newJSONServer(t, http.StatusNotFound, `{"errorType":"not_found","errorMessage":"not found"}`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := newTestClient(t, server.URL).WaitForAccountReady(ctx, testOwner)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWaitForAccountReadyReturnsAPIErrors(t *testing.T) {
+	setFastTimers(t)
+
+	server := newJSONServer(t, http.StatusUnauthorized, `{"errorType":"unauthorized","errorMessage":"bad key"}`)
+
+	err := newTestClient(t, server.URL).WaitForAccountReady(context.Background(), testOwner)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 *APIError, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+)
+
+// WalletAuthHeader returns the value of the X-Wallet-Auth header for a request the
+// typed API doesn't cover, signed with the client's wallet secret. The result is
+// only valid for exactly the request described, since the JWT commits to it:
+//
+//   - method is the HTTP method, e.g. "POST".
+//   - path is the full URL path, including the base path and without a query
+//     string, e.g. "/platform/v2/evm/accounts".
+//   - body is the exact JSON object that will be sent, or nil for none. It is
+//     hashed after sorting its keys, so whitespace and key order don't matter, but
+//     every field and value must match.
+//
+// The host the JWT is bound to is resolved as for the client's own requests:
+// ClientOptions.SigningHost, then the host set with WithRequestHost, then
+// ClientOptions.HostOverride, then the host of the base path.
+func (c *Client) WalletAuthHeader(ctx context.Context, method, path string, body []byte) (string, error) {
+	creds, err := credentials(ctx, c.options)
+	if err != nil {
+		return "", err
+	}
+	if creds.WalletSecret == "" {
+		return "", errors.New("missing required wallet secret: set ClientOptions.WalletSecret")
+	}
+
+	data := map[string]interface{}{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &data); err != nil {
+			return "", fmt.Errorf("failed to parse request body: %w", err)
+		}
+	}
+
+	host, err := c.requestHost(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	method = strings.ToUpper(method)
+	meta := TokenMeta{Kind: TokenKindWallet, Method: method, Host: host, Path: path}
+	return issueToken(ctx, c.options, meta, func() (string, error) {
+		return generateWalletJWT(auth.WalletJwtOptions{
+			WalletSecret:  creds.WalletSecret,
+			RequestMethod: method,
+			RequestHost:   host,
+			RequestPath:   path,
+			RequestData:   data,
+			JTIProvider:   c.options.WalletJTIProvider,
+		})
+	})
+}
+
+// requestHost returns the host that JWTs for requests made with ctx are bound to.
+func (c *Client) requestHost(ctx context.Context) (string, error) {
+	if c.options.SigningHost != "" {
+		return c.options.SigningHost, nil
+	}
+	if host, ok := requestHostFromContext(ctx); ok {
+		return host, nil
+	}
+	if c.options.HostOverride != "" {
+		return c.options.HostOverride, nil
+	}
+
+	basePath := c.options.BasePath
+	if basePath == "" {
+		basePath = defaultBasePath
+	}
+	u, err := url.Parse(basePath)
+	if err != nil {
+		return "", fmt.Errorf("invalid base path %q: %w", basePath, err)
+	}
+	return u.Host, nil
+}
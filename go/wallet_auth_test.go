@@ -0,0 +1,78 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+)
+
+func TestWalletAuthHeader(t *testing.T) {
+	secret := generateTestWalletSecret(t)
+	client, err := NewClient(ClientOptions{
+		WalletSecret:      secret,
+		WalletJTIProvider: func() string { return "fixed-jti" },
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	got, err := client.WalletAuthHeader(context.Background(), "post", "/platform/v2/evm/accounts",
+		[]byte(`{"name": "my-account", "accountPolicy": "policy-1"}`))
+	if err != nil {
+		t.Fatalf("WalletAuthHeader returned an error: %v", err)
+	}
+
+	want, err := auth.GenerateWalletJWT(auth.WalletJwtOptions{
+		WalletSecret:  secret,
+		RequestMethod: "POST",
+		RequestHost:   "api.cdp.coinbase.com",
+		RequestPath:   "/platform/v2/evm/accounts",
+		RequestData:   map[string]interface{}{"accountPolicy": "policy-1", "name": "my-account"},
+		JTIProvider:   func() string { return "fixed-jti" },
+	})
+	if err != nil {
+		t.Fatalf("failed to generate wallet JWT: %v", err)
+	}
+
+	gotClaims, wantClaims := parseClaims(t, got), parseClaims(t, want)
+	if wantClaims["reqHash"] == nil {
+		t.Fatal("expected the reference JWT to carry a reqHash claim")
+	}
+	for _, claim := range []string{"uris", "reqHash", "jti"} {
+		if g, w := gotClaims[claim], wantClaims[claim]; !equalClaim(g, w) {
+			t.Errorf("claim %s = %v, want %v", claim, g, w)
+		}
+	}
+
+	ctx := WithRequestHost(context.Background(), "mock.example.com")
+	hosted, err := client.WalletAuthHeader(ctx, "DELETE", "/platform/v2/evm/accounts/0xabc", nil)
+	if err != nil {
+		t.Fatalf("WalletAuthHeader returned an error: %v", err)
+	}
+	if uris := parseClaims(t, hosted)["uris"].([]interface{}); uris[0] != "DELETE mock.example.com/platform/v2/evm/accounts/0xabc" {
+		t.Errorf("got uris %v", uris)
+	}
+}
+
+func TestWalletAuthHeaderRequiresWalletSecret(t *testing.T) {
+	client := newTestClient(t, "https://api.cdp.coinbase.com/platform")
+	if _, err := client.WalletAuthHeader(context.Background(), "POST", "/platform/v2/evm/accounts", nil); err == nil {
+		t.Error("expected an error without a wallet secret")
+	}
+}
+
+func parseClaims(t *testing.T, token string) map[string]interface{} {
+	t.Helper()
+	claims, err := auth.DecodeClaims(token)
+	if err != nil {
+		t.Fatalf("failed to parse JWT: %v", err)
+	}
+	return claims
+}
+
+func equalClaim(a, b interface{}) bool {
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
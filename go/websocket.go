@@ -0,0 +1,249 @@
+package cdp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/gorilla/websocket"
+)
+
+// WebSocketToken generates a JWT for authenticating a websocket connection to CDP
+// using the client's API key and configured expiry.
+//
+// Unlike the JWTs attached to REST requests, the token omits the `uris` claim, so it
+// is not bound to a single method and path. Send it as a bearer token in the
+// Authorization header of the websocket handshake. The token is valid for
+// ClientOptions.ExpiresIn seconds (120 by default), so generate a fresh one for each
+// connection attempt rather than caching it. WithTokenLifetime on ctx extends it.
+func (c *Client) WebSocketToken(ctx context.Context) (string, error) {
+	return webSocketToken(ctx, c.options)
+}
+
+// webSocketToken generates a websocket JWT with the credentials of options, which
+// must have been resolved with resolveCredentials. The token never has a uris
+// claim, since no request is passed to generateJWT.
+func webSocketToken(ctx context.Context, options ClientOptions) (string, error) {
+	creds, err := credentials(ctx, options)
+	if err != nil {
+		return "", err
+	}
+	if creds.APIKeyID == "" || creds.APIKeySecret == "" {
+		return "", errors.New("missing required CDP API Key configuration: APIKeyID and APIKeySecret must both be set")
+	}
+
+	token, err := issueToken(ctx, options, TokenMeta{Kind: TokenKindAPIKey, KeyID: creds.APIKeyID}, func() (string, error) {
+		return generateJWT(auth.JwtOptions{
+			KeyID:     creds.APIKeyID,
+			KeySecret: creds.APIKeySecret,
+			ExpiresIn: tokenExpiresIn(ctx, options.ExpiresIn),
+			Audience:  options.Audience,
+		})
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate JWT: %w", err)
+	}
+
+	return token, nil
+}
+
+// Defaults of ReconnectPolicy.
+const (
+	defaultReconnectAttempts  = 5
+	defaultReconnectBaseDelay = 500 * time.Millisecond
+	defaultReconnectMaxDelay  = 30 * time.Second
+)
+
+// ReconnectPolicy configures how a WebSocketConn reconnects after its connection
+// drops. See ClientOptions.ReconnectPolicy.
+type ReconnectPolicy struct {
+	// MaxAttempts is the number of consecutive attempts to reconnect before giving
+	// up. Defaults to 5.
+	MaxAttempts int
+	// BaseDelay is the delay before the first attempt. It doubles with each
+	// attempt up to MaxDelay, and each delay is randomized by up to half its
+	// length. Defaults to 500ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts. Defaults to 30 seconds.
+	MaxDelay time.Duration
+	// OnReconnect, if set, is called with each new connection before reads
+	// resume, for example to renew subscriptions, which do not carry over to a
+	// new connection. An error fails the attempt.
+	OnReconnect func(conn *websocket.Conn) error
+}
+
+// WebSocketConn is a websocket connection to CDP authenticated with an API key
+// JWT, as returned by DialWebSocket. If ClientOptions.ReconnectPolicy was set, it
+// reconnects with a fresh JWT when the connection drops.
+//
+// Like the underlying connection, it supports one concurrent reader and one
+// concurrent writer.
+type WebSocketConn struct {
+	url     string
+	options ClientOptions
+	ctx     context.Context
+	cancel  context.CancelFunc
+
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+// DialWebSocket opens a websocket connection to url, such as one of CDP's
+// streaming endpoints, authenticated with the API key of opts. The handshake
+// carries a JWT from the same source as Client.WebSocketToken in its
+// Authorization header. The JWT never has a uris claim, whatever the request
+// options of opts, since websocket tokens are not bound to a method and path.
+//
+// If the server rejects the handshake, the error wraps an *APIError with the
+// response status. Call Close on the returned connection once it is no longer
+// needed.
+func DialWebSocket(ctx context.Context, url string, opts ClientOptions) (*WebSocketConn, error) {
+	if err := resolveCredentials(&opts); err != nil {
+		return nil, err
+	}
+	if opts.MinTLSVersion != 0 {
+		if err := checkMinTLSVersion(opts.MinTLSVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	connCtx, cancel := context.WithCancel(context.Background())
+	c := &WebSocketConn{url: url, options: opts, ctx: connCtx, cancel: cancel}
+	conn, err := c.dial(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	c.conn = conn
+	return c, nil
+}
+
+// dial opens a new connection with a fresh JWT.
+func (c *WebSocketConn) dial(ctx context.Context) (*websocket.Conn, error) {
+	token, err := webSocketToken(ctx, c.options)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := *websocket.DefaultDialer
+	if c.options.MinTLSVersion != 0 {
+		dialer.TLSClientConfig = &tls.Config{MinVersion: c.options.MinTLSVersion}
+	}
+	conn, resp, err := dialer.DialContext(ctx, c.url, http.Header{"Authorization": {"Bearer " + token}})
+	if err != nil {
+		if resp != nil {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to open websocket %s: %w", c.url, unexpectedStatusError("open websocket", resp.StatusCode, body))
+		}
+		return nil, fmt.Errorf("failed to open websocket %s: %w", c.url, err)
+	}
+	return conn, nil
+}
+
+// Conn returns the current underlying connection, for settings such as read
+// limits and ping handlers. It is replaced when the connection reconnects.
+func (c *WebSocketConn) Conn() *websocket.Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn
+}
+
+// ReadMessage reads the next message, as websocket.Conn.ReadMessage does. If the
+// connection drops and ClientOptions.ReconnectPolicy is set, it reconnects and
+// reads from the new connection; messages sent while disconnected are lost. It
+// returns an error if reconnecting fails, the connection is closed, or the server
+// closes it normally (websocket.CloseNormalClosure), which is not reconnected.
+func (c *WebSocketConn) ReadMessage() (messageType int, data []byte, err error) {
+	for {
+		conn := c.Conn()
+		if messageType, data, err = conn.ReadMessage(); err == nil {
+			return messageType, data, nil
+		}
+		if c.options.ReconnectPolicy == nil || c.ctx.Err() != nil || websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return 0, nil, err
+		}
+		if err = c.reconnect(conn, err); err != nil {
+			return 0, nil, err
+		}
+	}
+}
+
+// WriteMessage writes a message to the current connection, as
+// websocket.Conn.WriteMessage does. It fails if the connection has dropped; the
+// next ReadMessage reconnects it.
+func (c *WebSocketConn) WriteMessage(messageType int, data []byte) error {
+	return c.Conn().WriteMessage(messageType, data)
+}
+
+// Close closes the connection and stops any reconnection in progress.
+func (c *WebSocketConn) Close() error {
+	c.cancel()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	return c.conn.Close()
+}
+
+// reconnect replaces the dropped connection old, which failed with cause, with a
+// new one, backing off between attempts as configured by the reconnect policy.
+// The lock is only held to swap in the new connection, so that Conn and
+// WriteMessage return the dropped connection, and fail, while reconnecting.
+func (c *WebSocketConn) reconnect(old *websocket.Conn, cause error) error {
+	policy := *c.options.ReconnectPolicy
+	attempts := policy.MaxAttempts
+	if attempts <= 0 {
+		attempts = defaultReconnectAttempts
+	}
+	delay, maxDelay := policy.BaseDelay, policy.MaxDelay
+	if delay <= 0 {
+		delay = defaultReconnectBaseDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = defaultReconnectMaxDelay
+	}
+
+	_ = old.Close()
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if c.options.Debugging {
+			clientLogger(c.options).Debug("cdp websocket reconnecting", "url", c.url, "attempt", attempt, "cause", cause)
+		}
+		timer := time.NewTimer(jitter(delay))
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("websocket closed while reconnecting: %w", cause)
+		case <-timer.C:
+		}
+		delay = min(2*delay, maxDelay)
+
+		var conn *websocket.Conn
+		if conn, err = c.dial(c.ctx); err != nil {
+			continue
+		}
+		if policy.OnReconnect != nil {
+			if err = policy.OnReconnect(conn); err != nil {
+				_ = conn.Close()
+				continue
+			}
+		}
+		c.mu.Lock()
+		if c.ctx.Err() != nil {
+			c.mu.Unlock()
+			_ = conn.Close()
+			return fmt.Errorf("websocket closed while reconnecting: %w", cause)
+		}
+		c.conn = conn
+		c.mu.Unlock()
+		return nil
+	}
+	return fmt.Errorf("websocket disconnected (%v) and %d attempts to reconnect failed: %w", cause, attempts, err)
+}
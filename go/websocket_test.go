@@ -0,0 +1,298 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coinbase/cdp-sdk/go/auth"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+)
+
+func TestClientWebSocketTokenOmitsURIs(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+		ExpiresIn:    300,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	token, err := client.WebSocketToken(context.Background())
+	if err != nil {
+		t.Fatalf("WebSocketToken returned an error: %v", err)
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+
+	if _, ok := claims["uris"]; ok {
+		t.Errorf("expected no uris claim, got %v", claims["uris"])
+	}
+	if claims["sub"] != testAPIKeyID {
+		t.Errorf("sub = %v, want the API key ID", claims["sub"])
+	}
+	if exp, iat := claims["exp"].(float64), claims["iat"].(float64); exp-iat != 300 {
+		t.Errorf("token lifetime = %v seconds, want 300", exp-iat)
+	}
+}
+
+func TestClientWebSocketTokenRequiresCredentials(t *testing.T) {
+	client, err := NewClient(ClientOptions{})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.WebSocketToken(context.Background()); err == nil {
+		t.Fatal("expected an error without credentials, got nil")
+	}
+}
+
+// newWebSocketServer accepts websocket connections, records the bearer token of
+// each handshake, and sends each connection its number, starting at 1, before
+// closing connections whose number is in drop.
+func newWebSocketServer(t *testing.T, drop ...int) (*httptest.Server, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var tokens []string
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			http.Error(w, `{"errorType":"unauthorized","errorMessage":"missing token"}`, http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		tokens = append(tokens, token)
+		n := len(tokens)
+		mu.Unlock()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte{byte('0' + n)})
+		for _, d := range drop {
+			if d == n {
+				return
+			}
+		}
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			_ = conn.WriteMessage(messageType, data)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), tokens...)
+	}
+}
+
+// readWebSocketMessage reads a text message from conn, failing the test on error.
+func readWebSocketMessage(t *testing.T, conn *WebSocketConn) string {
+	t.Helper()
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("ReadMessage returned an error: %v", err)
+	}
+	return string(data)
+}
+
+func TestDialWebSocket(t *testing.T) {
+	server, tokens := newWebSocketServer(t)
+	conn, err := DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"), ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+		// Request options don't apply to websocket tokens.
+		HostOverride: "api.cdp.coinbase.com",
+		SigningHost:  "api.cdp.coinbase.com",
+	})
+	if err != nil {
+		t.Fatalf("DialWebSocket returned an error: %v", err)
+	}
+	defer conn.Close()
+
+	if got := readWebSocketMessage(t, conn); got != "1" {
+		t.Errorf("expected the greeting of the first connection, got %q", got)
+	}
+	if err := conn.WriteMessage(websocket.TextMessage, []byte("ping")); err != nil {
+		t.Fatalf("WriteMessage returned an error: %v", err)
+	}
+	if got := readWebSocketMessage(t, conn); got != "ping" {
+		t.Errorf("expected the echoed message, got %q", got)
+	}
+
+	claims, err := auth.DecodeClaims(tokens()[0])
+	if err != nil {
+		t.Fatalf("failed to decode the handshake token: %v", err)
+	}
+	if _, ok := claims["uris"]; ok {
+		t.Errorf("expected no uris claim, got %v", claims["uris"])
+	}
+	if claims["sub"] != testAPIKeyID {
+		t.Errorf("sub = %v, want the API key ID", claims["sub"])
+	}
+}
+
+func TestDialWebSocketReconnects(t *testing.T) {
+	server, tokens := newWebSocketServer(t, 1, 2)
+	var reconnects atomic.Int32
+	conn, err := DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"), ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+		ReconnectPolicy: &ReconnectPolicy{
+			BaseDelay: time.Millisecond,
+			OnReconnect: func(*websocket.Conn) error {
+				reconnects.Add(1)
+				return nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("DialWebSocket returned an error: %v", err)
+	}
+	defer conn.Close()
+
+	for _, want := range []string{"1", "2", "3"} {
+		if got := readWebSocketMessage(t, conn); got != want {
+			t.Errorf("expected the greeting of connection %s, got %q", want, got)
+		}
+	}
+	if n := reconnects.Load(); n != 2 {
+		t.Errorf("expected 2 reconnections, got %d", n)
+	}
+	if got := tokens(); len(got) != 3 || got[0] == got[1] || got[1] == got[2] {
+		t.Errorf("expected a fresh token for each connection, got %d tokens", len(got))
+	}
+}
+
+func TestDialWebSocketWithoutReconnectPolicy(t *testing.T) {
+	server, _ := newWebSocketServer(t, 1)
+	conn, err := DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"), ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+	})
+	if err != nil {
+		t.Fatalf("DialWebSocket returned an error: %v", err)
+	}
+	defer conn.Close()
+
+	readWebSocketMessage(t, conn)
+	if _, _, err := conn.ReadMessage(); err == nil {
+		t.Error("expected an error once the connection drops")
+	}
+}
+
+func TestDialWebSocketDoesNotReconnectAfterNormalClosure(t *testing.T) {
+	var handshakes atomic.Int32
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handshakes.Add(1)
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "bye"))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	conn, err := DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"), ClientOptions{
+		APIKeyID:        testAPIKeyID,
+		APIKeySecret:    generateTestECKeyForCdpTest(t),
+		ReconnectPolicy: &ReconnectPolicy{BaseDelay: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("DialWebSocket returned an error: %v", err)
+	}
+	defer conn.Close()
+
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		t.Errorf("expected a normal closure error, got %v", err)
+	}
+	if n := handshakes.Load(); n != 1 {
+		t.Errorf("expected no reconnection after a normal closure, got %d handshakes", n)
+	}
+}
+
+func TestDialWebSocketWriteDoesNotBlockWhileReconnecting(t *testing.T) {
+	server, _ := newWebSocketServer(t, 1)
+	conn, err := DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"), ClientOptions{
+		APIKeyID:        testAPIKeyID,
+		APIKeySecret:    generateTestECKeyForCdpTest(t),
+		ReconnectPolicy: &ReconnectPolicy{BaseDelay: time.Hour},
+	})
+	if err != nil {
+		t.Fatalf("DialWebSocket returned an error: %v", err)
+	}
+
+	readWebSocketMessage(t, conn)
+	readErr := make(chan error, 1)
+	go func() {
+		_, _, err := conn.ReadMessage()
+		readErr <- err
+	}()
+	// Give the reader time to see the drop and start backing off.
+	time.Sleep(50 * time.Millisecond)
+
+	written := make(chan error, 1)
+	go func() { written <- conn.WriteMessage(websocket.TextMessage, []byte("ping")) }()
+	select {
+	case err := <-written:
+		if err == nil {
+			t.Error("expected WriteMessage to fail on the dropped connection")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WriteMessage blocked while reconnecting")
+	}
+
+	_ = conn.Close()
+	if err := <-readErr; err == nil {
+		t.Error("expected ReadMessage to fail once closed while reconnecting")
+	}
+}
+
+func TestDialWebSocketErrors(t *testing.T) {
+	server, _ := newWebSocketServer(t)
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	if _, err := DialWebSocket(context.Background(), url, ClientOptions{}); err == nil {
+		t.Error("expected an error without credentials")
+	}
+
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errorType":"unauthorized","errorMessage":"invalid token"}`))
+	}))
+	defer rejecting.Close()
+	_, err := DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(rejecting.URL, "http"), ClientOptions{
+		APIKeyID:     testAPIKeyID,
+		APIKeySecret: generateTestECKeyForCdpTest(t),
+	})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected an unauthorized *APIError, got %v", err)
+	}
+}
@@ -0,0 +1,281 @@
+#!/usr/bin/env python3
+"""Generate the Go SDK's lookup table of OpenAPI operations and request-body schemas.
+
+This script reads openapi.yaml and writes go/openapi/operations.gen.go, which lists every
+operation (method, path pattern, and operation ID) together with a compact description of its
+JSON request body schema. The Go SDK uses this table to validate outgoing request bodies when
+`ClientOptions.StrictValidation` is enabled.
+
+Only the subset of JSON Schema that the Go validator understands is emitted: types, required
+properties, enums, patterns, string formats, string length limits, array items, and the
+allOf/anyOf/oneOf combinators. Component schemas are emitted by name and referenced lazily, so
+recursive schemas are supported.
+
+Usage:
+    python3 scripts/generate_go_operations.py [path/to/openapi.yaml]
+"""
+
+from __future__ import annotations
+
+import argparse
+import json
+import re
+import subprocess
+from pathlib import Path
+
+import yaml
+
+HTTP_METHODS = {"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+REPO_ROOT = Path(__file__).resolve().parent.parent
+DEFAULT_SPEC_PATH = REPO_ROOT / "openapi.yaml"
+GO_OUTPUT_PATH = REPO_ROOT / "go" / "openapi" / "operations.gen.go"
+
+# String formats checked by the Go validator; other formats are not emitted.
+SUPPORTED_FORMATS = {"date-time", "email", "uri", "uuid"}
+
+# Go's RE2 engine does not support lookaround or backreferences; such patterns are skipped.
+UNSUPPORTED_PATTERN = re.compile(r"\(\?[=!<]|\\[1-9]")
+
+
+def go_string(value: str) -> str:
+    """Render a Go interpreted string literal."""
+    return json.dumps(value)
+
+
+def path_regex_source(path: str) -> str:
+    """Convert an OpenAPI path template into a regex source anchored at the end.
+
+    See generate_public_operations.py for why the pattern is not anchored at the start.
+    """
+    segments = re.split(r"(\{[^}]+\})", path)
+    return (
+        "".join(
+            "[^/]+" if s.startswith("{") and s.endswith("}") else re.escape(s) for s in segments
+        )
+        + "$"
+    )
+
+
+def operation_id_to_go(operation_id: str) -> str:
+    """Convert an operationId (e.g. `createEvmAccount`) to its Go name (`CreateEvmAccount`)."""
+    return operation_id[:1].upper() + operation_id[1:]
+
+
+def render_schema(schema: dict, indent: int) -> str:
+    """Render a schema as a Go composite literal body (without the leading `&Schema`)."""
+    if not isinstance(schema, dict):
+        return "{}"
+
+    pad = "\t" * (indent + 1)
+    fields: list[str] = []
+
+    ref = schema.get("$ref")
+    if ref:
+        return "{Ref: " + go_string(ref.split("/")[-1]) + "}"
+
+    if isinstance(schema.get("type"), str):
+        fields.append(f"Type: {go_string(schema['type'])}")
+    if schema.get("nullable"):
+        fields.append("Nullable: true")
+    if schema.get("required"):
+        required = ", ".join(go_string(r) for r in schema["required"])
+        fields.append(f"Required: []string{{{required}}}")
+    if schema.get("enum"):
+        values = ", ".join(
+            go_string(v if isinstance(v, str) else json.dumps(v)) for v in schema["enum"] if v is not None
+        )
+        fields.append(f"Enum: []string{{{values}}}")
+    pattern = schema.get("pattern")
+    if isinstance(pattern, str) and not UNSUPPORTED_PATTERN.search(pattern):
+        fields.append(f"Pattern: regexp.MustCompile({go_string(pattern)})")
+    if schema.get("format") in SUPPORTED_FORMATS:
+        fields.append(f"Format: {go_string(schema['format'])}")
+    if isinstance(schema.get("minLength"), int):
+        fields.append(f"MinLength: {schema['minLength']}")
+    if isinstance(schema.get("maxLength"), int):
+        fields.append(f"MaxLength: {schema['maxLength']}")
+    if isinstance(schema.get("items"), dict):
+        fields.append(f"Items: &Schema{render_schema(schema['items'], indent + 1)}")
+    if isinstance(schema.get("properties"), dict) and schema["properties"]:
+        props = "".join(
+            f"\n{pad}\t{go_string(name)}: {render_schema(prop, indent + 2)},"
+            for name, prop in schema["properties"].items()
+        )
+        fields.append(f"Properties: map[string]*Schema{{{props}\n{pad}}}")
+    for combinator, go_field in (("allOf", "AllOf"), ("anyOf", "AnyOf"), ("oneOf", "OneOf")):
+        if isinstance(schema.get(combinator), list):
+            items = "".join(
+                f"\n{pad}\t{render_schema(s, indent + 2)}," for s in schema[combinator]
+            )
+            fields.append(f"{go_field}: []*Schema{{{items}\n{pad}}}")
+
+    if not fields:
+        return "{}"
+    return "{\n" + "".join(f"{pad}{f},\n" for f in fields) + "\t" * indent + "}"
+
+
+def header_parameter(spec: dict, path_item: dict, operation: dict, name: str) -> dict | None:
+    """Return the operation's header parameter with the given name, if it declares one."""
+    components = (spec.get("components") or {}).get("parameters") or {}
+    for parameter in (path_item.get("parameters") or []) + (operation.get("parameters") or []):
+        ref = parameter.get("$ref", "")
+        if ref.startswith("#/components/parameters/"):
+            parameter = components.get(ref.rsplit("/", 1)[-1]) or {}
+        if parameter.get("in") == "header" and parameter.get("name", "").lower() == name.lower():
+            return parameter
+    return None
+
+
+def load_operations(spec: dict) -> list[dict]:
+    """Return every operation in the spec, most specific path first."""
+    operations = []
+    for path, path_item in (spec.get("paths") or {}).items():
+        if not isinstance(path_item, dict):
+            continue
+        for method, operation in path_item.items():
+            if method not in HTTP_METHODS or not isinstance(operation, dict):
+                continue
+            operation_id = operation.get("operationId")
+            if not operation_id:
+                continue
+            body = (
+                (operation.get("requestBody") or {})
+                .get("content", {})
+                .get("application/json", {})
+                .get("schema")
+            )
+            operations.append(
+                {
+                    "id": operation_id_to_go(operation_id),
+                    "method": method.upper(),
+                    "path": path,
+                    "body": body,
+                    "idempotency_key": header_parameter(spec, path_item, operation, "X-Idempotency-Key")
+                    is not None,
+                    "wallet_auth": bool(
+                        (header_parameter(spec, path_item, operation, "X-Wallet-Auth") or {}).get("required")
+                    ),
+                }
+            )
+
+    # Literal paths must be matched before templated ones that could shadow them
+    # (e.g. `/v2/evm/accounts/import` before `/v2/evm/accounts/{address}`).
+    return sorted(operations, key=lambda op: (op["path"].count("{"), op["path"], op["method"]))
+
+
+def render_go(spec: dict) -> str:
+    """Render the Go `operations.gen.go` source."""
+    operations = load_operations(spec)
+    components = (spec.get("components") or {}).get("schemas") or {}
+
+    operation_entries = []
+    for op in operations:
+        fields = [
+            f"ID: {go_string(op['id'])}",
+            f"Method: {go_string(op['method'])}",
+            f"PathPattern: regexp.MustCompile({go_string(path_regex_source(op['path']))})",
+        ]
+        if op["idempotency_key"]:
+            fields.append("IdempotencyKey: true")
+        if op["wallet_auth"]:
+            fields.append("WalletAuth: true")
+        if op["body"] is not None:
+            fields.append(f"RequestBody: &Schema{render_schema(op['body'], 1)}")
+        operation_entries.append("\t{" + ", ".join(fields) + "},")
+
+    schema_entries = [
+        f"\t{go_string(name)}: {render_schema(schema, 1)},"
+        for name, schema in sorted(components.items())
+    ]
+
+    return f"""// Code generated by scripts/generate_go_operations.py from openapi.yaml. DO NOT EDIT.
+
+package openapi
+
+import (
+\t"regexp"
+\t"strings"
+)
+
+// Schema is a compact description of an OpenAPI schema, covering the subset of
+// JSON Schema used to validate request bodies client-side.
+type Schema struct {{
+\t// Ref names a schema in Schemas that this schema refers to.
+\tRef string
+\tType string
+\tNullable bool
+\tRequired []string
+\tEnum []string
+\tPattern *regexp.Regexp
+\t// Format is the string format of the value: "date-time", "email", "uri" or "uuid".
+\tFormat string
+\tMinLength int
+\tMaxLength int
+\tItems *Schema
+\tProperties map[string]*Schema
+\tAllOf []*Schema
+\tAnyOf []*Schema
+\tOneOf []*Schema
+}}
+
+// Operation describes a single OpenAPI operation.
+type Operation struct {{
+\t// ID is the operation's Go name, as used by the generated client (e.g. "CreateEvmAccount").
+\tID string
+\tMethod string
+\tPathPattern *regexp.Regexp
+\t// IdempotencyKey reports whether the operation accepts an X-Idempotency-Key header,
+\t// with which the server deduplicates retried requests.
+\tIdempotencyKey bool
+\t// WalletAuth reports whether the operation requires an X-Wallet-Auth header.
+\tWalletAuth bool
+\t// RequestBody is the schema of the operation's JSON request body, or nil if it has none.
+\tRequestBody *Schema
+}}
+
+// Operations lists every operation declared in openapi.yaml. Literal paths are listed
+// before templated paths so that LookupOperation prefers the most specific match.
+var Operations = []Operation{{
+{chr(10).join(operation_entries)}
+}}
+
+// Schemas maps component schema names to their descriptions.
+var Schemas = map[string]*Schema{{
+{chr(10).join(schema_entries)}
+}}
+
+// LookupOperation returns the operation matching the given HTTP method and path.
+func LookupOperation(method, path string) (Operation, bool) {{
+\tnormalizedMethod := strings.ToUpper(method)
+\tfor _, operation := range Operations {{
+\t\tif operation.Method == normalizedMethod && operation.PathPattern.MatchString(path) {{
+\t\t\treturn operation, true
+\t\t}}
+\t}}
+\treturn Operation{{}}, false
+}}
+"""
+
+
+def main() -> None:
+    """Load operations from the OpenAPI spec and regenerate the Go lookup table."""
+    parser = argparse.ArgumentParser(description=__doc__)
+    parser.add_argument(
+        "spec",
+        nargs="?",
+        default=str(DEFAULT_SPEC_PATH),
+        help="Path to the OpenAPI spec (defaults to the repo-root openapi.yaml).",
+    )
+    args = parser.parse_args()
+
+    with open(args.spec) as f:
+        spec = yaml.safe_load(f)
+
+    GO_OUTPUT_PATH.write_text(render_go(spec))
+    subprocess.run(["gofmt", "-w", str(GO_OUTPUT_PATH)], check=True)
+    print(f"Wrote {GO_OUTPUT_PATH.relative_to(REPO_ROOT)}")
+
+
+if __name__ == "__main__":
+    main()